@@ -5,16 +5,21 @@ import (
 
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/provisioner"
+	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/email"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.uber.org/zap"
 )
 
 type Options struct {
-	DatabaseDriver  string
-	DatabaseDSN     string
-	ProvisionerSpec string
-	ExternalURL     string
+	DatabaseDriver string
+	DatabaseDSN    string
+	// DatabaseEncryptionKeyring is a JSON keyring used to encrypt sensitive data at rest (see symmetriccrypto.ParseKeyring)
+	DatabaseEncryptionKeyring string
+	ProvisionerSpec           string
+	ExternalURL               string
+	// AuditActivity optionally receives a copy of every audit event
+	AuditActivity activity.Client
 }
 
 type Service struct {
@@ -30,7 +35,7 @@ type Service struct {
 
 func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Issuer, emailClient *email.Client, github Github) (*Service, error) {
 	// Init db
-	db, err := database.Open(opts.DatabaseDriver, opts.DatabaseDSN)
+	db, err := database.Open(opts.DatabaseDriver, opts.DatabaseDSN, opts.DatabaseEncryptionKeyring)
 	if err != nil {
 		logger.Fatal("error connecting to database", zap.Error(err))
 	}
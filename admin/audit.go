@@ -0,0 +1,193 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/rilldata/rill/admin/database"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// auditRedacted replaces secret values in audit event diffs.
+const auditRedacted = "[redacted]"
+
+// AuditEvent describes a change to record in the audit log.
+// Before and After can be any value that serializes to a JSON object. Only the fields that differ are recorded.
+type AuditEvent struct {
+	OrgID       string
+	ProjectID   string
+	ProjectName string
+	ActorType   string
+	ActorID     string
+	ActorName   string
+	Action      string
+	Target      string
+	Before      any
+	After       any
+}
+
+// RecordAuditEvent writes an event to the audit log and forwards it to the audit activity client (if configured).
+func (s *Service) RecordAuditEvent(ctx context.Context, e *AuditEvent) error {
+	before, err := auditObject(e.Before)
+	if err != nil {
+		return err
+	}
+	after, err := auditObject(e.After)
+	if err != nil {
+		return err
+	}
+	before, after = auditDiff(before, after)
+	redactAuditSecrets(before)
+	redactAuditSecrets(after)
+
+	beforeJSON, err := marshalAuditObject(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := marshalAuditObject(after)
+	if err != nil {
+		return err
+	}
+
+	var projectID *string
+	if e.ProjectID != "" {
+		projectID = &e.ProjectID
+	}
+
+	evt, err := s.DB.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{
+		OrgID:       e.OrgID,
+		ProjectID:   projectID,
+		ProjectName: e.ProjectName,
+		ActorType:   e.ActorType,
+		ActorID:     e.ActorID,
+		ActorName:   e.ActorName,
+		Action:      e.Action,
+		Target:      e.Target,
+		Before:      beforeJSON,
+		After:       afterJSON,
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.opts.AuditActivity != nil {
+		s.opts.AuditActivity.Emit(ctx, "audit_event", 1,
+			attribute.String("audit_event_id", evt.ID),
+			attribute.String("org_id", evt.OrgID),
+			attribute.String("project_id", e.ProjectID),
+			attribute.String("project_name", evt.ProjectName),
+			attribute.String("actor_type", evt.ActorType),
+			attribute.String("actor_id", evt.ActorID),
+			attribute.String("actor_name", evt.ActorName),
+			attribute.String("action", evt.Action),
+			attribute.String("target", evt.Target),
+			attribute.String("before", string(beforeJSON)),
+			attribute.String("after", string(afterJSON)),
+		)
+	}
+
+	return nil
+}
+
+// auditObject converts v to a JSON object by round-tripping it through JSON.
+func auditObject(v any) (map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize audit event: %w", err)
+	}
+	var res map[string]any
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, fmt.Errorf("audit event values must serialize to JSON objects: %w", err)
+	}
+	return res, nil
+}
+
+func marshalAuditObject(m map[string]any) ([]byte, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(m)
+}
+
+// auditDiff returns the fields of before and after that differ. Nested objects are diffed recursively.
+func auditDiff(before, after map[string]any) (map[string]any, map[string]any) {
+	resBefore := make(map[string]any)
+	resAfter := make(map[string]any)
+	for k, b := range before {
+		a, ok := after[k]
+		if !ok {
+			resBefore[k] = b
+			continue
+		}
+		if reflect.DeepEqual(a, b) {
+			continue
+		}
+		bm, bok := b.(map[string]any)
+		am, aok := a.(map[string]any)
+		if bok && aok {
+			resBefore[k], resAfter[k] = auditDiff(bm, am)
+			continue
+		}
+		resBefore[k] = b
+		resAfter[k] = a
+	}
+	for k, a := range after {
+		if _, ok := before[k]; !ok {
+			resAfter[k] = a
+		}
+	}
+	return resBefore, resAfter
+}
+
+// redactAuditSecrets replaces the values of secret fields with a placeholder.
+// For objects like project variables, the keys are kept so it's possible to tell which secrets changed.
+func redactAuditSecrets(m map[string]any) {
+	for k, v := range m {
+		if isAuditSecret(k) {
+			m[k] = redactAuditValue(v)
+			continue
+		}
+		if vm, ok := v.(map[string]any); ok {
+			redactAuditSecrets(vm)
+		}
+	}
+}
+
+func redactAuditValue(v any) any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case map[string]any:
+		res := make(map[string]any, len(v))
+		for k, vv := range v {
+			res[k] = redactAuditValue(vv)
+		}
+		return res
+	default:
+		return auditRedacted
+	}
+}
+
+func isAuditSecret(key string) bool {
+	key = strings.ToLower(key)
+	// IDs are safe to record, even for secrets (e.g. a service token's ID)
+	if strings.HasSuffix(key, "_id") {
+		return false
+	}
+	if strings.HasSuffix(key, "variables") {
+		return true
+	}
+	for _, s := range []string{"secret", "password", "token", "credentials", "dsn"} {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditDiff(t *testing.T) {
+	type project struct {
+		Name          string             `json:"name"`
+		Public        bool               `json:"public"`
+		ProdVariables database.Variables `json:"prod_variables"`
+		ProdOLAPDSN   string             `json:"prod_olap_dsn"`
+	}
+
+	before, err := auditObject(&project{
+		Name:          "foo",
+		ProdVariables: database.Variables{"A": "1", "B": "2"},
+		ProdOLAPDSN:   "md:?token=secret",
+	})
+	require.NoError(t, err)
+	after, err := auditObject(&project{
+		Name:          "foo",
+		Public:        true,
+		ProdVariables: database.Variables{"A": "1", "B": "3", "C": "4"},
+		ProdOLAPDSN:   "md:?token=other",
+	})
+	require.NoError(t, err)
+
+	before, after = auditDiff(before, after)
+	redactAuditSecrets(before)
+	redactAuditSecrets(after)
+
+	require.Equal(t, map[string]any{
+		"public":         false,
+		"prod_variables": map[string]any{"B": auditRedacted},
+		"prod_olap_dsn":  auditRedacted,
+	}, before)
+	require.Equal(t, map[string]any{
+		"public":         true,
+		"prod_variables": map[string]any{"B": auditRedacted, "C": auditRedacted},
+		"prod_olap_dsn":  auditRedacted,
+	}, after)
+}
+
+func TestAuditDiffCreateAndDelete(t *testing.T) {
+	created, err := auditObject(map[string]any{"email": "a@example.com", "role": "viewer"})
+	require.NoError(t, err)
+
+	before, after := auditDiff(nil, created)
+	require.Empty(t, before)
+	require.Equal(t, created, after)
+
+	before, after = auditDiff(created, nil)
+	require.Equal(t, created, before)
+	require.Empty(t, after)
+
+	before, after = auditDiff(created, created)
+	require.Empty(t, before)
+	require.Empty(t, after)
+
+	token := map[string]any{"token_id": "a", "token": "b"}
+	redactAuditSecrets(token)
+	require.Equal(t, map[string]any{"token_id": "a", "token": auditRedacted}, token)
+
+	_, err = auditObject("not an object")
+	require.Error(t, err)
+}
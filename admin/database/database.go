@@ -20,13 +20,15 @@ func Register(name string, driver Driver) {
 }
 
 // Open opens a new database connection.
-func Open(driver, dsn string) (DB, error) {
+// The encKeyring is a JSON keyring (see symmetriccrypto.ParseKeyring) used to encrypt sensitive data at rest.
+// If it is empty, data is stored unencrypted.
+func Open(driver, dsn, encKeyring string) (DB, error) {
 	d, ok := Drivers[driver]
 	if !ok {
 		return nil, fmt.Errorf("unknown database driver: %s", driver)
 	}
 
-	db, err := d.Open(dsn)
+	db, err := d.Open(dsn, encKeyring)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +38,7 @@ func Open(driver, dsn string) (DB, error) {
 
 // Driver is the interface for DB drivers.
 type Driver interface {
-	Open(dsn string) (DB, error)
+	Open(dsn, encKeyring string) (DB, error)
 }
 
 // DB is the interface for a database connection.
@@ -64,6 +66,21 @@ type DB interface {
 	InsertOrganizationWhitelistedDomain(ctx context.Context, opts *InsertOrganizationWhitelistedDomainOptions) (*OrganizationWhitelistedDomain, error)
 	DeleteOrganizationWhitelistedDomain(ctx context.Context, id string) error
 
+	FindOrganizationUserAttributes(ctx context.Context, orgID, userID string) (*OrganizationUserAttributes, error)
+	UpsertOrganizationUserAttributes(ctx context.Context, orgID, userID string, attributes map[string]any) (*OrganizationUserAttributes, error)
+
+	FindOrganizationSSOProvider(ctx context.Context, orgID string) (*OrganizationSSOProvider, error)
+	FindOrganizationSSOProviderForDomain(ctx context.Context, domain string) (*OrganizationSSOProvider, error)
+	UpsertOrganizationSSOProvider(ctx context.Context, opts *UpsertOrganizationSSOProviderOptions) (*OrganizationSSOProvider, error)
+	ReencryptOrganizationSSOProviders(ctx context.Context, limit int) (int, error)
+	DeleteOrganizationSSOProvider(ctx context.Context, orgID string) error
+
+	FindOrganizationSSODomains(ctx context.Context, orgID string) ([]*OrganizationSSODomain, error)
+	FindOrganizationSSODomain(ctx context.Context, orgID, domain string) (*OrganizationSSODomain, error)
+	InsertOrganizationSSODomain(ctx context.Context, opts *InsertOrganizationSSODomainOptions) (*OrganizationSSODomain, error)
+	UpdateOrganizationSSODomainVerified(ctx context.Context, id string) (*OrganizationSSODomain, error)
+	DeleteOrganizationSSODomain(ctx context.Context, id string) error
+
 	FindProjects(ctx context.Context, afterName string, limit int) ([]*Project, error)
 	FindProjectPathsByPattern(ctx context.Context, namePattern, afterName string, limit int) ([]string, error)
 	FindProjectsForUser(ctx context.Context, userID string) ([]*Project, error)
@@ -78,6 +95,7 @@ type DB interface {
 	InsertProject(ctx context.Context, opts *InsertProjectOptions) (*Project, error)
 	DeleteProject(ctx context.Context, id string) error
 	UpdateProject(ctx context.Context, id string, opts *UpdateProjectOptions) (*Project, error)
+	ReencryptProjectVariables(ctx context.Context, limit int) (int, error)
 	CountProjectsForOrganization(ctx context.Context, orgID string) (int, error)
 
 	FindExpiredDeployments(ctx context.Context) ([]*Deployment, error)
@@ -106,10 +124,19 @@ type DB interface {
 	UpdateSuperuser(ctx context.Context, userID string, superuser bool) error
 	CheckUserIsAnOrganizationMember(ctx context.Context, userID, orgID string) (bool, error)
 
+	FindUsergroupsForOrganization(ctx context.Context, orgID, afterName string, limit int) ([]*Usergroup, error)
+	FindCustomUsergroupsForOrganization(ctx context.Context, orgID string, offset, limit int) ([]*Usergroup, error)
+	CountCustomUsergroupsForOrganization(ctx context.Context, orgID string) (int, error)
+	FindUsergroup(ctx context.Context, id string) (*Usergroup, error)
+	FindUsergroupByName(ctx context.Context, orgID, name string) (*Usergroup, error)
 	InsertUsergroup(ctx context.Context, opts *InsertUsergroupOptions) (*Usergroup, error)
+	UpdateUsergroupName(ctx context.Context, name, groupID string) (*Usergroup, error)
+	DeleteUsergroup(ctx context.Context, groupID string) error
+	FindUsergroupMemberUsers(ctx context.Context, groupID string) ([]*User, error)
 	FindUsergroupsForUser(ctx context.Context, userID, orgID string) ([]*Usergroup, error)
 	InsertUsergroupMember(ctx context.Context, groupID, userID string) error
 	DeleteUsergroupMember(ctx context.Context, groupID, userID string) error
+	DeleteAllUsergroupMemberUserForOrganization(ctx context.Context, orgID, userID string) error
 
 	FindUserAuthTokens(ctx context.Context, userID string) ([]*UserAuthToken, error)
 	FindUserAuthToken(ctx context.Context, id string) (*UserAuthToken, error)
@@ -138,6 +165,12 @@ type DB interface {
 	UpdateDeploymentAuthTokenUsedOn(ctx context.Context, ids []string) error
 	DeleteExpiredDeploymentAuthTokens(ctx context.Context, retention time.Duration) error
 
+	FindEmbedToken(ctx context.Context, id string) (*EmbedToken, error)
+	FindRevokedEmbedTokens(ctx context.Context) ([]*EmbedToken, error)
+	InsertEmbedToken(ctx context.Context, opts *InsertEmbedTokenOptions) (*EmbedToken, error)
+	UpdateEmbedTokenRevokedOn(ctx context.Context, id string) error
+	DeleteExpiredEmbedTokens(ctx context.Context, retention time.Duration) error
+
 	FindDeviceAuthCodeByDeviceCode(ctx context.Context, deviceCode string) (*DeviceAuthCode, error)
 	FindPendingDeviceAuthCodeByUserCode(ctx context.Context, userCode string) (*DeviceAuthCode, error)
 	InsertDeviceAuthCode(ctx context.Context, deviceCode, userCode, clientID string, expiresOn time.Time) (*DeviceAuthCode, error)
@@ -146,17 +179,30 @@ type DB interface {
 	DeleteExpiredDeviceAuthCodes(ctx context.Context, retention time.Duration) error
 
 	FindOrganizationRole(ctx context.Context, name string) (*OrganizationRole, error)
+	FindOrganizationRoleByID(ctx context.Context, id string) (*OrganizationRole, error)
 	FindProjectRole(ctx context.Context, name string) (*ProjectRole, error)
+	FindProjectRolesForOrganization(ctx context.Context, orgID string) ([]*ProjectRole, error)
+	FindProjectRoleForOrganization(ctx context.Context, orgID, name string) (*ProjectRole, error)
+	InsertProjectRole(ctx context.Context, opts *InsertProjectRoleOptions) (*ProjectRole, error)
+	DeleteProjectRole(ctx context.Context, id string) error
 	ResolveOrganizationRolesForUser(ctx context.Context, userID, orgID string) ([]*OrganizationRole, error)
 	ResolveProjectRolesForUser(ctx context.Context, userID, projectID string) ([]*ProjectRole, error)
 
 	FindOrganizationMemberUsers(ctx context.Context, orgID, afterEmail string, limit int) ([]*Member, error)
+	FindOrganizationMemberUser(ctx context.Context, orgID, userID string) (*Member, error)
 	FindOrganizationMemberUsersByRole(ctx context.Context, orgID, roleID string) ([]*User, error)
 	InsertOrganizationMemberUser(ctx context.Context, orgID, userID, roleID string) error
 	DeleteOrganizationMemberUser(ctx context.Context, orgID, userID string) error
 	UpdateOrganizationMemberUserRole(ctx context.Context, orgID, userID, roleID string) error
 	CountSingleuserOrganizationsForMemberUser(ctx context.Context, userID string) (int, error)
 
+	FindOrganizationUsers(ctx context.Context, orgID string, offset, limit int) ([]*OrganizationUser, error)
+	FindOrganizationUser(ctx context.Context, orgID, userID string) (*OrganizationUser, error)
+	CountOrganizationUsers(ctx context.Context, orgID string) (int, error)
+	CheckUserIsDeactivatedInOrganization(ctx context.Context, userID, orgID string) (bool, error)
+	InsertOrganizationDeactivatedUser(ctx context.Context, orgID, userID, roleID string) error
+	DeleteOrganizationDeactivatedUser(ctx context.Context, orgID, userID string) error
+
 	FindProjectMemberUsers(ctx context.Context, projectID, afterEmail string, limit int) ([]*Member, error)
 	InsertProjectMemberUser(ctx context.Context, projectID, userID, roleID string) error
 	InsertProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error
@@ -190,6 +236,9 @@ type DB interface {
 	UpsertVirtualFile(ctx context.Context, opts *InsertVirtualFileOptions) error
 	UpdateVirtualFileDeleted(ctx context.Context, projectID, branch, path string) error
 	DeleteExpiredVirtualFiles(ctx context.Context, retention time.Duration) error
+
+	FindAuditEvents(ctx context.Context, orgID string, filter *AuditEventFilter, beforeCreatedOn time.Time, beforeID string, limit int) ([]*AuditEvent, error)
+	InsertAuditEvent(ctx context.Context, opts *InsertAuditEventOptions) (*AuditEvent, error)
 }
 
 // Tx represents a database transaction. It can only be used to commit and rollback transactions.
@@ -259,7 +308,7 @@ type Project struct {
 	GithubInstallationID *int64    `db:"github_installation_id"`
 	Subpath              string    `db:"subpath"`
 	ProdBranch           string    `db:"prod_branch"`
-	ProdVariables        Variables `db:"prod_variables"`
+	ProdVariables        Variables `db:"-"` // Decrypted by the driver
 	ProdOLAPDriver       string    `db:"prod_olap_driver"`
 	ProdOLAPDSN          string    `db:"prod_olap_dsn"`
 	ProdSlots            int       `db:"prod_slots"`
@@ -487,6 +536,24 @@ type InsertDeploymentAuthTokenOptions struct {
 	ExpiresOn    *time.Time
 }
 
+// EmbedToken is a runtime JWT issued for embedding specific resources of a project.
+// Only the token's metadata is persisted. It is used to revoke the token before it expires.
+type EmbedToken struct {
+	ID              string
+	ProjectID       string     `db:"project_id"`
+	CreatedByUserID *string    `db:"created_by_user_id"`
+	CreatedOn       time.Time  `db:"created_on"`
+	ExpiresOn       time.Time  `db:"expires_on"`
+	RevokedOn       *time.Time `db:"revoked_on"`
+}
+
+// InsertEmbedTokenOptions defines options for creating an EmbedToken.
+type InsertEmbedTokenOptions struct {
+	ProjectID       string `validate:"required"`
+	CreatedByUserID *string
+	ExpiresOn       time.Time `validate:"required"`
+}
+
 // AuthClient is a client that requests and consumes auth tokens.
 type AuthClient struct {
 	ID          string
@@ -549,8 +616,10 @@ type OrganizationRole struct {
 }
 
 // ProjectRole represents roles for projects.
+// Built-in roles have a nil OrgID. Custom roles are scoped to the org that created them.
 type ProjectRole struct {
 	ID                   string
+	OrgID                *string `db:"org_id"`
 	Name                 string
 	ReadProject          bool `db:"read_project"`
 	ManageProject        bool `db:"manage_project"`
@@ -566,6 +635,24 @@ type ProjectRole struct {
 	ManageReports        bool `db:"manage_reports"`
 }
 
+// InsertProjectRoleOptions defines options for inserting a custom project role.
+type InsertProjectRoleOptions struct {
+	OrgID                string `validate:"required"`
+	Name                 string `validate:"slug"`
+	ReadProject          bool
+	ManageProject        bool
+	ReadProd             bool
+	ReadProdStatus       bool
+	ManageProd           bool
+	ReadDev              bool
+	ReadDevStatus        bool
+	ManageDev            bool
+	ReadProjectMembers   bool
+	ManageProjectMembers bool
+	CreateReports        bool
+	ManageReports        bool
+}
+
 // Member is a convenience type used for display-friendly representation of an org or project member.
 type Member struct {
 	ID          string
@@ -576,6 +663,18 @@ type Member struct {
 	UpdatedOn   time.Time `db:"updated_on"`
 }
 
+// OrganizationUser is a user who is a member of an org or was deactivated in the org by its identity provider.
+// For deactivated users, RoleName is the role they get if they're reactivated (empty if the role was deleted).
+type OrganizationUser struct {
+	ID          string
+	Email       string
+	DisplayName string    `db:"display_name"`
+	RoleName    string    `db:"role_name"`
+	Active      bool      `db:"active"`
+	CreatedOn   time.Time `db:"created_on"`
+	UpdatedOn   time.Time `db:"updated_on"`
+}
+
 // OrganizationInvite represents an outstanding invitation to join an org.
 type OrganizationInvite struct {
 	ID              string
@@ -629,6 +728,66 @@ type OrganizationWhitelistedDomainWithJoinedRoleNames struct {
 	RoleName string `db:"name"`
 }
 
+// OrganizationUserAttributes are custom attributes of a user in an org.
+type OrganizationUserAttributes struct {
+	OrgID      string         `db:"org_id"`
+	UserID     string         `db:"user_id"`
+	Attributes UserAttributes `db:"attributes"`
+	CreatedOn  time.Time      `db:"created_on"`
+	UpdatedOn  time.Time      `db:"updated_on"`
+}
+
+// UserAttributes implements JSON SQL encoding of custom user attributes.
+type UserAttributes map[string]any
+
+func (a *UserAttributes) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &a)
+}
+
+// OrganizationSSOProvider is a custom OIDC identity provider for an org.
+// Users with an email address in one of the org's verified SSO domains log in through it.
+type OrganizationSSOProvider struct {
+	ID               string
+	OrgID            string `db:"org_id"`
+	Issuer           string
+	ClientID         string    `db:"client_id"`
+	ClientSecret     string    `db:"-"` // Decrypted by the driver
+	DefaultOrgRoleID string    `db:"default_org_role_id"`
+	CreatedOn        time.Time `db:"created_on"`
+	UpdatedOn        time.Time `db:"updated_on"`
+}
+
+// OrganizationSSODomain is an email domain whose users must log in through an org's SSO provider.
+// It's only used for SSO once it has been verified. A verified domain belongs to a single org.
+type OrganizationSSODomain struct {
+	ID                string
+	OrgID             string     `db:"org_id"`
+	Domain            string     `db:"domain"`
+	VerificationToken string     `db:"verification_token"`
+	VerifiedOn        *time.Time `db:"verified_on"`
+	CreatedOn         time.Time  `db:"created_on"`
+}
+
+// InsertOrganizationSSODomainOptions defines options for adding an SSO domain to an org.
+type InsertOrganizationSSODomainOptions struct {
+	OrgID             string `validate:"required"`
+	Domain            string `validate:"domain"`
+	VerificationToken string `validate:"required"`
+}
+
+// UpsertOrganizationSSOProviderOptions defines options for setting an org's SSO provider.
+type UpsertOrganizationSSOProviderOptions struct {
+	OrgID            string `validate:"required"`
+	Issuer           string `validate:"required,url"`
+	ClientID         string `validate:"required"`
+	ClientSecret     string `validate:"required"`
+	DefaultOrgRoleID string `validate:"required"`
+}
+
 const (
 	DefaultQuotaProjects           = 5
 	DefaultQuotaDeployments        = 10
@@ -687,3 +846,43 @@ type InsertVirtualFileOptions struct {
 	Path      string `validate:"required"`
 	Data      []byte `validate:"max=8192"` // 8kb
 }
+
+// AuditEvent is an append-only record of a change made through the admin service.
+// Before and After hold JSON objects with the changed fields (with secrets redacted).
+type AuditEvent struct {
+	ID          string
+	OrgID       string    `db:"org_id"`
+	ProjectID   *string   `db:"project_id"`
+	ProjectName string    `db:"project_name"`
+	ActorType   string    `db:"actor_type"`
+	ActorID     string    `db:"actor_id"`
+	ActorName   string    `db:"actor_name"`
+	Action      string    `db:"action"`
+	Target      string    `db:"target"`
+	Before      []byte    `db:"before"`
+	After       []byte    `db:"after"`
+	CreatedOn   time.Time `db:"created_on"`
+}
+
+// InsertAuditEventOptions defines options for inserting an AuditEvent.
+type InsertAuditEventOptions struct {
+	OrgID       string `validate:"required"`
+	ProjectID   *string
+	ProjectName string
+	ActorType   string `validate:"required"`
+	ActorID     string
+	ActorName   string
+	Action      string `validate:"required"`
+	Target      string
+	Before      []byte
+	After       []byte
+}
+
+// AuditEventFilter filters audit events. Empty fields match all events.
+type AuditEventFilter struct {
+	ProjectName string
+	ActorName   string
+	// Action matches events with the exact action or with the action as a prefix, e.g. "project" matches "project.update".
+	Action string
+	Since  time.Time
+}
@@ -0,0 +1,5 @@
+ALTER TABLE project_roles ADD org_id UUID REFERENCES orgs (id) ON DELETE CASCADE;
+
+DROP INDEX project_roles_name_idx;
+CREATE UNIQUE INDEX project_roles_name_idx ON project_roles (lower(name)) WHERE org_id IS NULL;
+CREATE UNIQUE INDEX project_roles_org_name_idx ON project_roles (org_id, lower(name)) WHERE org_id IS NOT NULL;
@@ -0,0 +1,14 @@
+-- Custom OIDC identity providers used for single sign-on into an org.
+-- Logins are routed to an org's provider based on the org's whitelisted email domains.
+CREATE TABLE orgs_sso_providers (
+	id UUID NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(),
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	issuer TEXT NOT NULL,
+	client_id TEXT NOT NULL,
+	client_secret TEXT NOT NULL,
+	default_org_role_id UUID NOT NULL REFERENCES org_roles (id) ON DELETE RESTRICT,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_on TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX orgs_sso_providers_org_id_idx ON orgs_sso_providers (org_id);
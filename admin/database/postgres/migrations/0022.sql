@@ -0,0 +1,10 @@
+-- Custom attributes of users in an org (e.g. region or tenant ID).
+-- They're added to the attributes in the JWTs used to access the org's deployments, so they can be referenced in security policies.
+CREATE TABLE users_orgs_attributes (
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	attributes JSONB NOT NULL DEFAULT '{}'::JSONB,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (org_id, user_id)
+);
@@ -0,0 +1,12 @@
+-- Runtime JWTs issued for embedding specific resources of a project.
+-- The tokens themselves are not stored. Revoked tokens are served to runtimes until they expire.
+CREATE TABLE embed_tokens (
+	id UUID NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(),
+	project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+	created_by_user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	expires_on TIMESTAMPTZ NOT NULL,
+	revoked_on TIMESTAMPTZ
+);
+
+CREATE INDEX embed_tokens_revoked_idx ON embed_tokens (expires_on) WHERE revoked_on IS NOT NULL;
@@ -0,0 +1,5 @@
+-- Project variables are encrypted at rest with the key identified by prod_variables_encryption_key_id.
+-- An empty key ID means the variables are stored as plain JSON (e.g. existing rows or no keyring configured).
+ALTER TABLE projects ALTER COLUMN prod_variables DROP DEFAULT;
+ALTER TABLE projects ALTER COLUMN prod_variables TYPE BYTEA USING convert_to(prod_variables::TEXT, 'UTF8');
+ALTER TABLE projects ADD COLUMN prod_variables_encryption_key_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,10 @@
+-- Users deactivated in an org by the org's identity provider through SCIM.
+-- Deactivated users are removed from the org (which revokes their access), but are kept here so the identity provider can still find them
+-- and reactivate them with their previous role.
+CREATE TABLE orgs_deactivated_users (
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	org_role_id UUID REFERENCES org_roles (id) ON DELETE SET NULL,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (org_id, user_id)
+);
@@ -0,0 +1,4 @@
+-- SSO client secrets are encrypted at rest with the key identified by client_secret_encryption_key_id.
+-- An empty key ID means the secret is stored in plain text (e.g. existing rows or no keyring configured).
+ALTER TABLE orgs_sso_providers ALTER COLUMN client_secret TYPE BYTEA USING convert_to(client_secret, 'UTF8');
+ALTER TABLE orgs_sso_providers ADD COLUMN client_secret_encryption_key_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,14 @@
+-- Email domains whose users must log in through the org's SSO provider.
+-- A domain is only used for SSO after the org proved that it owns the domain (with a DNS TXT record) or a superuser verified it.
+-- A verified domain belongs to a single org, so the SSO provider for an email address is unambiguous.
+CREATE TABLE orgs_sso_domains (
+	id UUID NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(),
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	domain TEXT NOT NULL,
+	verification_token TEXT NOT NULL,
+	verified_on TIMESTAMPTZ,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX orgs_sso_domains_org_id_domain_idx ON orgs_sso_domains (org_id, lower(domain));
+CREATE UNIQUE INDEX orgs_sso_domains_verified_domain_idx ON orgs_sso_domains (lower(domain)) WHERE verified_on IS NOT NULL;
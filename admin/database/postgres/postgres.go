@@ -3,14 +3,17 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/XSAM/otelsql"
 	"github.com/jackc/pgconn"
 	"github.com/jmoiron/sqlx"
 	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/symmetriccrypto"
 	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
 
 	// Load postgres driver
@@ -23,7 +26,12 @@ func init() {
 
 type driver struct{}
 
-func (d driver) Open(dsn string) (database.DB, error) {
+func (d driver) Open(dsn, encKeyring string) (database.DB, error) {
+	keyring, err := symmetriccrypto.ParseKeyring(encKeyring)
+	if err != nil {
+		return nil, err
+	}
+
 	db, err := otelsql.Open("pgx", dsn)
 	if err != nil {
 		return nil, err
@@ -35,11 +43,12 @@ func (d driver) Open(dsn string) (database.DB, error) {
 	}
 
 	dbx := sqlx.NewDb(db, "pgx")
-	return &connection{db: dbx}, nil
+	return &connection{db: dbx, encKeyring: keyring}, nil
 }
 
 type connection struct {
-	db *sqlx.DB
+	db         *sqlx.DB
+	encKeyring *symmetriccrypto.Keyring
 }
 
 func (c *connection) Close() error {
@@ -201,13 +210,188 @@ func (c *connection) DeleteOrganizationWhitelistedDomain(ctx context.Context, id
 	return checkDeleteRow("org whitelist domain", res, err)
 }
 
+func (c *connection) FindOrganizationUserAttributes(ctx context.Context, orgID, userID string) (*database.OrganizationUserAttributes, error) {
+	res := &database.OrganizationUserAttributes{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM users_orgs_attributes WHERE org_id=$1 AND user_id=$2", orgID, userID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("user attributes", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpsertOrganizationUserAttributes(ctx context.Context, orgID, userID string, attributes map[string]any) (*database.OrganizationUserAttributes, error) {
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+
+	res := &database.OrganizationUserAttributes{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO users_orgs_attributes (org_id, user_id, attributes)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (org_id, user_id) DO UPDATE SET
+			attributes = EXCLUDED.attributes,
+			updated_on = now()
+		RETURNING *
+	`, orgID, userID, database.UserAttributes(attributes)).StructScan(res)
+	if err != nil {
+		return nil, parseErr("user attributes", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindOrganizationSSOProvider(ctx context.Context, orgID string) (*database.OrganizationSSOProvider, error) {
+	res := &ssoProviderDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM orgs_sso_providers WHERE org_id=$1", orgID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org SSO provider", err)
+	}
+	return c.ssoProviderFromDTO(res)
+}
+
+// FindOrganizationSSOProviderForDomain finds the SSO provider of the org that verified the domain. Unverified domains are ignored.
+func (c *connection) FindOrganizationSSOProviderForDomain(ctx context.Context, domain string) (*database.OrganizationSSOProvider, error) {
+	res := &ssoProviderDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT p.* FROM orgs_sso_providers p
+		JOIN orgs_sso_domains d ON d.org_id = p.org_id
+		WHERE lower(d.domain)=lower($1) AND d.verified_on IS NOT NULL
+	`, domain).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org SSO provider", err)
+	}
+	return c.ssoProviderFromDTO(res)
+}
+
+func (c *connection) UpsertOrganizationSSOProvider(ctx context.Context, opts *database.UpsertOrganizationSSOProviderOptions) (*database.OrganizationSSOProvider, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	clientSecret, clientSecretKeyID, err := c.encKeyring.Encrypt([]byte(opts.ClientSecret))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ssoProviderDTO{}
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO orgs_sso_providers (org_id, issuer, client_id, client_secret, client_secret_encryption_key_id, default_org_role_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (org_id) DO UPDATE SET
+			issuer = EXCLUDED.issuer,
+			client_id = EXCLUDED.client_id,
+			client_secret = EXCLUDED.client_secret,
+			client_secret_encryption_key_id = EXCLUDED.client_secret_encryption_key_id,
+			default_org_role_id = EXCLUDED.default_org_role_id,
+			updated_on = now()
+		RETURNING *
+	`, opts.OrgID, opts.Issuer, opts.ClientID, clientSecret, clientSecretKeyID, opts.DefaultOrgRoleID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org SSO provider", err)
+	}
+	return c.ssoProviderFromDTO(res)
+}
+
+// ReencryptOrganizationSSOProviders re-encrypts the client secrets of up to limit SSO providers that are not encrypted with the current encryption key.
+// It returns the number of providers that were updated. It is used to rotate encryption keys.
+func (c *connection) ReencryptOrganizationSSOProviders(ctx context.Context, limit int) (int, error) {
+	var dtos []*ssoProviderDTO
+	err := c.getDB(ctx).SelectContext(ctx, &dtos, "SELECT * FROM orgs_sso_providers WHERE client_secret_encryption_key_id <> $1 ORDER BY id LIMIT $2", c.encKeyring.CurrentKeyID(), limit)
+	if err != nil {
+		return 0, parseErr("org SSO providers", err)
+	}
+
+	n := 0
+	for _, dto := range dtos {
+		p, err := c.ssoProviderFromDTO(dto)
+		if err != nil {
+			return n, err
+		}
+
+		clientSecret, clientSecretKeyID, err := c.encKeyring.Encrypt([]byte(p.ClientSecret))
+		if err != nil {
+			return n, err
+		}
+
+		// Skip the provider if it was updated concurrently
+		res, err := c.getDB(ctx).ExecContext(ctx, `
+			UPDATE orgs_sso_providers SET client_secret=$1, client_secret_encryption_key_id=$2
+			WHERE id=$3 AND client_secret_encryption_key_id=$4`,
+			clientSecret, clientSecretKeyID, p.ID, dto.ClientSecretEncryptionKeyID,
+		)
+		if err != nil {
+			return n, parseErr("org SSO provider", err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return n, parseErr("org SSO provider", err)
+		}
+		n += int(affected)
+	}
+
+	return n, nil
+}
+
+func (c *connection) DeleteOrganizationSSOProvider(ctx context.Context, orgID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM orgs_sso_providers WHERE org_id=$1", orgID)
+	return checkDeleteRow("org SSO provider", res, err)
+}
+
+func (c *connection) FindOrganizationSSODomains(ctx context.Context, orgID string) ([]*database.OrganizationSSODomain, error) {
+	var res []*database.OrganizationSSODomain
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM orgs_sso_domains WHERE org_id=$1 ORDER BY lower(domain)", orgID)
+	if err != nil {
+		return nil, parseErr("org SSO domains", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindOrganizationSSODomain(ctx context.Context, orgID, domain string) (*database.OrganizationSSODomain, error) {
+	res := &database.OrganizationSSODomain{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM orgs_sso_domains WHERE org_id=$1 AND lower(domain)=lower($2)", orgID, domain).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org SSO domain", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertOrganizationSSODomain(ctx context.Context, opts *database.InsertOrganizationSSODomainOptions) (*database.OrganizationSSODomain, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.OrganizationSSODomain{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO orgs_sso_domains (org_id, domain, verification_token) VALUES ($1, $2, $3) RETURNING *
+	`, opts.OrgID, opts.Domain, opts.VerificationToken).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org SSO domain", err)
+	}
+	return res, nil
+}
+
+// UpdateOrganizationSSODomainVerified marks an SSO domain as verified.
+// It returns an error that wraps database.ErrNotUnique if the domain is already verified for another org.
+func (c *connection) UpdateOrganizationSSODomainVerified(ctx context.Context, id string) (*database.OrganizationSSODomain, error) {
+	res := &database.OrganizationSSODomain{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE orgs_sso_domains SET verified_on=now() WHERE id=$1 RETURNING *", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org SSO domain", err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteOrganizationSSODomain(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM orgs_sso_domains WHERE id=$1", id)
+	return checkDeleteRow("org SSO domain", res, err)
+}
+
 func (c *connection) FindProjects(ctx context.Context, afterName string, limit int) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE lower(name) > lower($1) ORDER BY lower(p.name) LIMIT $2", afterName, limit)
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProjectPathsByPattern(ctx context.Context, namePattern, afterName string, limit int) ([]string, error) {
@@ -223,7 +407,7 @@ func (c *connection) FindProjectPathsByPattern(ctx context.Context, namePattern,
 }
 
 func (c *connection) FindProjectsForUser(ctx context.Context, userID string) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT p.* FROM projects p JOIN users_projects_roles upr ON p.id = upr.project_id
 		WHERE upr.user_id = $1
@@ -235,11 +419,11 @@ func (c *connection) FindProjectsForUser(ctx context.Context, userID string) ([]
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProjectsForOrganization(ctx context.Context, orgID, afterProjectName string, limit int) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT p.* FROM projects p
 		WHERE p.org_id=$1 AND lower(p.name) > lower($2)
@@ -248,11 +432,11 @@ func (c *connection) FindProjectsForOrganization(ctx context.Context, orgID, aft
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProjectsForOrgAndUser(ctx context.Context, orgID, userID, afterProjectName string, limit int) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT p.* FROM projects p
 		WHERE p.org_id = $1 AND lower(p.name) > lower($2) AND (p.public = true OR p.id IN (
@@ -264,11 +448,11 @@ func (c *connection) FindProjectsForOrgAndUser(ctx context.Context, orgID, userI
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindPublicProjectsInOrganization(ctx context.Context, orgID, afterProjectName string, limit int) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT p.* FROM projects p 
 		WHERE p.org_id = $1 AND p.public = true AND lower(p.name) > lower($2)
@@ -277,43 +461,43 @@ func (c *connection) FindPublicProjectsInOrganization(ctx context.Context, orgID
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProjectsByGithubURL(ctx context.Context, githubURL string) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE lower(p.github_url)=lower($1) ", githubURL)
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProjectsByGithubInstallationID(ctx context.Context, id int64) ([]*database.Project, error) {
-	var res []*database.Project
+	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE p.github_installation_id=$1", id)
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return res, nil
+	return c.projectsFromDTOs(res)
 }
 
 func (c *connection) FindProject(ctx context.Context, id string) (*database.Project, error) {
-	res := &database.Project{}
+	res := &projectDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM projects WHERE id=$1", id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res, nil
+	return c.projectFromDTO(res)
 }
 
 func (c *connection) FindProjectByName(ctx context.Context, orgName, name string) (*database.Project, error) {
-	res := &database.Project{}
+	res := &projectDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT p.* FROM projects p JOIN orgs o ON p.org_id = o.id WHERE lower(p.name)=lower($1) AND lower(o.name)=lower($2)", name, orgName).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res, nil
+	return c.projectFromDTO(res)
 }
 
 func (c *connection) InsertProject(ctx context.Context, opts *database.InsertProjectOptions) (*database.Project, error) {
@@ -321,16 +505,21 @@ func (c *connection) InsertProject(ctx context.Context, opts *database.InsertPro
 		return nil, err
 	}
 
-	res := &database.Project{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO projects (org_id, name, description, public, region, prod_olap_driver, prod_olap_dsn, prod_slots, subpath, prod_branch, prod_variables, github_url, github_installation_id, prod_ttl_seconds)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING *`,
-		opts.OrganizationID, opts.Name, opts.Description, opts.Public, opts.Region, opts.ProdOLAPDriver, opts.ProdOLAPDSN, opts.ProdSlots, opts.Subpath, opts.ProdBranch, database.Variables(opts.ProdVariables), opts.GithubURL, opts.GithubInstallationID, opts.ProdTTLSeconds,
+	prodVariables, prodVariablesKeyID, err := c.encryptVariables(opts.ProdVariables)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &projectDTO{}
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO projects (org_id, name, description, public, region, prod_olap_driver, prod_olap_dsn, prod_slots, subpath, prod_branch, prod_variables, prod_variables_encryption_key_id, github_url, github_installation_id, prod_ttl_seconds)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) RETURNING *`,
+		opts.OrganizationID, opts.Name, opts.Description, opts.Public, opts.Region, opts.ProdOLAPDriver, opts.ProdOLAPDSN, opts.ProdSlots, opts.Subpath, opts.ProdBranch, prodVariables, prodVariablesKeyID, opts.GithubURL, opts.GithubInstallationID, opts.ProdTTLSeconds,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res, nil
+	return c.projectFromDTO(res)
 }
 
 func (c *connection) DeleteProject(ctx context.Context, id string) error {
@@ -343,16 +532,61 @@ func (c *connection) UpdateProject(ctx context.Context, id string, opts *databas
 		return nil, err
 	}
 
-	res := &database.Project{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, github_url=$6, github_installation_id=$7, prod_deployment_id=$8, region=$9, prod_slots=$10, prod_ttl_seconds=$11, updated_on=now()
-		WHERE id=$12 RETURNING *`,
-		opts.Name, opts.Description, opts.Public, opts.ProdBranch, database.Variables(opts.ProdVariables), opts.GithubURL, opts.GithubInstallationID, opts.ProdDeploymentID, opts.Region, opts.ProdSlots, opts.ProdTTLSeconds, id,
+	prodVariables, prodVariablesKeyID, err := c.encryptVariables(opts.ProdVariables)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &projectDTO{}
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, prod_variables_encryption_key_id=$6, github_url=$7, github_installation_id=$8, prod_deployment_id=$9, region=$10, prod_slots=$11, prod_ttl_seconds=$12, updated_on=now()
+		WHERE id=$13 RETURNING *`,
+		opts.Name, opts.Description, opts.Public, opts.ProdBranch, prodVariables, prodVariablesKeyID, opts.GithubURL, opts.GithubInstallationID, opts.ProdDeploymentID, opts.Region, opts.ProdSlots, opts.ProdTTLSeconds, id,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res, nil
+	return c.projectFromDTO(res)
+}
+
+// ReencryptProjectVariables re-encrypts the variables of up to limit projects that are not encrypted with the current encryption key.
+// It returns the number of projects that were updated. It is used to rotate encryption keys.
+func (c *connection) ReencryptProjectVariables(ctx context.Context, limit int) (int, error) {
+	var dtos []*projectDTO
+	err := c.getDB(ctx).SelectContext(ctx, &dtos, "SELECT * FROM projects WHERE prod_variables_encryption_key_id <> $1 ORDER BY id LIMIT $2", c.encKeyring.CurrentKeyID(), limit)
+	if err != nil {
+		return 0, parseErr("projects", err)
+	}
+
+	n := 0
+	for _, dto := range dtos {
+		proj, err := c.projectFromDTO(dto)
+		if err != nil {
+			return n, err
+		}
+
+		prodVariables, prodVariablesKeyID, err := c.encryptVariables(proj.ProdVariables)
+		if err != nil {
+			return n, err
+		}
+
+		// Skip the project if its variables were updated concurrently
+		res, err := c.getDB(ctx).ExecContext(ctx, `
+			UPDATE projects SET prod_variables=$1, prod_variables_encryption_key_id=$2
+			WHERE id=$3 AND prod_variables_encryption_key_id=$4`,
+			prodVariables, prodVariablesKeyID, proj.ID, dto.ProdVariablesEncryptionKeyID,
+		)
+		if err != nil {
+			return n, parseErr("project", err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return n, parseErr("project", err)
+		}
+		n += int(affected)
+	}
+
+	return n, nil
 }
 
 func (c *connection) CountProjectsForOrganization(ctx context.Context, orgID string) (int, error) {
@@ -592,6 +826,63 @@ func (c *connection) CheckUserIsAnOrganizationMember(ctx context.Context, userID
 	return res, nil
 }
 
+func (c *connection) FindUsergroupsForOrganization(ctx context.Context, orgID, afterName string, limit int) ([]*database.Usergroup, error) {
+	var res []*database.Usergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT * FROM usergroups
+		WHERE org_id = $1 AND lower(name) > lower($2)
+		ORDER BY lower(name) LIMIT $3
+	`, orgID, afterName, limit)
+	if err != nil {
+		return nil, parseErr("usergroups", err)
+	}
+	return res, nil
+}
+
+// FindCustomUsergroupsForOrganization returns the org's user groups except its all-users group, which is managed automatically.
+func (c *connection) FindCustomUsergroupsForOrganization(ctx context.Context, orgID string, offset, limit int) ([]*database.Usergroup, error) {
+	var res []*database.Usergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT ug.* FROM usergroups ug JOIN orgs o ON o.id = ug.org_id
+		WHERE ug.org_id = $1 AND ug.id IS DISTINCT FROM o.all_usergroup_id
+		ORDER BY lower(ug.name) OFFSET $2 LIMIT $3
+	`, orgID, offset, limit)
+	if err != nil {
+		return nil, parseErr("usergroups", err)
+	}
+	return res, nil
+}
+
+func (c *connection) CountCustomUsergroupsForOrganization(ctx context.Context, orgID string) (int, error) {
+	var count int
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT COUNT(*) FROM usergroups ug JOIN orgs o ON o.id = ug.org_id
+		WHERE ug.org_id = $1 AND ug.id IS DISTINCT FROM o.all_usergroup_id
+	`, orgID).Scan(&count)
+	if err != nil {
+		return 0, parseErr("usergroups", err)
+	}
+	return count, nil
+}
+
+func (c *connection) FindUsergroup(ctx context.Context, id string) (*database.Usergroup, error) {
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM usergroups WHERE id = $1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindUsergroupByName(ctx context.Context, orgID, name string) (*database.Usergroup, error) {
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM usergroups WHERE org_id = $1 AND lower(name) = lower($2)", orgID, name).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertUsergroup(ctx context.Context, opts *database.InsertUsergroupOptions) (*database.Usergroup, error) {
 	if err := database.Validate(opts); err != nil {
 		return nil, err
@@ -607,6 +898,36 @@ func (c *connection) InsertUsergroup(ctx context.Context, opts *database.InsertU
 	return res, nil
 }
 
+func (c *connection) UpdateUsergroupName(ctx context.Context, name, groupID string) (*database.Usergroup, error) {
+	if err := database.Validate(&database.InsertUsergroupOptions{Name: name}); err != nil {
+		return nil, err
+	}
+
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE usergroups SET name = $1 WHERE id = $2 RETURNING *", name, groupID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteUsergroup(ctx context.Context, groupID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups WHERE id = $1", groupID)
+	return checkDeleteRow("usergroup", res, err)
+}
+
+func (c *connection) FindUsergroupMemberUsers(ctx context.Context, groupID string) ([]*database.User, error) {
+	var res []*database.User
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT u.* FROM users u JOIN usergroups_users uug ON u.id = uug.user_id
+		WHERE uug.usergroup_id = $1 ORDER BY lower(u.email)
+	`, groupID)
+	if err != nil {
+		return nil, parseErr("usergroup members", err)
+	}
+	return res, nil
+}
+
 func (c *connection) FindUsergroupsForUser(ctx context.Context, userID, orgID string) ([]*database.Usergroup, error) {
 	var res []*database.Usergroup
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
@@ -632,6 +953,14 @@ func (c *connection) DeleteUsergroupMember(ctx context.Context, groupID, userID
 	return checkDeleteRow("usergroup member", res, err)
 }
 
+func (c *connection) DeleteAllUsergroupMemberUserForOrganization(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups_users ugu WHERE ugu.user_id = $1 AND ugu.usergroup_id IN (SELECT ug.id FROM usergroups ug WHERE ug.org_id = $2)", userID, orgID)
+	if err != nil {
+		return parseErr("usergroup member", err)
+	}
+	return nil
+}
+
 func (c *connection) FindUserAuthTokens(ctx context.Context, userID string) ([]*database.UserAuthToken, error) {
 	var res []*database.UserAuthToken
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT t.* FROM user_auth_tokens t WHERE t.user_id=$1", userID)
@@ -866,6 +1195,52 @@ func (c *connection) DeleteExpiredDeploymentAuthTokens(ctx context.Context, rete
 	return parseErr("deployment auth token", err)
 }
 
+func (c *connection) FindEmbedToken(ctx context.Context, id string) (*database.EmbedToken, error) {
+	res := &database.EmbedToken{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM embed_tokens WHERE id=$1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("embed token", err)
+	}
+	return res, nil
+}
+
+// FindRevokedEmbedTokens returns the revoked embed tokens that haven't expired yet.
+func (c *connection) FindRevokedEmbedTokens(ctx context.Context) ([]*database.EmbedToken, error) {
+	var res []*database.EmbedToken
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM embed_tokens WHERE revoked_on IS NOT NULL AND expires_on > now()")
+	if err != nil {
+		return nil, parseErr("embed tokens", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertEmbedToken(ctx context.Context, opts *database.InsertEmbedTokenOptions) (*database.EmbedToken, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.EmbedToken{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO embed_tokens (project_id, created_by_user_id, expires_on)
+		VALUES ($1, $2, $3) RETURNING *`,
+		opts.ProjectID, opts.CreatedByUserID, opts.ExpiresOn,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("embed token", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateEmbedTokenRevokedOn(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "UPDATE embed_tokens SET revoked_on=COALESCE(revoked_on, now()) WHERE id=$1", id)
+	return checkUpdateRow("embed token", res, err)
+}
+
+func (c *connection) DeleteExpiredEmbedTokens(ctx context.Context, retention time.Duration) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM embed_tokens WHERE expires_on + $1 < now()", retention)
+	return parseErr("embed token", err)
+}
+
 func (c *connection) FindDeviceAuthCodeByDeviceCode(ctx context.Context, deviceCode string) (*database.DeviceAuthCode, error) {
 	authCode := &database.DeviceAuthCode{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM device_auth_codes WHERE device_code = $1", deviceCode).StructScan(authCode)
@@ -919,15 +1294,69 @@ func (c *connection) FindOrganizationRole(ctx context.Context, name string) (*da
 	return role, nil
 }
 
+func (c *connection) FindOrganizationRoleByID(ctx context.Context, id string) (*database.OrganizationRole, error) {
+	role := &database.OrganizationRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM org_roles WHERE id=$1", id).StructScan(role)
+	if err != nil {
+		return nil, parseErr("org role", err)
+	}
+	return role, nil
+}
+
 func (c *connection) FindProjectRole(ctx context.Context, name string) (*database.ProjectRole, error) {
 	role := &database.ProjectRole{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM project_roles WHERE lower(name)=lower($1)", name).StructScan(role)
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM project_roles WHERE lower(name)=lower($1) AND org_id IS NULL", name).StructScan(role)
 	if err != nil {
 		return nil, parseErr("project role", err)
 	}
 	return role, nil
 }
 
+func (c *connection) FindProjectRolesForOrganization(ctx context.Context, orgID string) ([]*database.ProjectRole, error) {
+	var res []*database.ProjectRole
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT * FROM project_roles WHERE org_id IS NULL OR org_id = $1
+		ORDER BY org_id NULLS FIRST, lower(name)
+	`, orgID)
+	if err != nil {
+		return nil, parseErr("project roles", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindProjectRoleForOrganization(ctx context.Context, orgID, name string) (*database.ProjectRole, error) {
+	role := &database.ProjectRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT * FROM project_roles WHERE lower(name)=lower($2) AND (org_id IS NULL OR org_id = $1)
+		ORDER BY org_id NULLS FIRST LIMIT 1
+	`, orgID, name).StructScan(role)
+	if err != nil {
+		return nil, parseErr("project role", err)
+	}
+	return role, nil
+}
+
+func (c *connection) InsertProjectRole(ctx context.Context, opts *database.InsertProjectRoleOptions) (*database.ProjectRole, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.ProjectRole{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO project_roles (org_id, name, read_project, manage_project, read_prod, read_prod_status, manage_prod, read_dev, read_dev_status, manage_dev, read_project_members, manage_project_members, create_reports, manage_reports)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING *
+	`, opts.OrgID, opts.Name, opts.ReadProject, opts.ManageProject, opts.ReadProd, opts.ReadProdStatus, opts.ManageProd, opts.ReadDev, opts.ReadDevStatus, opts.ManageDev, opts.ReadProjectMembers, opts.ManageProjectMembers, opts.CreateReports, opts.ManageReports).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project role", err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteProjectRole(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM project_roles WHERE id=$1 AND org_id IS NOT NULL", id)
+	return checkDeleteRow("project role", res, err)
+}
+
 func (c *connection) ResolveOrganizationRolesForUser(ctx context.Context, userID, orgID string) ([]*database.OrganizationRole, error) {
 	var res []*database.OrganizationRole
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
@@ -977,6 +1406,20 @@ func (c *connection) FindOrganizationMemberUsers(ctx context.Context, orgID, aft
 	return res, nil
 }
 
+func (c *connection) FindOrganizationMemberUser(ctx context.Context, orgID, userID string) (*database.Member, error) {
+	res := &database.Member{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT u.id, u.email, u.display_name, u.created_on, u.updated_on, r.name FROM users u
+		JOIN users_orgs_roles uor ON u.id = uor.user_id
+		JOIN org_roles r ON r.id = uor.org_role_id
+		WHERE uor.org_id=$1 AND u.id=$2
+	`, orgID, userID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org member", err)
+	}
+	return res, nil
+}
+
 func (c *connection) FindOrganizationMemberUsersByRole(ctx context.Context, orgID, roleID string) ([]*database.User, error) {
 	var res []*database.User
 	err := c.getDB(ctx).SelectContext(
@@ -1027,6 +1470,78 @@ func (c *connection) CountSingleuserOrganizationsForMemberUser(ctx context.Conte
 	return count, nil
 }
 
+// organizationUsersQuery selects the members of the org $1 and the users deactivated in it.
+// A deactivated user who was added to the org again is only returned as a member.
+const organizationUsersQuery = `
+	SELECT * FROM (
+		SELECT u.id, u.email, u.display_name, u.created_on, u.updated_on, r.name AS role_name, true AS active FROM users u
+		JOIN users_orgs_roles uor ON u.id = uor.user_id
+		JOIN org_roles r ON r.id = uor.org_role_id
+		WHERE uor.org_id = $1
+		UNION ALL
+		SELECT u.id, u.email, u.display_name, u.created_on, u.updated_on, COALESCE(r.name, '') AS role_name, false AS active FROM users u
+		JOIN orgs_deactivated_users odu ON u.id = odu.user_id
+		LEFT JOIN org_roles r ON r.id = odu.org_role_id
+		WHERE odu.org_id = $1 AND NOT EXISTS (SELECT 1 FROM users_orgs_roles uor WHERE uor.org_id = $1 AND uor.user_id = u.id)
+	) ou
+`
+
+func (c *connection) FindOrganizationUsers(ctx context.Context, orgID string, offset, limit int) ([]*database.OrganizationUser, error) {
+	var res []*database.OrganizationUser
+	err := c.getDB(ctx).SelectContext(ctx, &res, organizationUsersQuery+" ORDER BY lower(ou.email), ou.id OFFSET $2 LIMIT $3", orgID, offset, limit)
+	if err != nil {
+		return nil, parseErr("org users", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindOrganizationUser(ctx context.Context, orgID, userID string) (*database.OrganizationUser, error) {
+	res := &database.OrganizationUser{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, organizationUsersQuery+" WHERE ou.id = $2", orgID, userID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org user", err)
+	}
+	return res, nil
+}
+
+func (c *connection) CountOrganizationUsers(ctx context.Context, orgID string) (int, error) {
+	var count int
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT COUNT(*) FROM ("+organizationUsersQuery+") c", orgID).Scan(&count)
+	if err != nil {
+		return 0, parseErr("org users", err)
+	}
+	return count, nil
+}
+
+func (c *connection) CheckUserIsDeactivatedInOrganization(ctx context.Context, userID, orgID string) (bool, error) {
+	var res bool
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT EXISTS (SELECT 1 FROM orgs_deactivated_users WHERE user_id=$1 AND org_id=$2)", userID, orgID).Scan(&res)
+	if err != nil {
+		return false, parseErr("check", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertOrganizationDeactivatedUser(ctx context.Context, orgID, userID, roleID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, `
+		INSERT INTO orgs_deactivated_users (org_id, user_id, org_role_id) VALUES ($1, $2, $3)
+		ON CONFLICT (org_id, user_id) DO UPDATE SET org_role_id = EXCLUDED.org_role_id
+	`, orgID, userID, roleID)
+	if err != nil {
+		return parseErr("deactivated org user", err)
+	}
+	return nil
+}
+
+// DeleteOrganizationDeactivatedUser does not return an error if the user wasn't deactivated in the org.
+func (c *connection) DeleteOrganizationDeactivatedUser(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM orgs_deactivated_users WHERE org_id = $1 AND user_id = $2", orgID, userID)
+	if err != nil {
+		return parseErr("deactivated org user", err)
+	}
+	return nil
+}
+
 func (c *connection) FindProjectMemberUsers(ctx context.Context, projectID, afterEmail string, limit int) ([]*database.Member, error) {
 	var res []*database.Member
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
@@ -1311,6 +1826,64 @@ func (c *connection) DeleteExpiredVirtualFiles(ctx context.Context, retention ti
 	return parseErr("virtual files", err)
 }
 
+// FindAuditEvents returns the org's audit events, newest first.
+// If beforeCreatedOn is non-zero, it only returns events created before the event identified by beforeCreatedOn and beforeID.
+func (c *connection) FindAuditEvents(ctx context.Context, orgID string, filter *database.AuditEventFilter, beforeCreatedOn time.Time, beforeID string, limit int) ([]*database.AuditEvent, error) {
+	var where strings.Builder
+	args := []any{orgID}
+	where.WriteString("org_id=$1")
+	addArg := func(arg any) string {
+		args = append(args, arg)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter != nil {
+		if filter.ProjectName != "" {
+			fmt.Fprintf(&where, " AND lower(project_name)=lower(%s)", addArg(filter.ProjectName))
+		}
+		if filter.ActorName != "" {
+			fmt.Fprintf(&where, " AND lower(actor_name)=lower(%s)", addArg(filter.ActorName))
+		}
+		if filter.Action != "" {
+			arg := addArg(filter.Action)
+			fmt.Fprintf(&where, " AND (action=%s OR starts_with(action, %s || '.'))", arg, arg)
+		}
+		if !filter.Since.IsZero() {
+			fmt.Fprintf(&where, " AND created_on>=%s", addArg(filter.Since))
+		}
+	}
+	if !beforeCreatedOn.IsZero() {
+		ts := addArg(beforeCreatedOn)
+		fmt.Fprintf(&where, " AND (created_on<%s OR created_on=%s AND id<%s::UUID)", ts, ts, addArg(beforeID))
+	}
+
+	var res []*database.AuditEvent
+	err := c.getDB(ctx).SelectContext(ctx, &res, fmt.Sprintf(`
+		SELECT * FROM audit_events WHERE %s ORDER BY created_on DESC, id DESC LIMIT %s
+	`, where.String(), addArg(limit)), args...)
+	if err != nil {
+		return nil, parseErr("audit events", err)
+	}
+	return res, nil
+}
+
+// InsertAuditEvent inserts an audit event.
+func (c *connection) InsertAuditEvent(ctx context.Context, opts *database.InsertAuditEventOptions) (*database.AuditEvent, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.AuditEvent{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO audit_events (org_id, project_id, project_name, actor_type, actor_id, actor_name, action, target, before, after)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING *
+	`, opts.OrgID, opts.ProjectID, opts.ProjectName, opts.ActorType, opts.ActorID, opts.ActorName, opts.Action, opts.Target, opts.Before, opts.After).StructScan(res)
+	if err != nil {
+		return nil, parseErr("audit event", err)
+	}
+	return res, nil
+}
+
 func checkUpdateRow(target string, res sql.Result, err error) error {
 	if err != nil {
 		return parseErr(target, err)
@@ -1391,6 +1964,12 @@ func parseErr(target string, err error) error {
 			return newAlreadyExistsErr("email has already been invited to the project")
 		case "orgs_autoinvite_domains_org_id_domain_idx":
 			return newAlreadyExistsErr("domain has already been added for the org")
+		case "orgs_sso_domains_org_id_domain_idx":
+			return newAlreadyExistsErr("SSO domain has already been added for the org")
+		case "orgs_sso_domains_verified_domain_idx":
+			return newAlreadyExistsErr("SSO domain has already been verified for another org")
+		case "project_roles_org_name_idx":
+			return newAlreadyExistsErr("a role with that name already exists in the org")
 		case "service_name_idx":
 			return newAlreadyExistsErr("a service with that name already exists in the org")
 		case "virtual_files_pkey":
@@ -1405,6 +1984,73 @@ func parseErr(target string, err error) error {
 	return err
 }
 
+// projectDTO is used to scan projects. It holds the raw variables, which may be encrypted.
+type projectDTO struct {
+	database.Project
+	ProdVariables                []byte `db:"prod_variables"`
+	ProdVariablesEncryptionKeyID string `db:"prod_variables_encryption_key_id"`
+}
+
+// projectFromDTO decrypts the variables of a projectDTO and returns the project.
+func (c *connection) projectFromDTO(dto *projectDTO) (*database.Project, error) {
+	data, err := c.encKeyring.Decrypt(dto.ProdVariables, dto.ProdVariablesEncryptionKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt variables of project %q: %w", dto.Project.ID, err)
+	}
+
+	err = json.Unmarshal(data, &dto.Project.ProdVariables)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.Project, nil
+}
+
+func (c *connection) projectsFromDTOs(dtos []*projectDTO) ([]*database.Project, error) {
+	res := make([]*database.Project, len(dtos))
+	for i, dto := range dtos {
+		var err error
+		res[i], err = c.projectFromDTO(dto)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// encryptVariables serializes variables and encrypts them with the current encryption key.
+// It returns the encrypted data and the ID of the key (empty if the keyring is empty and the data is not encrypted).
+func (c *connection) encryptVariables(vars map[string]string) ([]byte, string, error) {
+	if vars == nil {
+		vars = map[string]string{}
+	}
+
+	data, err := json.Marshal(vars)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return c.encKeyring.Encrypt(data)
+}
+
+// ssoProviderDTO is used to scan SSO providers. It holds the raw client secret, which may be encrypted.
+type ssoProviderDTO struct {
+	database.OrganizationSSOProvider
+	ClientSecret                []byte `db:"client_secret"`
+	ClientSecretEncryptionKeyID string `db:"client_secret_encryption_key_id"`
+}
+
+// ssoProviderFromDTO decrypts the client secret of an ssoProviderDTO and returns the provider.
+func (c *connection) ssoProviderFromDTO(dto *ssoProviderDTO) (*database.OrganizationSSOProvider, error) {
+	data, err := c.encKeyring.Decrypt(dto.ClientSecret, dto.ClientSecretEncryptionKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt client secret of the SSO provider for org %q: %w", dto.OrgID, err)
+	}
+
+	dto.OrganizationSSOProvider.ClientSecret = string(data)
+	return &dto.OrganizationSSOProvider, nil
+}
+
 func newAlreadyExistsErr(msg string) error {
 	// wrap database.ErrNotUnique so checks with errors.Is(...) still work
 	return &wrappedError{msg: msg, err: database.ErrNotUnique}
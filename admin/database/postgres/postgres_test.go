@@ -10,6 +10,12 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const (
+	testKeyring1 = `[{"key_id":"k1","secret":"MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="}]`
+	testKeyring2 = `[{"key_id":"k2","secret":"ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="},{"key_id":"k1","secret":"MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="}]`
+	testKeyring3 = `[{"key_id":"k1","secret":"MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="},{"key_id":"k2","secret":"ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="}]`
+)
+
 // TestPostgres starts Postgres using testcontainers and runs all other tests in
 // this file as sub-tests (to prevent spawning many clusters).
 func TestPostgres(t *testing.T) {
@@ -18,7 +24,7 @@ func TestPostgres(t *testing.T) {
 	pg := pgtestcontainer.New(t)
 	defer pg.Terminate(t)
 
-	db, err := database.Open("postgres", pg.DatabaseURL)
+	db, err := database.Open("postgres", pg.DatabaseURL, testKeyring1)
 	require.NoError(t, err)
 	require.NotNil(t, db)
 
@@ -28,11 +34,18 @@ func TestPostgres(t *testing.T) {
 	t.Run("TestProjects", func(t *testing.T) { testProjects(t, db) })
 	// Add new tests here
 	t.Run("TestProjectsWithVariables", func(t *testing.T) { testProjectsWithVariables(t, db) })
+	t.Run("TestProjectVariablesEncryption", func(t *testing.T) { testProjectVariablesEncryption(t, db, pg.DatabaseURL) })
 
 	t.Run("TestOrgsWithPagination", func(t *testing.T) { testOrgsWithPagination(t, db) })
 	t.Run("TestProjectsWithPagination", func(t *testing.T) { testProjectsWithPagination(t, db) })
 	t.Run("TestProjectsForUsersWithPagination", func(t *testing.T) { testProjectsForUserWithPagination(t, db) })
 	t.Run("TestMembersWithPagination", func(t *testing.T) { testOrgsMembersPagination(t, db) })
+	t.Run("TestUsergroups", func(t *testing.T) { testUsergroups(t, db) })
+	t.Run("TestProjectRoles", func(t *testing.T) { testProjectRoles(t, db) })
+	t.Run("TestAuditEvents", func(t *testing.T) { testAuditEvents(t, db) })
+	t.Run("TestOrganizationSSOProviders", func(t *testing.T) { testOrganizationSSOProviders(t, db) })
+	t.Run("TestOrganizationUserAttributes", func(t *testing.T) { testOrganizationUserAttributes(t, db) })
+	t.Run("TestEmbedTokens", func(t *testing.T) { testEmbedTokens(t, db) })
 
 	require.NoError(t, db.Close())
 }
@@ -166,6 +179,63 @@ func testProjectsWithVariables(t *testing.T, db database.DB) {
 	require.Equal(t, database.Variables(opts.ProdVariables), proj.ProdVariables)
 }
 
+func testProjectVariablesEncryption(t *testing.T, db database.DB, dsn string) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "encrypted"})
+	require.NoError(t, err)
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{
+		OrganizationID: org.ID,
+		Name:           "secret",
+		ProdVariables:  map[string]string{"password": "hunter2"},
+	})
+	require.NoError(t, err)
+
+	// The variables must not be stored in plain text
+	var raw []byte
+	var keyID string
+	err = db.(*connection).db.QueryRowxContext(ctx, "SELECT prod_variables, prod_variables_encryption_key_id FROM projects WHERE id=$1", proj.ID).Scan(&raw, &keyID)
+	require.NoError(t, err)
+	require.Equal(t, "k1", keyID)
+	require.NotContains(t, string(raw), "hunter2")
+
+	// Rotate the key
+	db2, err := database.Open("postgres", dsn, testKeyring2)
+	require.NoError(t, err)
+	defer db2.Close()
+
+	proj, err = db2.FindProject(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", proj.ProdVariables["password"])
+
+	n, err := db2.ReencryptProjectVariables(ctx, 1000)
+	require.NoError(t, err)
+	require.Greater(t, n, 0)
+
+	n, err = db2.ReencryptProjectVariables(ctx, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	proj, err = db2.FindProject(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", proj.ProdVariables["password"])
+
+	// The old keyring can't decrypt the re-encrypted variables
+	_, err = db.FindProject(ctx, proj.ID)
+	require.Error(t, err)
+
+	// Rotate back, so the other tests can read their projects
+	db3, err := database.Open("postgres", dsn, testKeyring3)
+	require.NoError(t, err)
+	defer db3.Close()
+	_, err = db3.ReencryptProjectVariables(ctx, 1000)
+	require.NoError(t, err)
+
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
 func testOrgsWithPagination(t *testing.T, db database.DB) {
 	ctx := context.Background()
 
@@ -354,6 +424,41 @@ func testOrgsMembersPagination(t *testing.T, db database.DB) {
 	require.Equal(t, len(users), 1)
 	require.Equal(t, "test2@rilldata.com", users[0].Email)
 
+	// fetch a single member
+	member, err := db.FindOrganizationMemberUser(ctx, org.ID, viewerUser.ID)
+	require.NoError(t, err)
+	require.Equal(t, database.OrganizationRoleNameViewer, member.RoleName)
+	_, err = db.FindOrganizationMemberUser(ctx, org.ID, "00000000-0000-0000-0000-000000000000")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// deactivated users are listed after being removed from the org
+	require.NoError(t, db.DeleteOrganizationMemberUser(ctx, org.ID, viewerUser.ID))
+	require.NoError(t, db.InsertOrganizationDeactivatedUser(ctx, org.ID, viewerUser.ID, viewer.ID))
+	deactivated, err := db.CheckUserIsDeactivatedInOrganization(ctx, viewerUser.ID, org.ID)
+	require.NoError(t, err)
+	require.True(t, deactivated)
+	count, err := db.CountOrganizationUsers(ctx, org.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	orgUsers, err := db.FindOrganizationUsers(ctx, org.ID, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, orgUsers, 1)
+	require.Equal(t, "test2@rilldata.com", orgUsers[0].Email)
+	require.False(t, orgUsers[0].Active)
+	require.Equal(t, database.OrganizationRoleNameViewer, orgUsers[0].RoleName)
+
+	// a deactivated user who is added again is only listed as a member
+	require.NoError(t, db.InsertOrganizationMemberUser(ctx, org.ID, viewerUser.ID, viewer.ID))
+	orgUser, err := db.FindOrganizationUser(ctx, org.ID, viewerUser.ID)
+	require.NoError(t, err)
+	require.True(t, orgUser.Active)
+	count, err = db.CountOrganizationUsers(ctx, org.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.NoError(t, db.DeleteOrganizationDeactivatedUser(ctx, org.ID, viewerUser.ID))
+	_, err = db.FindOrganizationUser(ctx, org.ID, "00000000-0000-0000-0000-000000000000")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
 	// fetch invites without name filter
 	invites, err := db.FindOrganizationInvites(ctx, org.ID, "", 1)
 	require.NoError(t, err)
@@ -367,3 +472,378 @@ func testOrgsMembersPagination(t *testing.T, db database.DB) {
 	//cleanup
 	require.NoError(t, db.DeleteOrganization(ctx, "alpha"))
 }
+
+func testUsergroups(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "group@rilldata.com"})
+	require.NoError(t, err)
+
+	viewer, err := db.FindProjectRole(ctx, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "groups"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "finance"})
+	require.NoError(t, err)
+
+	// add groups
+	finance, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "finance-analysts"})
+	require.NoError(t, err)
+	_, err = db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "engineers"})
+	require.NoError(t, err)
+	_, err = db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "Engineers"})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	// find groups with pagination
+	groups, err := db.FindUsergroupsForOrganization(ctx, org.ID, "", 1)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, "engineers", groups[0].Name)
+	groups, err = db.FindUsergroupsForOrganization(ctx, org.ID, groups[0].Name, 1)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, "finance-analysts", groups[0].Name)
+
+	// the all-users group is not a custom group
+	allUsers, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "all-users"})
+	require.NoError(t, err)
+	_, err = db.UpdateOrganizationAllUsergroup(ctx, org.ID, allUsers.ID)
+	require.NoError(t, err)
+	count, err := db.CountCustomUsergroupsForOrganization(ctx, org.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	groups, err = db.FindCustomUsergroupsForOrganization(ctx, org.ID, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, "finance-analysts", groups[0].Name)
+
+	group, err := db.FindUsergroupByName(ctx, org.ID, "Finance-Analysts")
+	require.NoError(t, err)
+	require.Equal(t, finance.ID, group.ID)
+
+	// grant the group access to the project
+	require.NoError(t, db.InsertProjectMemberUsergroup(ctx, finance.ID, proj.ID, viewer.ID))
+	roles, err := db.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 0)
+
+	require.NoError(t, db.InsertUsergroupMember(ctx, finance.ID, user.ID))
+	roles, err = db.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	require.Equal(t, viewer.ID, roles[0].ID)
+
+	users, err := db.FindUsergroupMemberUsers(ctx, finance.ID)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, user.ID, users[0].ID)
+
+	// rename the group
+	group, err = db.UpdateUsergroupName(ctx, "finance", finance.ID)
+	require.NoError(t, err)
+	require.Equal(t, "finance", group.Name)
+	_, err = db.UpdateUsergroupName(ctx, "Engineers", finance.ID)
+	require.ErrorIs(t, err, database.ErrNotUnique)
+	group, err = db.FindUsergroup(ctx, finance.ID)
+	require.NoError(t, err)
+	require.Equal(t, "finance", group.Name)
+
+	// removing the user from the org's groups revokes the group grant
+	require.NoError(t, db.DeleteAllUsergroupMemberUserForOrganization(ctx, org.ID, user.ID))
+	roles, err = db.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 0)
+
+	// deleting the group removes its grants
+	require.NoError(t, db.InsertUsergroupMember(ctx, finance.ID, user.ID))
+	require.NoError(t, db.DeleteUsergroup(ctx, finance.ID))
+	_, err = db.FindUsergroup(ctx, finance.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+	roles, err = db.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 0)
+
+	//cleanup
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, "groups"))
+	require.NoError(t, db.DeleteUser(ctx, user.ID))
+}
+
+func testProjectRoles(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "roles@rilldata.com"})
+	require.NoError(t, err)
+
+	org1, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "roles1"})
+	require.NoError(t, err)
+	org2, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "roles2"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org1.ID, Name: "reports"})
+	require.NoError(t, err)
+
+	// add a custom role with the same name in both orgs
+	role, err := db.InsertProjectRole(ctx, &database.InsertProjectRoleOptions{OrgID: org1.ID, Name: "report-manager", ReadProject: true, ReadProd: true, ManageReports: true})
+	require.NoError(t, err)
+	require.Equal(t, org1.ID, *role.OrgID)
+	_, err = db.InsertProjectRole(ctx, &database.InsertProjectRoleOptions{OrgID: org2.ID, Name: "report-manager"})
+	require.NoError(t, err)
+	_, err = db.InsertProjectRole(ctx, &database.InsertProjectRoleOptions{OrgID: org1.ID, Name: "Report-Manager"})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	// custom roles are only visible in their own org
+	_, err = db.FindProjectRole(ctx, "report-manager")
+	require.ErrorIs(t, err, database.ErrNotFound)
+	found, err := db.FindProjectRoleForOrganization(ctx, org1.ID, "report-manager")
+	require.NoError(t, err)
+	require.Equal(t, role.ID, found.ID)
+	found, err = db.FindProjectRoleForOrganization(ctx, org1.ID, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+	require.Nil(t, found.OrgID)
+
+	roles, err := db.FindProjectRolesForOrganization(ctx, org1.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 4)
+	require.Equal(t, "report-manager", roles[3].Name)
+
+	// custom roles are resolved like built-in roles
+	require.NoError(t, db.InsertProjectMemberUser(ctx, proj.ID, user.ID, role.ID))
+	resolved, err := db.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, resolved, 1)
+	require.True(t, resolved[0].ManageReports)
+	require.False(t, resolved[0].ManageProd)
+
+	// deleting the role revokes the grant
+	require.NoError(t, db.DeleteProjectRole(ctx, role.ID))
+	resolved, err = db.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, resolved, 0)
+
+	//cleanup
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, "roles1"))
+	require.NoError(t, db.DeleteOrganization(ctx, "roles2"))
+	require.NoError(t, db.DeleteUser(ctx, user.ID))
+}
+
+func testAuditEvents(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "audit"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "audited"})
+	require.NoError(t, err)
+
+	_, err = db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{OrgID: org.ID, ActorType: "user", ActorName: "a@rilldata.com", Action: "org.update"})
+	require.NoError(t, err)
+	_, err = db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{OrgID: org.ID, ProjectID: &proj.ID, ProjectName: proj.Name, ActorType: "user", ActorName: "b@rilldata.com", Action: "project.member.add", After: []byte(`{"role":"viewer"}`)})
+	require.NoError(t, err)
+	last, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{OrgID: org.ID, ProjectID: &proj.ID, ProjectName: proj.Name, ActorType: "service", ActorName: "ci", Action: "project.update"})
+	require.NoError(t, err)
+
+	// newest first
+	events, err := db.FindAuditEvents(ctx, org.ID, &database.AuditEventFilter{}, time.Time{}, "", 10)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, last.ID, events[0].ID)
+	require.JSONEq(t, `{"role":"viewer"}`, string(events[1].After))
+	require.Nil(t, events[1].Before)
+
+	// filters
+	events, err = db.FindAuditEvents(ctx, org.ID, &database.AuditEventFilter{Action: "project"}, time.Time{}, "", 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	events, err = db.FindAuditEvents(ctx, org.ID, &database.AuditEventFilter{Action: "project.member.add", ActorName: "b@rilldata.com", ProjectName: proj.Name}, time.Time{}, "", 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	events, err = db.FindAuditEvents(ctx, org.ID, &database.AuditEventFilter{Since: time.Now().Add(time.Hour)}, time.Time{}, "", 10)
+	require.NoError(t, err)
+	require.Len(t, events, 0)
+
+	// pagination
+	page1, err := db.FindAuditEvents(ctx, org.ID, &database.AuditEventFilter{}, time.Time{}, "", 2)
+	require.NoError(t, err)
+	require.Len(t, page1, 2)
+	page2, err := db.FindAuditEvents(ctx, org.ID, &database.AuditEventFilter{}, page1[1].CreatedOn, page1[1].ID, 2)
+	require.NoError(t, err)
+	require.Len(t, page2, 1)
+	require.Equal(t, "org.update", page2[0].Action)
+
+	// the audit log is append-only
+	conn := db.(*connection)
+	_, err = conn.db.ExecContext(ctx, "UPDATE audit_events SET action='tampered' WHERE id=$1", last.ID)
+	require.Error(t, err)
+	_, err = conn.db.ExecContext(ctx, "DELETE FROM audit_events WHERE id=$1", last.ID)
+	require.Error(t, err)
+
+	// events outlive the org
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, "audit"))
+	events, err = db.FindAuditEvents(ctx, org.ID, &database.AuditEventFilter{}, time.Time{}, "", 10)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+}
+
+func testOrganizationSSOProviders(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	viewer, err := db.FindOrganizationRole(ctx, database.OrganizationRoleNameViewer)
+	require.NoError(t, err)
+	admin, err := db.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+	require.NoError(t, err)
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "sso"})
+	require.NoError(t, err)
+
+	_, err = db.FindOrganizationSSOProvider(ctx, org.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// set the provider
+	p, err := db.UpsertOrganizationSSOProvider(ctx, &database.UpsertOrganizationSSOProviderOptions{
+		OrgID:            org.ID,
+		Issuer:           "https://sso.example.com",
+		ClientID:         "client",
+		ClientSecret:     "secret",
+		DefaultOrgRoleID: viewer.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, viewer.ID, p.DefaultOrgRoleID)
+	require.Equal(t, "secret", p.ClientSecret)
+
+	// The client secret must not be stored in plain text
+	var raw []byte
+	var keyID string
+	err = db.(*connection).db.QueryRowxContext(ctx, "SELECT client_secret, client_secret_encryption_key_id FROM orgs_sso_providers WHERE id=$1", p.ID).Scan(&raw, &keyID)
+	require.NoError(t, err)
+	require.Equal(t, "k1", keyID)
+	require.NotContains(t, string(raw), "secret")
+
+	// replace the provider
+	p, err = db.UpsertOrganizationSSOProvider(ctx, &database.UpsertOrganizationSSOProviderOptions{
+		OrgID:            org.ID,
+		Issuer:           "https://sso2.example.com",
+		ClientID:         "client2",
+		ClientSecret:     "secret2",
+		DefaultOrgRoleID: admin.ID,
+	})
+	require.NoError(t, err)
+	p, err = db.FindOrganizationSSOProvider(ctx, org.ID)
+	require.NoError(t, err)
+	require.Equal(t, "https://sso2.example.com", p.Issuer)
+	require.Equal(t, "secret2", p.ClientSecret)
+	require.Equal(t, admin.ID, p.DefaultOrgRoleID)
+
+	// providers are found through the org's verified SSO domains
+	_, err = db.FindOrganizationSSOProviderForDomain(ctx, "example.com")
+	require.ErrorIs(t, err, database.ErrNotFound)
+	_, err = db.InsertOrganizationWhitelistedDomain(ctx, &database.InsertOrganizationWhitelistedDomainOptions{OrgID: org.ID, OrgRoleID: viewer.ID, Domain: "example.com"})
+	require.NoError(t, err)
+	_, err = db.FindOrganizationSSOProviderForDomain(ctx, "example.com")
+	require.ErrorIs(t, err, database.ErrNotFound)
+	d, err := db.InsertOrganizationSSODomain(ctx, &database.InsertOrganizationSSODomainOptions{OrgID: org.ID, Domain: "example.com", VerificationToken: "token"})
+	require.NoError(t, err)
+	require.Nil(t, d.VerifiedOn)
+	_, err = db.FindOrganizationSSOProviderForDomain(ctx, "example.com")
+	require.ErrorIs(t, err, database.ErrNotFound)
+	d, err = db.UpdateOrganizationSSODomainVerified(ctx, d.ID)
+	require.NoError(t, err)
+	require.NotNil(t, d.VerifiedOn)
+	found, err := db.FindOrganizationSSOProviderForDomain(ctx, "Example.com")
+	require.NoError(t, err)
+	require.Equal(t, p.ID, found.ID)
+
+	// other orgs can add the domain, but not verify it
+	other, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "sso-other"})
+	require.NoError(t, err)
+	d2, err := db.InsertOrganizationSSODomain(ctx, &database.InsertOrganizationSSODomainOptions{OrgID: other.ID, Domain: "EXAMPLE.com", VerificationToken: "token2"})
+	require.NoError(t, err)
+	_, err = db.UpdateOrganizationSSODomainVerified(ctx, d2.ID)
+	require.ErrorIs(t, err, database.ErrNotUnique)
+	_, err = db.InsertOrganizationSSODomain(ctx, &database.InsertOrganizationSSODomainOptions{OrgID: other.ID, Domain: "example.com", VerificationToken: "token3"})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+	domains, err := db.FindOrganizationSSODomains(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, domains, 1)
+	require.NoError(t, db.DeleteOrganizationSSODomain(ctx, d2.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, "sso-other"))
+
+	// remove the provider
+	require.NoError(t, db.DeleteOrganizationSSOProvider(ctx, org.ID))
+	require.ErrorIs(t, db.DeleteOrganizationSSOProvider(ctx, org.ID), database.ErrNotFound)
+
+	//cleanup
+	require.NoError(t, db.DeleteOrganization(ctx, "sso"))
+}
+
+func testOrganizationUserAttributes(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "attrs@rilldata.com"})
+	require.NoError(t, err)
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "attrs"})
+	require.NoError(t, err)
+
+	_, err = db.FindOrganizationUserAttributes(ctx, org.ID, user.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	attrs, err := db.UpsertOrganizationUserAttributes(ctx, org.ID, user.ID, map[string]any{"region": "emea", "tenant_id": 42})
+	require.NoError(t, err)
+	require.Equal(t, database.UserAttributes{"region": "emea", "tenant_id": float64(42)}, attrs.Attributes)
+
+	// replaces the attributes
+	_, err = db.UpsertOrganizationUserAttributes(ctx, org.ID, user.ID, map[string]any{"region": "apac"})
+	require.NoError(t, err)
+	attrs, err = db.FindOrganizationUserAttributes(ctx, org.ID, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, database.UserAttributes{"region": "apac"}, attrs.Attributes)
+
+	//cleanup
+	require.NoError(t, db.DeleteOrganization(ctx, "attrs"))
+	require.NoError(t, db.DeleteUser(ctx, user.ID))
+}
+
+func testEmbedTokens(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "embeds"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "embeds"})
+	require.NoError(t, err)
+
+	tkn1, err := db.InsertEmbedToken(ctx, &database.InsertEmbedTokenOptions{ProjectID: proj.ID, ExpiresOn: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	require.Nil(t, tkn1.RevokedOn)
+	tkn2, err := db.InsertEmbedToken(ctx, &database.InsertEmbedTokenOptions{ProjectID: proj.ID, ExpiresOn: time.Now().Add(-time.Hour)})
+	require.NoError(t, err)
+
+	revoked, err := db.FindRevokedEmbedTokens(ctx)
+	require.NoError(t, err)
+	require.Len(t, revoked, 0)
+
+	// expired tokens are not listed
+	require.NoError(t, db.UpdateEmbedTokenRevokedOn(ctx, tkn1.ID))
+	require.NoError(t, db.UpdateEmbedTokenRevokedOn(ctx, tkn2.ID))
+	revoked, err = db.FindRevokedEmbedTokens(ctx)
+	require.NoError(t, err)
+	require.Len(t, revoked, 1)
+	require.Equal(t, tkn1.ID, revoked[0].ID)
+
+	// revoking again keeps the original revocation time
+	tkn1, err = db.FindEmbedToken(ctx, tkn1.ID)
+	require.NoError(t, err)
+	require.NotNil(t, tkn1.RevokedOn)
+	require.NoError(t, db.UpdateEmbedTokenRevokedOn(ctx, tkn1.ID))
+	tkn, err := db.FindEmbedToken(ctx, tkn1.ID)
+	require.NoError(t, err)
+	require.True(t, tkn1.RevokedOn.Equal(*tkn.RevokedOn))
+
+	require.NoError(t, db.DeleteExpiredEmbedTokens(ctx, 0))
+	_, err = db.FindEmbedToken(ctx, tkn2.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	//cleanup
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, "embeds"))
+}
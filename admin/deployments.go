@@ -126,6 +126,7 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		OlapConnector:  olapDriver,
 		RepoConnector:  "admin",
 		AdminConnector: "admin",
+		Environment:    "prod",
 		Connectors: []*runtimev1.Connector{
 			{
 				Name:   olapDriver,
@@ -212,8 +213,12 @@ func (s *Service) updateDeployment(ctx context.Context, depl *database.Deploymen
 		}
 	}
 
+	// Deployments are always prod (also sets it on instances created before environments were introduced)
+	environment := "prod"
+
 	_, err = rt.EditInstance(ctx, &runtimev1.EditInstanceRequest{
 		InstanceId:              depl.RuntimeInstanceID,
+		Environment:             &environment,
 		Connectors:              connectors,
 		Annotations:             opts.Annotations.toMap(),
 		Variables:               opts.Variables,
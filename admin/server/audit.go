@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListAuditEvents(ctx context.Context, req *adminv1.ListAuditEventsRequest) (*adminv1.ListAuditEventsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.action", req.Action),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to read the org audit log")
+	}
+
+	token, err := unmarshalStringTimestampPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	var beforeCreatedOn time.Time
+	if token.Ts != nil {
+		beforeCreatedOn = token.Ts.AsTime()
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	filter := &database.AuditEventFilter{
+		ProjectName: req.Project,
+		ActorName:   req.Actor,
+		Action:      req.Action,
+	}
+	if req.Since != nil {
+		filter.Since = req.Since.AsTime()
+	}
+
+	events, err := s.admin.DB.FindAuditEvents(ctx, org.ID, filter, beforeCreatedOn, token.Str, pageSize)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextToken := ""
+	if len(events) >= pageSize {
+		e := events[len(events)-1]
+		nextToken = marshalStringTimestampPageToken(e.ID, e.CreatedOn)
+	}
+
+	dtos := make([]*adminv1.AuditEvent, len(events))
+	for i, e := range events {
+		dtos[i], err = auditEventToPB(e)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &adminv1.ListAuditEventsResponse{
+		Events:        dtos,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+// recordAuditEvent records a change made by the caller in the audit log.
+// The change has already been made when it's called, so failures are logged instead of failing the request.
+func (s *Server) recordAuditEvent(ctx context.Context, e *admin.AuditEvent) {
+	claims := auth.GetClaims(ctx)
+	e.ActorType = string(claims.OwnerType())
+	e.ActorID = claims.OwnerID()
+
+	switch claims.OwnerType() {
+	case auth.OwnerTypeUser:
+		user, err := s.admin.DB.FindUser(ctx, e.ActorID)
+		if err == nil {
+			e.ActorName = user.Email
+		}
+	case auth.OwnerTypeService:
+		service, err := s.admin.DB.FindService(ctx, e.ActorID)
+		if err == nil {
+			e.ActorName = service.Name
+		}
+	}
+
+	err := s.admin.RecordAuditEvent(ctx, e)
+	if err != nil {
+		s.logger.Error("failed to record audit event", zap.String("action", e.Action), zap.String("org_id", e.OrgID), zap.Error(err), observability.ZapCtx(ctx))
+	}
+}
+
+func auditEventToPB(e *database.AuditEvent) (*adminv1.AuditEvent, error) {
+	before, err := auditStructToPB(e.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := auditStructToPB(e.After)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.AuditEvent{
+		Id:          e.ID,
+		ActorType:   e.ActorType,
+		ActorId:     e.ActorID,
+		ActorName:   e.ActorName,
+		ProjectId:   safeStr(e.ProjectID),
+		ProjectName: e.ProjectName,
+		Action:      e.Action,
+		Target:      e.Target,
+		Before:      before,
+		After:       after,
+		CreatedOn:   timestamppb.New(e.CreatedOn),
+	}, nil
+}
+
+func auditStructToPB(data []byte) (*structpb.Struct, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var m map[string]any
+	err := json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, err
+	}
+	return structpb.NewStruct(m)
+}
@@ -30,6 +30,7 @@ type Authenticator struct {
 	opts    *AuthenticatorOptions
 	oidc    *oidc.Provider
 	oauth2  oauth2.Config
+	sso     ssoClients
 }
 
 // NewAuthenticator creates an Authenticator.
@@ -9,6 +9,7 @@ import (
 	"net/url"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/runtime/pkg/middleware"
 	"github.com/rilldata/rill/runtime/pkg/observability"
@@ -23,6 +24,7 @@ const (
 	cookieFieldState       = "state"
 	cookieFieldRedirect    = "redirect"
 	cookieFieldAccessToken = "access_token"
+	cookieFieldSSOOrg      = "sso_org"
 )
 
 // RegisterEndpoints adds HTTP endpoints for auth.
@@ -70,6 +72,9 @@ func (a *Authenticator) authLogin(w http.ResponseWriter, r *http.Request) {
 // authStart starts an OAuth and OIDC flow that redirects the user for authentication with the auth provider.
 // After auth, the user is redirected back to authLoginCallback, which in turn will redirect the user to "/".
 // You can override the redirect destination by passing a `?redirect=URI` query to this endpoint.
+// If the user's email address is passed in an `?email=EMAIL` query and its domain is a verified SSO domain of an org with an SSO provider,
+// the user is redirected to the org's SSO provider instead. Users of such domains who log in through the default auth provider
+// are sent back here with their email address by authLoginCallback, so they can't bypass their org's SSO provider.
 func (a *Authenticator) authStart(w http.ResponseWriter, r *http.Request, signup bool) {
 	// Generate random state for CSRF
 	b := make([]byte, 32)
@@ -92,12 +97,43 @@ func (a *Authenticator) authStart(w http.ResponseWriter, r *http.Request, signup
 		sess.Values[cookieFieldRedirect] = redirect
 	}
 
+	// Route the login to the SSO provider of the org that owns the email's domain (if any)
+	var sso *ssoClient
+	email := r.URL.Query().Get("email")
+	if email != "" {
+		provider, err := a.ssoProviderForEmail(r.Context(), email)
+		if err != nil {
+			internalServerError(w, fmt.Errorf("failed to find SSO provider: %w", err))
+			return
+		}
+		if provider != nil {
+			sso, err = a.ssoClientForProvider(r.Context(), provider)
+			if err != nil {
+				// Don't reflect the error since it may contain the SSO provider's response
+				a.logger.Warn("failed to connect to SSO provider", zap.String("org_id", provider.OrgID), zap.Error(err), observability.ZapCtx(r.Context()))
+				internalServerError(w, errors.New("failed to connect to SSO provider"))
+				return
+			}
+			sess.Values[cookieFieldSSOOrg] = provider.OrgID
+		}
+	}
+	if sso == nil {
+		delete(sess.Values, cookieFieldSSOOrg)
+	}
+
 	// Save cookie
 	if err := sess.Save(r, w); err != nil {
 		http.Error(w, fmt.Sprintf("failed to save session: %s", err), http.StatusInternalServerError)
 		return
 	}
 
+	// Redirect to the org's SSO provider
+	if sso != nil {
+		redirectURL := sso.oauth2.AuthCodeURL(state, oauth2.SetAuthURLParam("login_hint", email))
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+
 	// Redirect to auth provider
 	redirectURL := a.oauth2.AuthCodeURL(state)
 	if signup {
@@ -123,9 +159,39 @@ func (a *Authenticator) authLoginCallback(w http.ResponseWriter, r *http.Request
 	}
 	delete(sess.Values, cookieFieldState)
 
+	// Use the org's SSO provider if the login was routed to one in authStart
+	ctx := r.Context()
+	oauth2Config := a.oauth2
+	oidcProvider := a.oidc
+	var ssoProvider *database.OrganizationSSOProvider
+	if orgID, ok := sess.Values[cookieFieldSSOOrg].(string); ok && orgID != "" {
+		delete(sess.Values, cookieFieldSSOOrg)
+
+		var err error
+		ssoProvider, err = a.admin.DB.FindOrganizationSSOProvider(r.Context(), orgID)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to find SSO provider: %s", err), http.StatusUnauthorized)
+			return
+		}
+		sso, err := a.ssoClientForProvider(r.Context(), ssoProvider)
+		if err != nil {
+			a.logger.Warn("failed to connect to SSO provider", zap.String("org_id", ssoProvider.OrgID), zap.Error(err), observability.ZapCtx(r.Context()))
+			internalServerError(w, errors.New("failed to connect to SSO provider"))
+			return
+		}
+		oauth2Config = sso.oauth2
+		oidcProvider = sso.oidc
+		ctx = WithSSOHTTPClient(ctx)
+	}
+
 	// Exchange authorization code for an oauth2 token
-	oauthToken, err := a.oauth2.Exchange(r.Context(), r.URL.Query().Get("code"))
+	oauthToken, err := oauth2Config.Exchange(ctx, r.URL.Query().Get("code"))
 	if err != nil {
+		if ssoProvider != nil {
+			// Don't reflect the SSO provider's response
+			http.Error(w, "failed to convert authorization code into a token", http.StatusUnauthorized)
+			return
+		}
 		http.Error(w, fmt.Sprintf("failed to convert authorization code into a token: %s", err), http.StatusUnauthorized)
 		return
 	}
@@ -138,10 +204,10 @@ func (a *Authenticator) authLoginCallback(w http.ResponseWriter, r *http.Request
 	}
 
 	oidcConfig := &oidc.Config{
-		ClientID: a.oauth2.ClientID,
+		ClientID: oauth2Config.ClientID,
 	}
 
-	idToken, err := a.oidc.Verifier(oidcConfig).Verify(r.Context(), rawIDToken)
+	idToken, err := oidcProvider.Verifier(oidcConfig).Verify(r.Context(), rawIDToken)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("failed to verify ID token: %s", err), http.StatusInternalServerError)
 		return
@@ -159,21 +225,67 @@ func (a *Authenticator) authLoginCallback(w http.ResponseWriter, r *http.Request
 		return
 	}
 	emailVerified, ok := profile["email_verified"].(bool)
-	if !ok {
+	if !ok && ssoProvider == nil {
 		http.Error(w, "claim 'email_verified' not found", http.StatusInternalServerError)
 		return
 	}
 	name, ok := profile["name"].(string)
-	if !ok {
+	if !ok && ssoProvider == nil {
 		http.Error(w, "claim 'name' not found", http.StatusInternalServerError)
 		return
 	}
 	photoURL, ok := profile["picture"].(string)
-	if !ok {
+	if !ok && ssoProvider == nil {
 		http.Error(w, "claim 'picture' not found", http.StatusInternalServerError)
 		return
 	}
 
+	// Custom SSO providers are trusted to verify the emails of the org's domains (but not of other domains).
+	// They may not provide all the standard profile claims, so we keep the user's current profile for missing claims.
+	if ssoProvider != nil {
+		err := a.checkSSOEmail(r.Context(), ssoProvider, email)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		if _, ok := profile["email_verified"]; !ok {
+			emailVerified = true
+		}
+		if name == "" || photoURL == "" {
+			user, err := a.admin.DB.FindUserByEmail(r.Context(), email)
+			if err == nil {
+				if name == "" {
+					name = user.DisplayName
+				}
+				if photoURL == "" {
+					photoURL = user.PhotoURL
+				}
+			}
+		}
+	}
+
+	// Users of an org's verified SSO domains must log in through the org's SSO provider
+	if ssoProvider == nil {
+		provider, err := a.ssoProviderForEmail(r.Context(), email)
+		if err != nil {
+			internalServerError(w, fmt.Errorf("failed to find SSO provider: %w", err))
+			return
+		}
+		if provider != nil {
+			loginURL, err := url.JoinPath(a.opts.ExternalURL, "/auth/login")
+			if err != nil {
+				internalServerError(w, fmt.Errorf("failed to create login url: %w", err))
+				return
+			}
+			if err := sess.Save(r, w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			http.Redirect(w, r, loginURL+"?email="+url.QueryEscape(email), http.StatusTemporaryRedirect)
+			return
+		}
+	}
+
 	// Check that the user's email is verified
 	if !emailVerified {
 		errorRedirect, err := url.JoinPath(a.opts.FrontendURL, "/-/auth/verify-email")
@@ -193,6 +305,19 @@ func (a *Authenticator) authLoginCallback(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// Users who logged in through an org's SSO provider automatically join the org
+	if ssoProvider != nil {
+		_, err := a.admin.AddOrganizationMemberForSSO(r.Context(), ssoProvider, user)
+		if err != nil {
+			if errors.Is(err, admin.ErrUserDeactivated) {
+				http.Error(w, "your account has been deactivated by your organization", http.StatusForbidden)
+				return
+			}
+			http.Error(w, fmt.Sprintf("failed to add user to org: %s", err), http.StatusInternalServerError)
+			return
+		}
+	}
+
 	// If there's already a token in the cookie, revoke it (since we're now issuing a new one)
 	oldAuthToken, ok := sess.Values[cookieFieldAccessToken].(string)
 	if ok && oldAuthToken != "" {
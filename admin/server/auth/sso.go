@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/singleflight"
+	"golang.org/x/oauth2"
+)
+
+// ssoDiscoveryTimeout is the timeout for fetching the OIDC discovery document of an org's SSO provider.
+const ssoDiscoveryTimeout = 10 * time.Second
+
+// ssoClient is an OIDC client for an org's SSO provider.
+type ssoClient struct {
+	oidc      *oidc.Provider
+	oauth2    oauth2.Config
+	updatedOn time.Time
+}
+
+// ssoClients caches OIDC clients for orgs' SSO providers by org ID.
+// Creating a client fetches the provider's discovery document, so it's not done on every login.
+type ssoClients struct {
+	mu        sync.Mutex
+	clients   map[string]*ssoClient
+	discovery singleflight.Group[string, *ssoClient]
+}
+
+// ssoProviderForEmail returns the SSO provider that users with the given email address must log in through.
+// It returns nil if the email's domain isn't a verified SSO domain of an org with an SSO provider.
+func (a *Authenticator) ssoProviderForEmail(ctx context.Context, email string) (*database.OrganizationSSOProvider, error) {
+	domain := emailDomain(email)
+	if domain == "" {
+		return nil, nil
+	}
+
+	provider, err := a.admin.DB.FindOrganizationSSOProviderForDomain(ctx, domain)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return provider, nil
+}
+
+// ssoClientForProvider returns an OIDC client for an org's SSO provider.
+func (a *Authenticator) ssoClientForProvider(ctx context.Context, p *database.OrganizationSSOProvider) (*ssoClient, error) {
+	a.sso.mu.Lock()
+	c, ok := a.sso.clients[p.OrgID]
+	a.sso.mu.Unlock()
+	if ok && c.updatedOn.Equal(p.UpdatedOn) {
+		return c, nil
+	}
+
+	// Discovery makes network requests, so it's done outside the lock (with a timeout).
+	// Concurrent logins through the same provider share a single discovery request.
+	key := fmt.Sprintf("%s:%d", p.OrgID, p.UpdatedOn.UnixNano())
+	return a.sso.discovery.Do(ctx, key, func(ctx context.Context) (*ssoClient, error) {
+		return a.newSSOClient(ctx, p)
+	})
+}
+
+// newSSOClient creates an OIDC client for an org's SSO provider and caches it.
+func (a *Authenticator) newSSOClient(ctx context.Context, p *database.OrganizationSSOProvider) (*ssoClient, error) {
+	provider, err := NewSSOProvider(ctx, p.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURL, err := url.JoinPath(a.opts.ExternalURL, "/auth/callback")
+	if err != nil {
+		return nil, err
+	}
+
+	c := &ssoClient{
+		oidc: provider,
+		oauth2: oauth2.Config{
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  redirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       []string{oidc.ScopeOpenID, "email", "profile"},
+		},
+		updatedOn: p.UpdatedOn,
+	}
+
+	a.sso.mu.Lock()
+	defer a.sso.mu.Unlock()
+
+	// Don't replace a client created for a newer version of the provider
+	if cur, ok := a.sso.clients[p.OrgID]; !ok || cur.updatedOn.Before(p.UpdatedOn) {
+		if a.sso.clients == nil {
+			a.sso.clients = make(map[string]*ssoClient)
+		}
+		a.sso.clients[p.OrgID] = c
+	}
+
+	return c, nil
+}
+
+// checkSSOEmail checks that an email address returned by an org's SSO provider belongs to one of the org's verified SSO domains.
+// This prevents an org's identity provider from authenticating users outside of the org's domains.
+func (a *Authenticator) checkSSOEmail(ctx context.Context, p *database.OrganizationSSOProvider, email string) error {
+	domain := emailDomain(email)
+	if domain == "" {
+		return fmt.Errorf("invalid email address %q", email)
+	}
+
+	d, err := a.admin.DB.FindOrganizationSSODomain(ctx, p.OrgID, domain)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return err
+	}
+	if d == nil || d.VerifiedOn == nil {
+		return fmt.Errorf("the email domain %q is not a verified SSO domain of the org", domain)
+	}
+
+	return nil
+}
+
+// ErrSSODiscovery is returned by NewSSOProvider when the issuer's discovery document can't be fetched.
+// The underlying error is not exposed to callers since it may contain responses from arbitrary servers.
+var ErrSSODiscovery = errors.New("failed to discover OIDC provider")
+
+// errSSOAddressNotAllowed is returned when connecting to an SSO provider that resolves to a non-public address.
+var errSSOAddressNotAllowed = errors.New("address not allowed")
+
+// ssoHTTPClient is the HTTP client for requests to orgs' SSO providers.
+// Since org admins choose the issuer URL, it refuses to connect to loopback, private and link-local addresses.
+// The check is done when dialing, after DNS resolution, so it also applies to redirects and hosts that resolve to different addresses over time.
+var ssoHTTPClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: nil,
+		DialContext: (&net.Dialer{
+			Timeout: ssoDiscoveryTimeout,
+			Control: checkSSODialAddress,
+		}).DialContext,
+		TLSHandshakeTimeout: ssoDiscoveryTimeout,
+	},
+	Timeout: ssoDiscoveryTimeout,
+}
+
+// NewSSOProvider fetches the OIDC discovery document for an SSO provider's issuer.
+// The issuer must be an https URL of a public host. The returned provider uses ssoHTTPClient for its requests.
+func NewSSOProvider(ctx context.Context, issuer string) (*oidc.Provider, error) {
+	err := validateSSOIssuer(issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ssoDiscoveryTimeout)
+	defer cancel()
+
+	provider, err := oidc.NewProvider(oidc.ClientContext(ctx, ssoHTTPClient), issuer)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSSODiscovery, err)
+	}
+	return provider, nil
+}
+
+// WithSSOHTTPClient returns a context in which OAuth2 requests (such as token exchanges with an org's SSO provider) use ssoHTTPClient.
+func WithSSOHTTPClient(ctx context.Context) context.Context {
+	return oidc.ClientContext(ctx, ssoHTTPClient)
+}
+
+// validateSSOIssuer checks that an issuer is an https URL without credentials.
+func validateSSOIssuer(issuer string) error {
+	u, err := url.Parse(issuer)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		return fmt.Errorf("invalid issuer %q: must be an https URL", issuer)
+	}
+	return nil
+}
+
+// checkSSODialAddress is a net.Dialer Control function that refuses connections to non-public addresses.
+func checkSSODialAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return errSSOAddressNotAllowed
+	}
+	return nil
+}
+
+// isPublicIP returns false for loopback, private, link-local, multicast and unspecified addresses.
+func isPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified())
+}
+
+// emailDomain returns the lower-cased domain of an email address, or an empty string if it doesn't have one.
+func emailDomain(email string) string {
+	i := strings.LastIndex(email, "@")
+	if i < 0 || i == len(email)-1 {
+		return ""
+	}
+	return strings.ToLower(email[i+1:])
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/urlutil"
 	"github.com/rilldata/rill/admin/server/auth"
@@ -44,6 +45,14 @@ func (s *Server) TriggerReconcile(ctx context.Context, req *adminv1.TriggerRecon
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "project.reconcile",
+		Target:      depl.ID,
+	})
+
 	return &adminv1.TriggerReconcileResponse{}, nil
 }
 
@@ -73,6 +82,15 @@ func (s *Server) TriggerRefreshSources(ctx context.Context, req *adminv1.Trigger
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "project.refresh_sources",
+		Target:      depl.ID,
+		After:       map[string]any{"sources": req.Sources},
+	})
+
 	return &adminv1.TriggerRefreshSourcesResponse{}, nil
 }
 
@@ -149,11 +167,19 @@ func (s *Server) TriggerRedeploy(ctx context.Context, req *adminv1.TriggerRedepl
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to manage deployment")
 	}
 
-	_, err := s.admin.TriggerRedeploy(ctx, proj, depl)
+	newDepl, err := s.admin.TriggerRedeploy(ctx, proj, depl)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "project.redeploy",
+		Target:      newDepl.ID,
+	})
+
 	return &adminv1.TriggerRedeployResponse{}, nil
 }
 
@@ -309,45 +335,9 @@ func (s *Server) GetIFrame(ctx context.Context, req *adminv1.GetIFrameRequest) (
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to manage deployment")
 	}
 
-	var attr map[string]any
-	if req.For != nil {
-		switch forVal := req.For.(type) {
-		case *adminv1.GetIFrameRequest_UserId:
-			attr, err = s.getAttributesFor(ctx, forVal.UserId, proj.OrganizationID, proj.ID)
-			if err != nil {
-				return nil, status.Error(codes.Internal, err.Error())
-			}
-		case *adminv1.GetIFrameRequest_UserEmail:
-			user, err := s.admin.DB.FindUserByEmail(ctx, forVal.UserEmail)
-			// if email is not found in the database, we assume it is a non-admin user
-			if errors.Is(err, database.ErrNotFound) {
-				attr = map[string]any{
-					"email":  forVal.UserEmail,
-					"domain": forVal.UserEmail[strings.LastIndex(forVal.UserEmail, "@")+1:],
-					"admin":  false,
-				}
-				break
-			}
-			if err != nil {
-				return nil, status.Error(codes.Internal, err.Error())
-			}
-			attr, err = s.getAttributesFor(ctx, user.ID, proj.OrganizationID, proj.ID)
-			if err != nil {
-				return nil, status.Error(codes.Internal, err.Error())
-			}
-		case *adminv1.GetIFrameRequest_Attributes:
-			attr = forVal.Attributes.AsMap()
-		default:
-			return nil, status.Error(codes.InvalidArgument, "invalid 'for' type")
-		}
-	}
-	// if no attributes found, we add standard non-admin user attrs to ensure security policies are applied correctly
-	if len(attr) == 0 {
-		attr = map[string]any{
-			"email":  "",
-			"domain": "",
-			"admin":  false,
-		}
+	attr, err := s.embedAttributes(ctx, proj, req.GetUserId(), req.GetUserEmail(), req.GetAttributes())
+	if err != nil {
+		return nil, err
 	}
 
 	// default here is higher than GetDeploymentCredentials as most embedders probably won't implement refresh and state management
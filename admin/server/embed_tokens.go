@@ -0,0 +1,271 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// embedTokenDefaultTTL is the default TTL of embed tokens. It matches the default for GetIFrame.
+const embedTokenDefaultTTL = 24 * time.Hour
+
+// embedTokenMaxTTL is the max TTL of embed tokens. It bounds how long revoked tokens must be served to runtimes.
+const embedTokenMaxTTL = 7 * 24 * time.Hour
+
+func (s *Server) IssueEmbedToken(ctx context.Context, req *adminv1.IssueEmbedTokenRequest) (*adminv1.IssueEmbedTokenResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.branch", req.Branch),
+		attribute.Int("args.resources", len(req.Resources)),
+		attribute.String("args.ttl_seconds", strconv.FormatUint(uint64(req.TtlSeconds), 10)),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if proj.ProdDeploymentID == nil {
+		return nil, status.Error(codes.InvalidArgument, "project does not have a deployment")
+	}
+
+	prodDepl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Branch != "" && req.Branch != prodDepl.Branch {
+		return nil, status.Error(codes.InvalidArgument, "project does not have a deployment for given branch")
+	}
+
+	claims := auth.GetClaims(ctx)
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+
+	// If the user is not a superuser, they must have ManageProd permissions
+	if !permissions.ManageProd && !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to manage deployment")
+	}
+
+	if len(req.Resources) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one resource must be specified")
+	}
+
+	ttlDuration := embedTokenDefaultTTL
+	if req.TtlSeconds > 0 {
+		ttlDuration = time.Duration(req.TtlSeconds) * time.Second
+	}
+	if ttlDuration > embedTokenMaxTTL {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl_seconds must be at most %d", int64(embedTokenMaxTTL.Seconds()))
+	}
+
+	restrictions := &runtimeauth.Restrictions{}
+	for _, r := range req.Resources {
+		kind, err := embedResourceKind(r.Kind)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if r.Name == "" {
+			return nil, status.Error(codes.InvalidArgument, "resource name must be specified")
+		}
+		restrictions.Resources = append(restrictions.Resources, runtimeauth.ResourceName{Kind: kind, Name: r.Name})
+	}
+	for mv, filter := range req.MetricsViewFilters {
+		if !restrictions.CanAccessResource(runtime.ResourceKindMetricsView, mv) {
+			return nil, status.Errorf(codes.InvalidArgument, "filter for metrics view %q which is not in resources", mv)
+		}
+		if strings.TrimSpace(filter) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "empty filter for metrics view %q", mv)
+		}
+	}
+	restrictions.MetricsViewFilters = req.MetricsViewFilters
+
+	attr, err := s.embedAttributes(ctx, proj, req.GetUserId(), req.GetUserEmail(), req.GetAttributes())
+	if err != nil {
+		return nil, err
+	}
+
+	var createdByUserID *string
+	if claims.OwnerType() == auth.OwnerTypeUser {
+		id := claims.OwnerID()
+		createdByUserID = &id
+	}
+
+	// Persist the token's metadata, so it can be revoked before it expires
+	tkn, err := s.admin.DB.InsertEmbedToken(ctx, &database.InsertEmbedTokenOptions{
+		ProjectID:       proj.ID,
+		CreatedByUserID: createdByUserID,
+		ExpiresOn:       time.Now().Add(ttlDuration),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Generate JWT
+	jwt, err := s.issuer.NewToken(runtimeauth.TokenOptions{
+		AudienceURL: prodDepl.RuntimeAudience,
+		ID:          tkn.ID,
+		Subject:     claims.OwnerID(),
+		TTL:         ttlDuration,
+		InstancePermissions: map[string][]runtimeauth.Permission{
+			prodDepl.RuntimeInstanceID: {
+				runtimeauth.ReadObjects,
+				runtimeauth.ReadMetrics,
+			},
+		},
+		Attributes:   attr,
+		Restrictions: restrictions,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
+	}
+
+	s.admin.Used.Deployment(prodDepl.ID)
+
+	return &adminv1.IssueEmbedTokenResponse{
+		TokenId:     tkn.ID,
+		RuntimeHost: prodDepl.RuntimeHost,
+		InstanceId:  prodDepl.RuntimeInstanceID,
+		AccessToken: jwt,
+		TtlSeconds:  uint32(ttlDuration.Seconds()),
+	}, nil
+}
+
+func (s *Server) RevokeEmbedToken(ctx context.Context, req *adminv1.RevokeEmbedTokenRequest) (*adminv1.RevokeEmbedTokenResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.token_id", req.TokenId),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProd && !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to manage deployment")
+	}
+
+	tkn, err := s.admin.DB.FindEmbedToken(ctx, req.TokenId)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "embed token not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if tkn.ProjectID != proj.ID {
+		return nil, status.Error(codes.NotFound, "embed token not found")
+	}
+
+	err = s.admin.DB.UpdateEmbedTokenRevokedOn(ctx, tkn.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "project.embed_token.revoke",
+		Target:      tkn.ID,
+	})
+
+	return &adminv1.RevokeEmbedTokenResponse{}, nil
+}
+
+// revokedEmbedTokens lists the revoked embed tokens that haven't expired yet.
+// It is served to runtimes, which reject the tokens on the list.
+func (s *Server) revokedEmbedTokens(ctx context.Context) ([]runtimeauth.RevokedToken, error) {
+	tkns, err := s.admin.DB.FindRevokedEmbedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtimeauth.RevokedToken, len(tkns))
+	for i, t := range tkns {
+		res[i] = runtimeauth.RevokedToken{ID: t.ID, ExpiresOn: t.ExpiresOn}
+	}
+	return res, nil
+}
+
+// embedAttributes resolves the attributes of the user an embed token is issued for.
+// The user can be identified by ID or email, or its attributes can be provided directly.
+func (s *Server) embedAttributes(ctx context.Context, proj *database.Project, userID, userEmail string, attributes *structpb.Struct) (map[string]any, error) {
+	var attr map[string]any
+	var err error
+	switch {
+	case userID != "":
+		attr, err = s.getAttributesFor(ctx, userID, proj.OrganizationID, proj.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	case userEmail != "":
+		user, err := s.admin.DB.FindUserByEmail(ctx, userEmail)
+		// if email is not found in the database, we assume it is a non-admin user
+		if errors.Is(err, database.ErrNotFound) {
+			attr = map[string]any{
+				"email":  userEmail,
+				"domain": userEmail[strings.LastIndex(userEmail, "@")+1:],
+				"admin":  false,
+			}
+			break
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		attr, err = s.getAttributesFor(ctx, user.ID, proj.OrganizationID, proj.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	case attributes != nil:
+		attr = attributes.AsMap()
+	}
+
+	// if no attributes found, we add standard non-admin user attrs to ensure security policies are applied correctly
+	if len(attr) == 0 {
+		attr = map[string]any{
+			"email":  "",
+			"domain": "",
+			"admin":  false,
+		}
+	}
+
+	return attr, nil
+}
+
+// embedResourceKind maps a resource kind passed to IssueEmbedToken to a runtime resource kind.
+// It accepts both unqualified kinds like "MetricsView" and qualified kinds like "rill.runtime.v1.MetricsView".
+func embedResourceKind(kind string) (string, error) {
+	k := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(kind), "rill.runtime.v1."))
+	switch k {
+	case "source":
+		return runtime.ResourceKindSource, nil
+	case "model":
+		return runtime.ResourceKindModel, nil
+	case "metricsview", "metrics_view", "dashboard":
+		return runtime.ResourceKindMetricsView, nil
+	case "report":
+		return runtime.ResourceKindReport, nil
+	case "theme":
+		return runtime.ResourceKindTheme, nil
+	default:
+		return "", fmt.Errorf("invalid resource kind %q", kind)
+	}
+}
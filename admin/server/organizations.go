@@ -6,6 +6,7 @@ import (
 	"math"
 	"strings"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/publicemail"
 	"github.com/rilldata/rill/admin/server/auth"
@@ -128,6 +129,13 @@ func (s *Server) CreateOrganization(ctx context.Context, req *adminv1.CreateOrga
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.create",
+		Target: org.Name,
+		After:  orgAuditState(org),
+	})
+
 	return &adminv1.CreateOrganizationResponse{
 		Organization: organizationToDTO(org),
 	}, nil
@@ -151,6 +159,14 @@ func (s *Server) DeleteOrganization(ctx context.Context, req *adminv1.DeleteOrga
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// The audit log isn't deleted with the org, so the deletion itself is kept on record
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.delete",
+		Target: org.Name,
+		Before: orgAuditState(org),
+	})
+
 	return &adminv1.DeleteOrganizationResponse{}, nil
 }
 
@@ -174,6 +190,7 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 	}
 
 	nameChanged := req.NewName != nil && *req.NewName != org.Name
+	before := orgAuditState(org)
 
 	org, err = s.admin.DB.UpdateOrganization(ctx, org.ID, &database.UpdateOrganizationOptions{
 		Name:                    valOrDefault(req.NewName, org.Name),
@@ -188,6 +205,14 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.update",
+		Target: org.Name,
+		Before: before,
+		After:  orgAuditState(org),
+	})
+
 	if nameChanged {
 		err := s.admin.UpdateOrgDeploymentAnnotations(ctx, org)
 		if err != nil {
@@ -355,23 +380,30 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:  org.ID,
+			Action: "org.member.add",
+			Target: req.Email,
+			After:  map[string]any{"role": role.Name, "pending_signup": true},
+		})
+
 		return &adminv1.AddOrganizationMemberResponse{
 			PendingSignup: true,
 		}, nil
 	}
 
-	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	err = s.admin.DB.InsertOrganizationMemberUser(ctx, org.ID, user.ID, role.ID)
+	err = s.admin.DB.InsertOrganizationMemberUser(txCtx, org.ID, user.ID, role.ID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = s.admin.DB.InsertUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+	err = s.admin.DB.InsertUsergroupMember(txCtx, *org.AllUsergroupID, user.ID)
 	if err != nil {
 		if !errors.Is(err, database.ErrNotUnique) {
 			return nil, status.Error(codes.Internal, err.Error())
@@ -384,6 +416,13 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.member.add",
+		Target: req.Email,
+		After:  map[string]any{"role": role.Name},
+	})
+
 	err = s.admin.Email.SendOrganizationAddition(&email.OrganizationAddition{
 		ToEmail:       req.Email,
 		ToName:        "",
@@ -434,6 +473,12 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:  org.ID,
+			Action: "org.member.remove",
+			Target: req.Email,
+			Before: map[string]any{"pending_signup": true},
+		})
 		return &adminv1.RemoveOrganizationMemberResponse{}, nil
 	}
 
@@ -453,25 +498,25 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		return nil, status.Error(codes.InvalidArgument, "cannot remove the last owner")
 	}
 
-	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	defer func() { _ = tx.Rollback() }()
-	err = s.admin.DB.DeleteOrganizationMemberUser(ctx, org.ID, user.ID)
+	err = s.admin.DB.DeleteOrganizationMemberUser(txCtx, org.ID, user.ID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+	// delete from all user groups in the org
+	err = s.admin.DB.DeleteAllUsergroupMemberUserForOrganization(txCtx, org.ID, user.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	// delete from projects if KeepProjectRoles flag is set
 	if !req.KeepProjectRoles {
-		err = s.admin.DB.DeleteAllProjectMemberUserForOrganization(ctx, org.ID, user.ID)
+		err = s.admin.DB.DeleteAllProjectMemberUserForOrganization(txCtx, org.ID, user.ID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -482,6 +527,13 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.member.remove",
+		Target: req.Email,
+		Before: map[string]any{"keep_project_roles": req.KeepProjectRoles},
+	})
+
 	return &adminv1.RemoveOrganizationMemberResponse{}, nil
 }
 
@@ -523,6 +575,12 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:  org.ID,
+			Action: "org.member.set_role",
+			Target: req.Email,
+			After:  map[string]any{"role": role.Name, "pending_signup": true},
+		})
 		return &adminv1.SetOrganizationMemberRoleResponse{}, nil
 	}
 
@@ -548,6 +606,13 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.member.set_role",
+		Target: req.Email,
+		After:  map[string]any{"role": role.Name},
+	})
+
 	return &adminv1.SetOrganizationMemberRoleResponse{}, nil
 }
 
@@ -588,18 +653,18 @@ func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrgani
 		return nil, status.Error(codes.InvalidArgument, "cannot remove the last owner")
 	}
 
-	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	defer func() { _ = tx.Rollback() }()
-	err = s.admin.DB.DeleteOrganizationMemberUser(ctx, org.ID, claims.OwnerID())
+	err = s.admin.DB.DeleteOrganizationMemberUser(txCtx, org.ID, claims.OwnerID())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, claims.OwnerID())
+	// delete from all user groups in the org
+	err = s.admin.DB.DeleteAllUsergroupMemberUserForOrganization(txCtx, org.ID, claims.OwnerID())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -608,6 +673,11 @@ func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrgani
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.member.leave",
+	})
+
 	return &adminv1.LeaveOrganizationResponse{}, nil
 }
 
@@ -663,7 +733,7 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// filter out users who are already members of the org
+	// filter out users who are already members of the org or were deactivated in the org by its identity provider
 	newUsers := make([]*database.User, 0)
 	for _, user := range users {
 		// check if user is already a member of the org
@@ -671,18 +741,25 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		if !exists {
+		if exists {
+			continue
+		}
+		deactivated, err := s.admin.DB.CheckUserIsDeactivatedInOrganization(ctx, user.ID, org.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if !deactivated {
 			newUsers = append(newUsers, user)
 		}
 	}
 
-	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	_, err = s.admin.DB.InsertOrganizationWhitelistedDomain(ctx, &database.InsertOrganizationWhitelistedDomainOptions{
+	_, err = s.admin.DB.InsertOrganizationWhitelistedDomain(txCtx, &database.InsertOrganizationWhitelistedDomainOptions{
 		OrgID:     org.ID,
 		OrgRoleID: role.ID,
 		Domain:    req.Domain,
@@ -693,13 +770,13 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 	}
 
 	for _, user := range newUsers {
-		err = s.admin.DB.InsertOrganizationMemberUser(ctx, org.ID, user.ID, role.ID)
+		err = s.admin.DB.InsertOrganizationMemberUser(txCtx, org.ID, user.ID, role.ID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
 		// add to all user group
-		err = s.admin.DB.InsertUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+		err = s.admin.DB.InsertUsergroupMember(txCtx, *org.AllUsergroupID, user.ID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -710,6 +787,17 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		return nil, err
 	}
 
+	addedEmails := make([]string, len(newUsers))
+	for i, user := range newUsers {
+		addedEmails[i] = user.Email
+	}
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.whitelisted_domain.add",
+		Target: req.Domain,
+		After:  map[string]any{"role": role.Name, "added_members": addedEmails},
+	})
+
 	return &adminv1.CreateWhitelistedDomainResponse{}, nil
 }
 
@@ -746,6 +834,12 @@ func (s *Server) RemoveWhitelistedDomain(ctx context.Context, req *adminv1.Remov
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.whitelisted_domain.remove",
+		Target: req.Domain,
+	})
+
 	return &adminv1.RemoveWhitelistedDomainResponse{}, nil
 }
 
@@ -826,6 +920,14 @@ func (s *Server) SudoUpdateOrganizationQuotas(ctx context.Context, req *adminv1.
 		return nil, err
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.quotas.update",
+		Target: org.Name,
+		Before: orgAuditState(org),
+		After:  orgAuditState(updatedOrg),
+	})
+
 	return &adminv1.SudoUpdateOrganizationQuotasResponse{
 		Organization: organizationToDTO(updatedOrg),
 	}, nil
@@ -848,6 +950,19 @@ func organizationToDTO(o *database.Organization) *adminv1.Organization {
 	}
 }
 
+// orgAuditState returns the org fields recorded in the audit log when an org changes.
+func orgAuditState(o *database.Organization) map[string]any {
+	return map[string]any{
+		"name":                       o.Name,
+		"description":                o.Description,
+		"quota_projects":             o.QuotaProjects,
+		"quota_deployments":          o.QuotaDeployments,
+		"quota_slots_total":          o.QuotaSlotsTotal,
+		"quota_slots_per_deployment": o.QuotaSlotsPerDeployment,
+		"quota_outstanding_invites":  o.QuotaOutstandingInvites,
+	}
+}
+
 func whitelistedDomainToPB(a *database.OrganizationWhitelistedDomainWithJoinedRoleNames) *adminv1.WhitelistedDomain {
 	return &adminv1.WhitelistedDomain{
 		Domain: a.Domain,
@@ -282,6 +282,15 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       org.ID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "project.create",
+		Target:      proj.Name,
+		After:       projectAuditState(proj),
+	})
+
 	return &adminv1.CreateProjectResponse{
 		Project: s.projToDTO(proj, org.Name),
 	}, nil
@@ -308,6 +317,15 @@ func (s *Server) DeleteProject(ctx context.Context, req *adminv1.DeleteProjectRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "project.delete",
+		Target:      proj.Name,
+		Before:      projectAuditState(proj),
+	})
+
 	return &adminv1.DeleteProjectResponse{}, nil
 }
 
@@ -391,11 +409,22 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 		ProdTTLSeconds:       prodTTLSeconds,
 		Region:               valOrDefault(req.Region, proj.Region),
 	}
+	before := projectAuditState(proj)
 	proj, err = s.admin.UpdateProject(ctx, proj, opts)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "project.update",
+		Target:      proj.Name,
+		Before:      before,
+		After:       projectAuditState(proj),
+	})
+
 	return &adminv1.UpdateProjectResponse{
 		Project: s.projToDTO(proj, req.OrganizationName),
 	}, nil
@@ -436,6 +465,7 @@ func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.Update
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to update project variables")
 	}
 
+	before := proj.ProdVariables
 	proj, err = s.admin.UpdateProject(ctx, proj, &database.UpdateProjectOptions{
 		Name:                 proj.Name,
 		Description:          proj.Description,
@@ -453,6 +483,17 @@ func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.Update
 		return nil, status.Errorf(codes.Internal, "variables updated failed with error %s", err.Error())
 	}
 
+	// Variable values are redacted in the audit log, but the names of changed variables are kept
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "project.variables.update",
+		Target:      proj.Name,
+		Before:      map[string]any{"prod_variables": before},
+		After:       map[string]any{"prod_variables": proj.ProdVariables},
+	})
+
 	return &adminv1.UpdateProjectVariablesResponse{Variables: proj.ProdVariables}, nil
 }
 
@@ -573,7 +614,7 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: org %q can at most have %d outstanding invitations", org.Name, org.QuotaOutstandingInvites)
 	}
 
-	role, err := s.admin.DB.FindProjectRole(ctx, req.Role)
+	role, err := s.admin.DB.FindProjectRoleForOrganization(ctx, proj.OrganizationID, req.Role)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -620,6 +661,15 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:       proj.OrganizationID,
+			ProjectID:   proj.ID,
+			ProjectName: proj.Name,
+			Action:      "project.member.add",
+			Target:      req.Email,
+			After:       map[string]any{"role": role.Name, "pending_signup": true},
+		})
+
 		return &adminv1.AddProjectMemberResponse{
 			PendingSignup: true,
 		}, nil
@@ -630,6 +680,15 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "project.member.add",
+		Target:      req.Email,
+		After:       map[string]any{"role": role.Name},
+	})
+
 	err = s.admin.Email.SendProjectAddition(&email.ProjectAddition{
 		ToEmail:       req.Email,
 		ToName:        "",
@@ -681,6 +740,14 @@ func (s *Server) RemoveProjectMember(ctx context.Context, req *adminv1.RemovePro
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:       proj.OrganizationID,
+			ProjectID:   proj.ID,
+			ProjectName: proj.Name,
+			Action:      "project.member.remove",
+			Target:      req.Email,
+			Before:      map[string]any{"pending_signup": true},
+		})
 		return &adminv1.RemoveProjectMemberResponse{}, nil
 	}
 
@@ -689,6 +756,14 @@ func (s *Server) RemoveProjectMember(ctx context.Context, req *adminv1.RemovePro
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "project.member.remove",
+		Target:      req.Email,
+	})
+
 	return &adminv1.RemoveProjectMemberResponse{}, nil
 }
 
@@ -709,7 +784,7 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 		return nil, status.Error(codes.PermissionDenied, "not allowed to set project member roles")
 	}
 
-	role, err := s.admin.DB.FindProjectRole(ctx, req.Role)
+	role, err := s.admin.DB.FindProjectRoleForOrganization(ctx, proj.OrganizationID, req.Role)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -731,6 +806,14 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:       proj.OrganizationID,
+			ProjectID:   proj.ID,
+			ProjectName: proj.Name,
+			Action:      "project.member.set_role",
+			Target:      req.Email,
+			After:       map[string]any{"role": role.Name, "pending_signup": true},
+		})
 		return &adminv1.SetProjectMemberRoleResponse{}, nil
 	}
 
@@ -739,6 +822,15 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "project.member.set_role",
+		Target:      req.Email,
+		After:       map[string]any{"role": role.Name},
+	})
+
 	return &adminv1.SetProjectMemberRoleResponse{}, nil
 }
 
@@ -779,6 +871,23 @@ func (s *Server) getAndCheckGithubInstallationID(ctx context.Context, githubURL,
 	return installationID, nil
 }
 
+// projectAuditState returns the project fields recorded in the audit log when a project changes.
+func projectAuditState(p *database.Project) map[string]any {
+	return map[string]any{
+		"name":             p.Name,
+		"description":      p.Description,
+		"public":           p.Public,
+		"region":           p.Region,
+		"github_url":       safeStr(p.GithubURL),
+		"subpath":          p.Subpath,
+		"prod_branch":      p.ProdBranch,
+		"prod_olap_driver": p.ProdOLAPDriver,
+		"prod_olap_dsn":    p.ProdOLAPDSN,
+		"prod_slots":       p.ProdSlots,
+		"prod_ttl_seconds": p.ProdTTLSeconds,
+	}
+}
+
 func (s *Server) projToDTO(p *database.Project, orgName string) *adminv1.Project {
 	frontendURL, _ := url.JoinPath(s.opts.FrontendURL, orgName, p.Name)
 
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/auth"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -115,6 +116,15 @@ func (s *Server) CreateReport(ctx context.Context, req *adminv1.CreateReportRequ
 		return nil, status.Errorf(codes.Internal, "failed to insert virtual file: %s", err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "report.create",
+		Target:      name,
+		After:       req.Options,
+	})
+
 	err = s.admin.TriggerReconcileAndAwaitReport(ctx, depl, name)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -173,6 +183,11 @@ func (s *Server) EditReport(ctx context.Context, req *adminv1.EditReportRequest)
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to edit report")
 	}
 
+	prevOpts, err := recreateReportOptionsFromSpec(spec)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to recreate report options: %s", err.Error())
+	}
+
 	data, err := s.yamlForManagedReport(req.Options, req.Name, annotations.AdminOwnerUserID)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to generate report YAML: %s", err.Error())
@@ -188,6 +203,16 @@ func (s *Server) EditReport(ctx context.Context, req *adminv1.EditReportRequest)
 		return nil, status.Errorf(codes.Internal, "failed to update virtual file: %s", err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "report.edit",
+		Target:      req.Name,
+		Before:      prevOpts,
+		After:       req.Options,
+	})
+
 	err = s.admin.TriggerReconcileAndAwaitReport(ctx, depl, req.Name)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -287,6 +312,15 @@ func (s *Server) UnsubscribeReport(ctx context.Context, req *adminv1.Unsubscribe
 		}
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "report.unsubscribe",
+		Target:      req.Name,
+		Before:      map[string]any{"email": user.Email},
+	})
+
 	err = s.admin.TriggerReconcileAndAwaitReport(ctx, depl, req.Name)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -348,6 +382,14 @@ func (s *Server) DeleteReport(ctx context.Context, req *adminv1.DeleteReportRequ
 		return nil, status.Errorf(codes.Internal, "failed to delete virtual file: %s", err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "report.delete",
+		Target:      req.Name,
+	})
+
 	err = s.admin.TriggerReconcileAndAwaitReport(ctx, depl, req.Name)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -405,6 +447,14 @@ func (s *Server) TriggerReport(ctx context.Context, req *adminv1.TriggerReportRe
 		return nil, status.Errorf(codes.Internal, "failed to trigger report: %s", err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		Action:      "report.trigger",
+		Target:      req.Name,
+	})
+
 	return &adminv1.TriggerReportResponse{}, nil
 }
 
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ListProjectRoles(ctx context.Context, req *adminv1.ListProjectRolesRequest) (*adminv1.ListProjectRolesResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ReadOrg {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to read org roles")
+	}
+
+	roles, err := s.admin.DB.FindProjectRolesForOrganization(ctx, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.ProjectRole, len(roles))
+	for i, role := range roles {
+		dtos[i] = projectRoleToPB(role)
+	}
+
+	return &adminv1.ListProjectRolesResponse{Roles: dtos}, nil
+}
+
+func (s *Server) CreateProjectRole(ctx context.Context, req *adminv1.CreateProjectRoleRequest) (*adminv1.CreateProjectRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to create org roles")
+	}
+
+	// Custom roles can't shadow the built-in roles
+	_, err = s.admin.DB.FindProjectRole(ctx, req.Name)
+	if err == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "a built-in role named %q already exists", req.Name)
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	perms := req.Permissions
+	role, err := s.admin.DB.InsertProjectRole(ctx, &database.InsertProjectRoleOptions{
+		OrgID:                org.ID,
+		Name:                 req.Name,
+		ReadProject:          perms.ReadProject,
+		ManageProject:        perms.ManageProject,
+		ReadProd:             perms.ReadProd,
+		ReadProdStatus:       perms.ReadProdStatus,
+		ManageProd:           perms.ManageProd,
+		ReadDev:              perms.ReadDev,
+		ReadDevStatus:        perms.ReadDevStatus,
+		ManageDev:            perms.ManageDev,
+		ReadProjectMembers:   perms.ReadProjectMembers,
+		ManageProjectMembers: perms.ManageProjectMembers,
+		CreateReports:        perms.CreateReports,
+		ManageReports:        perms.ManageReports,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "role.create",
+		Target: role.Name,
+		After:  perms,
+	})
+
+	return &adminv1.CreateProjectRoleResponse{Role: projectRoleToPB(role)}, nil
+}
+
+func (s *Server) DeleteProjectRole(ctx context.Context, req *adminv1.DeleteProjectRoleRequest) (*adminv1.DeleteProjectRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to delete org roles")
+	}
+
+	role, err := s.admin.DB.FindProjectRoleForOrganization(ctx, org.ID, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if role.OrgID == nil {
+		return nil, status.Error(codes.InvalidArgument, "cannot delete a built-in role")
+	}
+
+	// Members and user groups assigned the role lose the access it granted
+	err = s.admin.DB.DeleteProjectRole(ctx, role.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "role.delete",
+		Target: role.Name,
+		Before: projectRoleToPB(role).Permissions,
+	})
+
+	return &adminv1.DeleteProjectRoleResponse{}, nil
+}
+
+func projectRoleToPB(r *database.ProjectRole) *adminv1.ProjectRole {
+	return &adminv1.ProjectRole{
+		Id:      r.ID,
+		Name:    r.Name,
+		Builtin: r.OrgID == nil,
+		Permissions: &adminv1.ProjectPermissions{
+			ReadProject:          r.ReadProject,
+			ManageProject:        r.ManageProject,
+			ReadProd:             r.ReadProd,
+			ReadProdStatus:       r.ReadProdStatus,
+			ManageProd:           r.ManageProd,
+			ReadDev:              r.ReadDev,
+			ReadDevStatus:        r.ReadDevStatus,
+			ManageDev:            r.ManageDev,
+			ReadProjectMembers:   r.ReadProjectMembers,
+			ManageProjectMembers: r.ManageProjectMembers,
+			CreateReports:        r.CreateReports,
+			ManageReports:        r.ManageReports,
+		},
+	}
+}
@@ -0,0 +1,1336 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	"github.com/rilldata/rill/admin/server/auth"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// SCIM 2.0 schemas and message types (see RFC 7643 and RFC 7644).
+const (
+	scimSchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimSchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimContentType        = "application/scim+json"
+	scimMaxBodyBytes       = 1 << 20
+)
+
+// registerSCIMEndpoints registers the SCIM 2.0 provisioning endpoints on /scim/v2/{org}/Users and /scim/v2/{org}/Groups.
+// They let an identity provider manage the org's members and user groups. Requests must be authenticated with a token for a service in the org.
+func (s *Server) registerSCIMEndpoints(mux *http.ServeMux) {
+	handler := otelhttp.WithRouteTag("/scim/v2", s.authenticator.HTTPMiddleware(http.HandlerFunc(s.scimHandler)))
+	mux.Handle("/scim/v2/", observability.Middleware("admin", s.logger, handler))
+}
+
+// scimError is an error that is returned to the client as a SCIM error response.
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func newSCIMError(status int, scimType, format string, args ...any) *scimError {
+	return &scimError{status: status, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+type scimMeta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimValue struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type scimUser struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *scimName   `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []scimValue `json:"emails,omitempty"`
+	Active      *scimBool   `json:"active,omitempty"`
+	Roles       []scimValue `json:"roles,omitempty"`
+	Meta        *scimMeta   `json:"meta,omitempty"`
+}
+
+type scimGroup struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []scimValue `json:"members,omitempty"`
+	Meta        *scimMeta   `json:"meta,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type scimPatchRequest struct {
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+type scimPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// scimBool is a boolean that also accepts the string values "true" and "false" (some identity providers send booleans as strings).
+type scimBool bool
+
+func (b *scimBool) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case bool:
+		*b = scimBool(v)
+	case string:
+		res, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", v)
+		}
+		*b = scimBool(res)
+	default:
+		return fmt.Errorf("invalid boolean %s", string(data))
+	}
+	return nil
+}
+
+func (s *Server) scimHandler(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, scimMaxBodyBytes)
+	err := s.serveSCIM(w, r)
+	if err == nil {
+		return
+	}
+
+	var serr *scimError
+	if !errors.As(err, &serr) {
+		switch {
+		case errors.Is(err, database.ErrNotFound):
+			serr = newSCIMError(http.StatusNotFound, "", "%s", err.Error())
+		case errors.Is(err, database.ErrNotUnique):
+			serr = newSCIMError(http.StatusConflict, "uniqueness", "%s", err.Error())
+		default:
+			s.logger.Error("scim request failed", zap.String("path", r.URL.Path), zap.Error(err), observability.ZapCtx(r.Context()))
+			serr = newSCIMError(http.StatusInternalServerError, "", "%s", err.Error())
+		}
+	}
+
+	writeSCIM(w, serr.status, map[string]any{
+		"schemas":  []string{scimSchemaError},
+		"status":   strconv.Itoa(serr.status),
+		"scimType": serr.scimType,
+		"detail":   serr.detail,
+	})
+}
+
+func (s *Server) serveSCIM(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	// Parse /scim/v2/{org}/{resource}[/{id}]
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/scim/v2/"), "/"), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return newSCIMError(http.StatusNotFound, "", "unknown SCIM endpoint %q", r.URL.Path)
+	}
+	orgName, resource := parts[0], parts[1]
+	var id string
+	if len(parts) == 3 {
+		id = parts[2]
+	}
+
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", orgName),
+		attribute.String("args.resource", resource),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeService {
+		return newSCIMError(http.StatusUnauthorized, "", "SCIM requests must be authenticated with a service token")
+	}
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, orgName)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return newSCIMError(http.StatusNotFound, "", "org not found")
+		}
+		return err
+	}
+
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrgMembers {
+		return newSCIMError(http.StatusForbidden, "", "the service is not allowed to manage members of org %q", org.Name)
+	}
+
+	switch resource {
+	case "Users":
+		switch {
+		case id == "" && r.Method == http.MethodGet:
+			return s.scimListUsers(w, r, org)
+		case id == "" && r.Method == http.MethodPost:
+			return s.scimCreateUser(w, r, org)
+		case id != "" && r.Method == http.MethodGet:
+			return s.scimGetUser(w, r, org, id)
+		case id != "" && r.Method == http.MethodPut:
+			return s.scimReplaceUser(w, r, org, id)
+		case id != "" && r.Method == http.MethodPatch:
+			return s.scimPatchUser(w, r, org, id)
+		case id != "" && r.Method == http.MethodDelete:
+			return s.scimDeleteUser(w, r, org, id)
+		}
+	case "Groups":
+		switch {
+		case id == "" && r.Method == http.MethodGet:
+			return s.scimListGroups(w, r, org)
+		case id == "" && r.Method == http.MethodPost:
+			return s.scimCreateGroup(w, r, org)
+		case id != "" && r.Method == http.MethodGet:
+			return s.scimGetGroup(w, r, org, id)
+		case id != "" && r.Method == http.MethodPut:
+			return s.scimReplaceGroup(w, r, org, id)
+		case id != "" && r.Method == http.MethodPatch:
+			return s.scimPatchGroup(w, r, org, id)
+		case id != "" && r.Method == http.MethodDelete:
+			return s.scimDeleteGroup(w, r, org, id)
+		}
+	default:
+		return newSCIMError(http.StatusNotFound, "", "unknown SCIM resource %q", resource)
+	}
+
+	return newSCIMError(http.StatusMethodNotAllowed, "", "method %s not supported for %s", r.Method, r.URL.Path)
+}
+
+func (s *Server) scimListUsers(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	userName, err := parseSCIMFilter(r.URL.Query().Get("filter"), "userName")
+	if err != nil {
+		return err
+	}
+
+	// Deactivated users are listed too, so the identity provider can find and reactivate them
+	var users []*database.OrganizationUser
+	var total, start int
+	if userName != "" {
+		user, err := s.admin.DB.FindUserByEmail(ctx, userName)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		if err == nil {
+			orgUser, err := s.admin.DB.FindOrganizationUser(ctx, org.ID, user.ID)
+			if err != nil && !errors.Is(err, database.ErrNotFound) {
+				return err
+			}
+			if err == nil {
+				users = append(users, orgUser)
+			}
+		}
+
+		var end int
+		total = len(users)
+		start, end, err = parseSCIMPagination(r.URL.Query(), total)
+		if err != nil {
+			return err
+		}
+		users = users[start:end]
+	} else {
+		total, err = s.admin.DB.CountOrganizationUsers(ctx, org.ID)
+		if err != nil {
+			return err
+		}
+
+		var end int
+		start, end, err = parseSCIMPagination(r.URL.Query(), total)
+		if err != nil {
+			return err
+		}
+
+		users, err = s.admin.DB.FindOrganizationUsers(ctx, org.ID, start, end-start)
+		if err != nil {
+			return err
+		}
+	}
+
+	resources := make([]any, 0, len(users))
+	for _, u := range users {
+		resources = append(resources, s.scimOrgUserResource(org, u))
+	}
+
+	writeSCIM(w, http.StatusOK, &scimListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   start + 1,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+	return nil
+}
+
+func (s *Server) scimGetUser(w http.ResponseWriter, r *http.Request, org *database.Organization, id string) error {
+	orgUser, err := s.scimFindOrgUser(r.Context(), org, id)
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusOK, s.scimOrgUserResource(org, orgUser))
+	return nil
+}
+
+func (s *Server) scimCreateUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	req := &scimUser{}
+	if err := decodeSCIMBody(r, req); err != nil {
+		return err
+	}
+
+	email := req.UserName
+	if email == "" {
+		for _, e := range req.Emails {
+			if email == "" || e.Primary {
+				email = e.Value
+			}
+		}
+	}
+	if email == "" {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "userName is required")
+	}
+
+	role, err := s.scimOrgRole(ctx, req.Roles)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.admin.DB.FindUserByEmail(ctx, email)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+
+		// Users are global, so the profile is only set when the user doesn't exist yet.
+		// NOTE: This may add the user to the org through a pending invite or a whitelisted domain.
+		user, err = s.admin.CreateOrUpdateUser(ctx, email, req.displayName(), "")
+		if err != nil {
+			return newSCIMError(http.StatusBadRequest, "invalidValue", "%s", err.Error())
+		}
+	} else {
+		// Deactivated users also exist in the org; the identity provider must reactivate them instead.
+		_, err := s.admin.DB.FindOrganizationUser(ctx, org.ID, user.ID)
+		if err == nil {
+			return newSCIMError(http.StatusConflict, "uniqueness", "user %q already exists in the org", email)
+		}
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+	}
+
+	active := req.Active == nil || bool(*req.Active)
+	roleName := ""
+	if active {
+		roleName, err = s.scimActivateUser(ctx, org, user.ID, user.Email, role)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = s.scimCreateDeactivatedUser(ctx, org, user.ID, user.Email, role)
+		if err != nil {
+			return err
+		}
+	}
+
+	writeSCIM(w, http.StatusCreated, s.scimUserResource(org, user.ID, user.Email, user.DisplayName, roleName, active, user.CreatedOn, user.UpdatedOn))
+	return nil
+}
+
+func (s *Server) scimReplaceUser(w http.ResponseWriter, r *http.Request, org *database.Organization, id string) error {
+	ctx := r.Context()
+
+	req := &scimUser{}
+	if err := decodeSCIMBody(r, req); err != nil {
+		return err
+	}
+
+	orgUser, err := s.scimFindOrgUser(ctx, org, id)
+	if err != nil {
+		return err
+	}
+
+	role, err := s.scimOrgRole(ctx, req.Roles)
+	if err != nil {
+		return err
+	}
+
+	active := req.Active == nil || bool(*req.Active)
+	return s.scimApplyUser(w, ctx, org, orgUser, active, role)
+}
+
+func (s *Server) scimPatchUser(w http.ResponseWriter, r *http.Request, org *database.Organization, id string) error {
+	ctx := r.Context()
+
+	req := &scimPatchRequest{}
+	if err := decodeSCIMBody(r, req); err != nil {
+		return err
+	}
+
+	orgUser, err := s.scimFindOrgUser(ctx, org, id)
+	if err != nil {
+		return err
+	}
+
+	// Only the active flag and roles can be changed. Other attributes (like the user's name) are managed by the user.
+	var active *scimBool
+	var roles []scimValue
+	for _, op := range req.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			patch := &scimUser{}
+			switch strings.ToLower(op.Path) {
+			case "":
+				err = json.Unmarshal(op.Value, patch)
+			case "active":
+				err = json.Unmarshal(op.Value, &patch.Active)
+			case "roles":
+				err = json.Unmarshal(op.Value, &patch.Roles)
+			default:
+				continue
+			}
+			if err != nil {
+				return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid value for path %q: %s", op.Path, err.Error())
+			}
+			if patch.Active != nil {
+				active = patch.Active
+			}
+			if patch.Roles != nil {
+				roles = patch.Roles
+			}
+		case "remove":
+			if strings.EqualFold(op.Path, "roles") {
+				roles = []scimValue{{Value: database.OrganizationRoleNameViewer}}
+			}
+		default:
+			return newSCIMError(http.StatusBadRequest, "invalidSyntax", "unsupported patch operation %q", op.Op)
+		}
+	}
+
+	if active == nil {
+		active = (*scimBool)(&orgUser.Active)
+	}
+
+	role, err := s.scimOrgRole(ctx, roles)
+	if err != nil {
+		return err
+	}
+
+	return s.scimApplyUser(w, ctx, org, orgUser, bool(*active), role)
+}
+
+func (s *Server) scimDeleteUser(w http.ResponseWriter, r *http.Request, org *database.Organization, id string) error {
+	ctx := r.Context()
+
+	orgUser, err := s.scimFindOrgUser(ctx, org, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.scimRemoveUser(ctx, org, orgUser.ID, orgUser.Email, false)
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// scimApplyUser activates or deactivates the user in the org and writes the resulting user resource.
+func (s *Server) scimApplyUser(w http.ResponseWriter, ctx context.Context, org *database.Organization, orgUser *database.OrganizationUser, active bool, role *database.OrganizationRole) error {
+	roleName := ""
+	if active {
+		var err error
+		roleName, err = s.scimActivateUser(ctx, org, orgUser.ID, orgUser.Email, role)
+		if err != nil {
+			return err
+		}
+	} else {
+		err := s.scimRemoveUser(ctx, org, orgUser.ID, orgUser.Email, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	writeSCIM(w, http.StatusOK, s.scimUserResource(org, orgUser.ID, orgUser.Email, orgUser.DisplayName, roleName, active, orgUser.CreatedOn, orgUser.UpdatedOn))
+	return nil
+}
+
+// scimActivateUser makes the user a member of the org. If role is nil, existing members keep their role,
+// deactivated users get back their previous role and new members get the viewer role.
+// It returns the name of the user's org role.
+func (s *Server) scimActivateUser(ctx context.Context, org *database.Organization, userID, email string, role *database.OrganizationRole) (string, error) {
+	member, err := s.admin.DB.FindOrganizationMemberUser(ctx, org.ID, userID)
+	if err == nil {
+		if role == nil || strings.EqualFold(member.RoleName, role.Name) {
+			return member.RoleName, nil
+		}
+
+		if member.RoleName == database.OrganizationRoleNameAdmin {
+			err = s.scimCheckNotLastAdmin(ctx, org, userID)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		err = s.admin.DB.UpdateOrganizationMemberUserRole(ctx, org.ID, userID, role.ID)
+		if err != nil {
+			return "", err
+		}
+
+		s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:  org.ID,
+			Action: "org.member.set_role",
+			Target: email,
+			Before: map[string]any{"role": member.RoleName},
+			After:  map[string]any{"role": role.Name},
+		})
+		return role.Name, nil
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return "", err
+	}
+
+	if role == nil {
+		// The user isn't a member, so this only finds users who were deactivated
+		orgUser, err := s.admin.DB.FindOrganizationUser(ctx, org.ID, userID)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return "", err
+		}
+		name := database.OrganizationRoleNameViewer
+		if err == nil && orgUser.RoleName != "" {
+			name = orgUser.RoleName
+		}
+		role, err = s.admin.DB.FindOrganizationRole(ctx, name)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.InsertOrganizationMemberUser(txCtx, org.ID, userID, role.ID)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.admin.DB.InsertUsergroupMember(txCtx, *org.AllUsergroupID, userID)
+	if err != nil && !errors.Is(err, database.ErrNotUnique) {
+		return "", err
+	}
+
+	err = s.admin.DB.DeleteOrganizationDeactivatedUser(txCtx, org.ID, userID)
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return "", err
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.member.add",
+		Target: email,
+		After:  map[string]any{"role": role.Name},
+	})
+
+	return role.Name, nil
+}
+
+// scimRemoveUser removes the user from the org, its user groups and its projects, which revokes the user's access to the org.
+// If deactivate is true, the user is kept as a deactivated user of the org (with its current role), so it can still be found and reactivated.
+// Otherwise, the user is removed from the org's deactivated users too.
+func (s *Server) scimRemoveUser(ctx context.Context, org *database.Organization, userID, email string, deactivate bool) error {
+	member, err := s.admin.DB.FindOrganizationMemberUser(ctx, org.ID, userID)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return err
+	}
+	isMember := err == nil
+
+	var role *database.OrganizationRole
+	if isMember {
+		err = s.scimCheckNotLastAdmin(ctx, org, userID)
+		if err != nil {
+			return err
+		}
+
+		role, err = s.admin.DB.FindOrganizationRole(ctx, member.RoleName)
+		if err != nil {
+			return err
+		}
+	}
+
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if isMember {
+		err = s.admin.DB.DeleteOrganizationMemberUser(txCtx, org.ID, userID)
+		if err != nil {
+			return err
+		}
+
+		err = s.admin.DB.DeleteAllUsergroupMemberUserForOrganization(txCtx, org.ID, userID)
+		if err != nil {
+			return err
+		}
+
+		err = s.admin.DB.DeleteAllProjectMemberUserForOrganization(txCtx, org.ID, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if deactivate && isMember {
+		err = s.admin.DB.InsertOrganizationDeactivatedUser(txCtx, org.ID, userID, role.ID)
+	} else if !deactivate {
+		err = s.admin.DB.DeleteOrganizationDeactivatedUser(txCtx, org.ID, userID)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	if isMember {
+		action := "org.member.remove"
+		if deactivate {
+			action = "org.member.deactivate"
+		}
+		s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:  org.ID,
+			Action: action,
+			Target: email,
+		})
+	}
+
+	return nil
+}
+
+// scimCreateDeactivatedUser adds a user who is created as inactive to the org's deactivated users, so it can be found and activated later.
+// If role is nil, the user gets the viewer role when activated.
+func (s *Server) scimCreateDeactivatedUser(ctx context.Context, org *database.Organization, userID, email string, role *database.OrganizationRole) error {
+	// The user may have joined the org when it was created (e.g. through a whitelisted domain)
+	isMember, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, userID, org.ID)
+	if err != nil {
+		return err
+	}
+	if isMember {
+		return s.scimRemoveUser(ctx, org, userID, email, true)
+	}
+
+	if role == nil {
+		role, err = s.admin.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameViewer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.admin.DB.InsertOrganizationDeactivatedUser(ctx, org.ID, userID, role.ID)
+}
+
+// scimCheckNotLastAdmin returns an error if the user is the org's only admin.
+func (s *Server) scimCheckNotLastAdmin(ctx context.Context, org *database.Organization, userID string) error {
+	role, err := s.admin.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+	if err != nil {
+		return err
+	}
+
+	admins, err := s.admin.DB.FindOrganizationMemberUsersByRole(ctx, org.ID, role.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(admins) == 1 && admins[0].ID == userID {
+		return newSCIMError(http.StatusConflict, "mutability", "cannot remove or demote the last admin of the org")
+	}
+	return nil
+}
+
+// scimOrgRole resolves the org role for a SCIM user's roles. The primary (or first) role is used. It returns nil if no roles were provided.
+func (s *Server) scimOrgRole(ctx context.Context, roles []scimValue) (*database.OrganizationRole, error) {
+	if len(roles) == 0 {
+		return nil, nil
+	}
+
+	name := roles[0].Value
+	for _, r := range roles {
+		if r.Primary {
+			name = r.Value
+			break
+		}
+	}
+
+	role, err := s.admin.DB.FindOrganizationRole(ctx, strings.ToLower(name))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "unknown org role %q", name)
+		}
+		return nil, err
+	}
+	return role, nil
+}
+
+// scimFindOrgUser finds a member of the org or a user who was deactivated in the org. Other users are treated as not found.
+func (s *Server) scimFindOrgUser(ctx context.Context, org *database.Organization, id string) (*database.OrganizationUser, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, newSCIMError(http.StatusNotFound, "", "user %q not found", id)
+	}
+
+	orgUser, err := s.admin.DB.FindOrganizationUser(ctx, org.ID, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, newSCIMError(http.StatusNotFound, "", "user %q not found", id)
+		}
+		return nil, err
+	}
+	return orgUser, nil
+}
+
+func (s *Server) scimFindMember(ctx context.Context, org *database.Organization, id string) (*database.Member, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, newSCIMError(http.StatusNotFound, "", "user %q not found", id)
+	}
+
+	member, err := s.admin.DB.FindOrganizationMemberUser(ctx, org.ID, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, newSCIMError(http.StatusNotFound, "", "user %q not found", id)
+		}
+		return nil, err
+	}
+	return member, nil
+}
+
+// scimOrgUserResource returns the user resource for a member or deactivated user of the org.
+// Roles are only included for active users, since deactivated users have no role in the org.
+func (s *Server) scimOrgUserResource(org *database.Organization, u *database.OrganizationUser) *scimUser {
+	roleName := u.RoleName
+	if !u.Active {
+		roleName = ""
+	}
+	return s.scimUserResource(org, u.ID, u.Email, u.DisplayName, roleName, u.Active, u.CreatedOn, u.UpdatedOn)
+}
+
+func (s *Server) scimUserResource(org *database.Organization, id, email, displayName, roleName string, active bool, createdOn, updatedOn time.Time) *scimUser {
+	res := &scimUser{
+		Schemas:     []string{scimSchemaUser},
+		ID:          id,
+		UserName:    email,
+		DisplayName: displayName,
+		Emails:      []scimValue{{Value: email, Primary: true}},
+		Active:      (*scimBool)(&active),
+		Meta: &scimMeta{
+			ResourceType: "User",
+			Created:      &createdOn,
+			LastModified: &updatedOn,
+			Location:     urlutil.MustJoinURL(s.opts.ExternalURL, "scim", "v2", org.Name, "Users", id),
+		},
+	}
+	if displayName != "" {
+		res.Name = &scimName{Formatted: displayName}
+	}
+	if roleName != "" {
+		res.Roles = []scimValue{{Value: roleName, Primary: true}}
+	}
+	return res
+}
+
+func (u *scimUser) displayName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name == nil {
+		return ""
+	}
+	if u.Name.Formatted != "" {
+		return u.Name.Formatted
+	}
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+func (s *Server) scimListGroups(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	displayName, err := parseSCIMFilter(r.URL.Query().Get("filter"), "displayName")
+	if err != nil {
+		return err
+	}
+
+	// The all-users group is managed automatically, so it's not exposed to the identity provider
+	var groups []*database.Usergroup
+	var total, start int
+	if displayName != "" {
+		name, err := scimGroupName(displayName)
+		if err == nil {
+			group, err := s.admin.DB.FindUsergroupByName(ctx, org.ID, name)
+			if err != nil && !errors.Is(err, database.ErrNotFound) {
+				return err
+			}
+			if err == nil && (org.AllUsergroupID == nil || group.ID != *org.AllUsergroupID) {
+				groups = append(groups, group)
+			}
+		}
+
+		var end int
+		total = len(groups)
+		start, end, err = parseSCIMPagination(r.URL.Query(), total)
+		if err != nil {
+			return err
+		}
+		groups = groups[start:end]
+	} else {
+		total, err = s.admin.DB.CountCustomUsergroupsForOrganization(ctx, org.ID)
+		if err != nil {
+			return err
+		}
+
+		var end int
+		start, end, err = parseSCIMPagination(r.URL.Query(), total)
+		if err != nil {
+			return err
+		}
+
+		groups, err = s.admin.DB.FindCustomUsergroupsForOrganization(ctx, org.ID, start, end-start)
+		if err != nil {
+			return err
+		}
+	}
+
+	withMembers := !strings.Contains(strings.ToLower(r.URL.Query().Get("excludedAttributes")), "members")
+	resources := make([]any, 0, len(groups))
+	for _, g := range groups {
+		res, err := s.scimGroupResource(ctx, org, g, withMembers)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, res)
+	}
+
+	writeSCIM(w, http.StatusOK, &scimListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   start + 1,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+	return nil
+}
+
+func (s *Server) scimGetGroup(w http.ResponseWriter, r *http.Request, org *database.Organization, id string) error {
+	ctx := r.Context()
+
+	group, err := s.scimFindGroup(ctx, org, id)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroupResource(ctx, org, group, true)
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusOK, res)
+	return nil
+}
+
+func (s *Server) scimCreateGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	req := &scimGroup{}
+	if err := decodeSCIMBody(r, req); err != nil {
+		return err
+	}
+
+	name, err := scimGroupName(req.DisplayName)
+	if err != nil {
+		return err
+	}
+
+	group, err := s.admin.DB.InsertUsergroup(ctx, &database.InsertUsergroupOptions{
+		OrgID: org.ID,
+		Name:  name,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return newSCIMError(http.StatusConflict, "uniqueness", "a group named %q already exists", name)
+		}
+		return err
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "usergroup.create",
+		Target: group.Name,
+	})
+
+	err = s.scimSetGroupMembers(ctx, org, group, req.Members)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroupResource(ctx, org, group, true)
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusCreated, res)
+	return nil
+}
+
+func (s *Server) scimReplaceGroup(w http.ResponseWriter, r *http.Request, org *database.Organization, id string) error {
+	ctx := r.Context()
+
+	req := &scimGroup{}
+	if err := decodeSCIMBody(r, req); err != nil {
+		return err
+	}
+
+	group, err := s.scimFindGroup(ctx, org, id)
+	if err != nil {
+		return err
+	}
+
+	group, err = s.scimRenameGroup(ctx, org, group, req.DisplayName)
+	if err != nil {
+		return err
+	}
+
+	err = s.scimSetGroupMembers(ctx, org, group, req.Members)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroupResource(ctx, org, group, true)
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusOK, res)
+	return nil
+}
+
+func (s *Server) scimPatchGroup(w http.ResponseWriter, r *http.Request, org *database.Organization, id string) error {
+	ctx := r.Context()
+
+	req := &scimPatchRequest{}
+	if err := decodeSCIMBody(r, req); err != nil {
+		return err
+	}
+
+	group, err := s.scimFindGroup(ctx, org, id)
+	if err != nil {
+		return err
+	}
+
+	for _, op := range req.Operations {
+		path := strings.ToLower(op.Path)
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			patch := &scimGroup{}
+			switch path {
+			case "":
+				err = json.Unmarshal(op.Value, patch)
+			case "displayname":
+				err = json.Unmarshal(op.Value, &patch.DisplayName)
+			case "members":
+				err = json.Unmarshal(op.Value, &patch.Members)
+			default:
+				return newSCIMError(http.StatusBadRequest, "invalidPath", "unsupported path %q", op.Path)
+			}
+			if err != nil {
+				return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid value for path %q: %s", op.Path, err.Error())
+			}
+
+			if patch.DisplayName != "" {
+				group, err = s.scimRenameGroup(ctx, org, group, patch.DisplayName)
+				if err != nil {
+					return err
+				}
+			}
+
+			if strings.EqualFold(op.Op, "replace") && (path == "members" || patch.Members != nil) {
+				err = s.scimSetGroupMembers(ctx, org, group, patch.Members)
+			} else {
+				err = s.scimAddGroupMembers(ctx, org, group, patch.Members)
+			}
+			if err != nil {
+				return err
+			}
+		case "remove":
+			userIDs, err := parseSCIMMemberPath(op)
+			if err != nil {
+				return err
+			}
+			if userIDs == nil {
+				err = s.scimSetGroupMembers(ctx, org, group, nil)
+			} else {
+				err = s.scimRemoveGroupMembers(ctx, org, group, userIDs)
+			}
+			if err != nil {
+				return err
+			}
+		default:
+			return newSCIMError(http.StatusBadRequest, "invalidSyntax", "unsupported patch operation %q", op.Op)
+		}
+	}
+
+	res, err := s.scimGroupResource(ctx, org, group, true)
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusOK, res)
+	return nil
+}
+
+func (s *Server) scimDeleteGroup(w http.ResponseWriter, r *http.Request, org *database.Organization, id string) error {
+	ctx := r.Context()
+
+	group, err := s.scimFindGroup(ctx, org, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.DeleteUsergroup(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "usergroup.delete",
+		Target: group.Name,
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// scimFindGroup finds a user group in the org. The org's all-users group is treated as not found.
+func (s *Server) scimFindGroup(ctx context.Context, org *database.Organization, id string) (*database.Usergroup, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+	}
+
+	group, err := s.admin.DB.FindUsergroup(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+		}
+		return nil, err
+	}
+
+	if group.OrgID != org.ID || (org.AllUsergroupID != nil && group.ID == *org.AllUsergroupID) {
+		return nil, newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+	}
+	return group, nil
+}
+
+func (s *Server) scimRenameGroup(ctx context.Context, org *database.Organization, group *database.Usergroup, displayName string) (*database.Usergroup, error) {
+	name, err := scimGroupName(displayName)
+	if err != nil {
+		return nil, err
+	}
+	if name == group.Name {
+		return group, nil
+	}
+
+	updated, err := s.admin.DB.UpdateUsergroupName(ctx, name, group.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return nil, newSCIMError(http.StatusConflict, "uniqueness", "a group named %q already exists", name)
+		}
+		return nil, err
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "usergroup.rename",
+		Target: updated.Name,
+		Before: map[string]any{"name": group.Name},
+		After:  map[string]any{"name": updated.Name},
+	})
+
+	return updated, nil
+}
+
+// scimSetGroupMembers sets the group's members to exactly the provided users.
+func (s *Server) scimSetGroupMembers(ctx context.Context, org *database.Organization, group *database.Usergroup, members []scimValue) error {
+	current, err := s.admin.DB.FindUsergroupMemberUsers(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	desired := make(map[string]bool, len(members))
+	for _, m := range members {
+		desired[strings.ToLower(m.Value)] = true
+	}
+
+	var remove []string
+	for _, u := range current {
+		if !desired[u.ID] {
+			remove = append(remove, u.ID)
+		}
+	}
+
+	err = s.scimRemoveGroupMembers(ctx, org, group, remove)
+	if err != nil {
+		return err
+	}
+
+	return s.scimAddGroupMembers(ctx, org, group, members)
+}
+
+func (s *Server) scimAddGroupMembers(ctx context.Context, org *database.Organization, group *database.Usergroup, members []scimValue) error {
+	for _, m := range members {
+		member, err := s.scimFindMember(ctx, org, m.Value)
+		if err != nil {
+			var serr *scimError
+			if errors.As(err, &serr) && serr.status == http.StatusNotFound {
+				return newSCIMError(http.StatusBadRequest, "invalidValue", "user %q is not a member of the org", m.Value)
+			}
+			return err
+		}
+
+		err = s.admin.DB.InsertUsergroupMember(ctx, group.ID, member.ID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotUnique) {
+				continue
+			}
+			return err
+		}
+
+		s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:  org.ID,
+			Action: "usergroup.member.add",
+			Target: group.Name,
+			After:  map[string]any{"email": member.Email},
+		})
+	}
+	return nil
+}
+
+func (s *Server) scimRemoveGroupMembers(ctx context.Context, org *database.Organization, group *database.Usergroup, userIDs []string) error {
+	for _, id := range userIDs {
+		// Users who aren't members of the org can't be members of its groups
+		member, err := s.scimFindMember(ctx, org, id)
+		if err != nil {
+			var serr *scimError
+			if errors.As(err, &serr) && serr.status == http.StatusNotFound {
+				continue
+			}
+			return err
+		}
+
+		err = s.admin.DB.DeleteUsergroupMember(ctx, group.ID, member.ID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		s.recordAuditEvent(ctx, &admin.AuditEvent{
+			OrgID:  org.ID,
+			Action: "usergroup.member.remove",
+			Target: group.Name,
+			Before: map[string]any{"email": member.Email},
+		})
+	}
+	return nil
+}
+
+func (s *Server) scimGroupResource(ctx context.Context, org *database.Organization, group *database.Usergroup, withMembers bool) (*scimGroup, error) {
+	res := &scimGroup{
+		Schemas:     []string{scimSchemaGroup},
+		ID:          group.ID,
+		DisplayName: group.Name,
+		Meta: &scimMeta{
+			ResourceType: "Group",
+			Location:     urlutil.MustJoinURL(s.opts.ExternalURL, "scim", "v2", org.Name, "Groups", group.ID),
+		},
+	}
+
+	if withMembers {
+		users, err := s.admin.DB.FindUsergroupMemberUsers(ctx, group.ID)
+		if err != nil {
+			return nil, err
+		}
+		res.Members = make([]scimValue, len(users))
+		for i, u := range users {
+			res.Members[i] = scimValue{Value: u.ID, Display: u.Email}
+		}
+	}
+
+	return res, nil
+}
+
+var (
+	scimFilterRegexp     = regexp.MustCompile(`^\s*(\S+)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+	scimMemberPathRegexp = regexp.MustCompile(`^(?i:members)\[\s*(?i:value)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*\]$`)
+	scimGroupNameRegexp  = regexp.MustCompile(`[^a-z0-9_]+`)
+)
+
+// parseSCIMFilter parses a SCIM filter of the form `<attr> eq "<value>"` and returns the value.
+// It's the only kind of filter identity providers use for provisioning. An empty filter returns an empty value.
+func parseSCIMFilter(filter, attr string) (string, error) {
+	if strings.TrimSpace(filter) == "" {
+		return "", nil
+	}
+
+	match := scimFilterRegexp.FindStringSubmatch(filter)
+	if match == nil || !strings.EqualFold(match[1], attr) {
+		return "", newSCIMError(http.StatusBadRequest, "invalidFilter", "unsupported filter %q: only '%s eq \"value\"' is supported", filter, attr)
+	}
+
+	var value string
+	if err := json.Unmarshal([]byte(match[2]), &value); err != nil {
+		return "", newSCIMError(http.StatusBadRequest, "invalidFilter", "invalid filter value %s", match[2])
+	}
+	return value, nil
+}
+
+// parseSCIMMemberPath returns the user IDs to remove for a "remove" patch operation on group members.
+// It returns nil if all members should be removed.
+func parseSCIMMemberPath(op scimPatchOperation) ([]string, error) {
+	if match := scimMemberPathRegexp.FindStringSubmatch(op.Path); match != nil {
+		var id string
+		if err := json.Unmarshal([]byte(match[1]), &id); err != nil {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidPath", "invalid path %q", op.Path)
+		}
+		return []string{id}, nil
+	}
+
+	if !strings.EqualFold(op.Path, "members") {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidPath", "unsupported path %q", op.Path)
+	}
+
+	if len(op.Value) == 0 || string(op.Value) == "null" {
+		return nil, nil
+	}
+
+	var members []scimValue
+	if err := json.Unmarshal(op.Value, &members); err != nil {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "invalid members: %s", err.Error())
+	}
+	ids := make([]string, len(members))
+	for i, m := range members {
+		ids[i] = m.Value
+	}
+	return ids, nil
+}
+
+// parseSCIMPagination returns the range of results to return based on the 1-indexed startIndex and count query parameters.
+func parseSCIMPagination(q url.Values, total int) (int, int, error) {
+	start := 0
+	if v := q.Get("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, newSCIMError(http.StatusBadRequest, "invalidValue", "invalid startIndex %q", v)
+		}
+		// Per the spec, values less than 1 are interpreted as 1
+		if i > 1 {
+			start = i - 1
+		}
+	}
+	if start > total {
+		start = total
+	}
+
+	end := total
+	if v := q.Get("count"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, newSCIMError(http.StatusBadRequest, "invalidValue", "invalid count %q", v)
+		}
+		if n < 0 {
+			n = 0
+		}
+		if start+n < end {
+			end = start + n
+		}
+	}
+
+	return start, end, nil
+}
+
+// scimGroupName converts a SCIM group display name to a user group name (e.g. "Data Engineering" becomes "data-engineering").
+func scimGroupName(displayName string) (string, error) {
+	name := scimGroupNameRegexp.ReplaceAllString(strings.ToLower(displayName), "-")
+	name = strings.Trim(name, "-")
+	if len(name) > 40 {
+		name = strings.TrimRight(name[:40], "-")
+	}
+	if len(name) < 3 {
+		return "", newSCIMError(http.StatusBadRequest, "invalidValue", "invalid group name %q: must contain at least 3 letters or digits", displayName)
+	}
+	return name, nil
+}
+
+func decodeSCIMBody(r *http.Request, v any) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidSyntax", "invalid request body: %s", err.Error())
+	}
+	return nil
+}
+
+func writeSCIM(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSCIMFilter(t *testing.T) {
+	v, err := parseSCIMFilter("", "userName")
+	require.NoError(t, err)
+	require.Equal(t, "", v)
+
+	v, err = parseSCIMFilter(`userName eq "jane@example.com"`, "userName")
+	require.NoError(t, err)
+	require.Equal(t, "jane@example.com", v)
+
+	v, err = parseSCIMFilter(`displayname EQ "Data \"Eng\""`, "displayName")
+	require.NoError(t, err)
+	require.Equal(t, `Data "Eng"`, v)
+
+	_, err = parseSCIMFilter(`userName co "jane"`, "userName")
+	require.Error(t, err)
+	_, err = parseSCIMFilter(`emails eq "jane@example.com"`, "userName")
+	require.Error(t, err)
+}
+
+func TestParseSCIMPagination(t *testing.T) {
+	start, end, err := parseSCIMPagination(url.Values{}, 10)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 10}, []int{start, end})
+
+	start, end, err = parseSCIMPagination(url.Values{"startIndex": {"3"}, "count": {"4"}}, 10)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 6}, []int{start, end})
+
+	start, end, err = parseSCIMPagination(url.Values{"startIndex": {"20"}, "count": {"4"}}, 10)
+	require.NoError(t, err)
+	require.Equal(t, []int{10, 10}, []int{start, end})
+
+	_, _, err = parseSCIMPagination(url.Values{"count": {"x"}}, 10)
+	require.Error(t, err)
+}
+
+func TestSCIMPatchValues(t *testing.T) {
+	// Some identity providers send booleans as strings
+	var u scimUser
+	require.NoError(t, json.Unmarshal([]byte(`{"userName":"a@example.com","active":"False"}`), &u))
+	require.NotNil(t, u.Active)
+	require.False(t, bool(*u.Active))
+	require.Error(t, json.Unmarshal([]byte(`{"active":"maybe"}`), &u))
+
+	ids, err := parseSCIMMemberPath(scimPatchOperation{Path: `members[value eq "abc"]`})
+	require.NoError(t, err)
+	require.Equal(t, []string{"abc"}, ids)
+
+	ids, err = parseSCIMMemberPath(scimPatchOperation{Path: "members", Value: json.RawMessage(`[{"value":"a"},{"value":"b"}]`)})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, ids)
+
+	ids, err = parseSCIMMemberPath(scimPatchOperation{Path: "members"})
+	require.NoError(t, err)
+	require.Nil(t, ids)
+
+	_, err = parseSCIMMemberPath(scimPatchOperation{Path: "displayName"})
+	require.Error(t, err)
+}
+
+func TestSCIMGroupName(t *testing.T) {
+	name, err := scimGroupName("Data Engineering")
+	require.NoError(t, err)
+	require.Equal(t, "data-engineering", name)
+
+	name, err = scimGroupName("  Finance & Ops (EMEA) ")
+	require.NoError(t, err)
+	require.Equal(t, "finance-ops-emea", name)
+
+	name, err = scimGroupName("a_very_long_group_name_that_exceeds_the_limit_of_forty")
+	require.NoError(t, err)
+	require.Len(t, name, 40)
+
+	_, err = scimGroupName("!!")
+	require.Error(t, err)
+}
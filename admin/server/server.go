@@ -184,12 +184,18 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 	// Server public JWKS for runtime JWT verification
 	mux.Handle("/.well-known/jwks.json", s.issuer.WellKnownHandler())
 
+	// Serve revoked embed tokens for runtime JWT verification
+	mux.Handle("/.well-known/revoked-tokens.json", runtimeauth.RevokedTokensHandler(s.revokedEmbedTokens))
+
 	// Add auth endpoints (not gRPC handlers, just regular endpoints on /auth/*)
 	s.authenticator.RegisterEndpoints(mux, s.limiter)
 
 	// Add Github-related endpoints (not gRPC handlers, just regular endpoints on /github/*)
 	s.registerGithubEndpoints(mux)
 
+	// Add SCIM provisioning endpoints (not gRPC handlers, just regular endpoints on /scim/v2/*)
+	s.registerSCIMEndpoints(mux)
+
 	// Add temporary internal endpoint for refreshing sources
 	mux.Handle("/internal/projects/trigger-refresh", otelhttp.WithRouteTag("/internal/projects/trigger-refresh", http.HandlerFunc(s.triggerRefreshSourcesInternal)))
 
@@ -269,14 +275,18 @@ func (s *Server) jwtAttributesForUser(ctx context.Context, userID, orgID string,
 		groupNames[i] = group.Name
 	}
 
-	attr := map[string]any{
-		"name":   user.DisplayName,
-		"email":  user.Email,
-		"domain": user.Email[strings.LastIndex(user.Email, "@")+1:],
-		"groups": groupNames,
-		"admin":  projectPermissions.ManageProject,
+	// Start with the user's custom attributes in the org. The built-in attributes below take precedence over them.
+	attr, err := s.userAttributes(ctx, orgID, user.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	attr["name"] = user.DisplayName
+	attr["email"] = user.Email
+	attr["domain"] = user.Email[strings.LastIndex(user.Email, "@")+1:]
+	attr["groups"] = groupNames
+	attr["admin"] = projectPermissions.ManageProject
+
 	return attr, nil
 }
 
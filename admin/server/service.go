@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/auth"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -39,6 +40,12 @@ func (s *Server) CreateService(ctx context.Context, req *adminv1.CreateServiceRe
 		return nil, err
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "service.create",
+		Target: service.Name,
+	})
+
 	return &adminv1.CreateServiceResponse{
 		Service: serviceToPB(service, req.OrganizationName),
 	}, nil
@@ -105,6 +112,14 @@ func (s *Server) UpdateService(ctx context.Context, req *adminv1.UpdateServiceRe
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "service.update",
+		Target: updatedService.Name,
+		Before: map[string]any{"name": service.Name},
+		After:  map[string]any{"name": updatedService.Name},
+	})
+
 	return &adminv1.UpdateServiceResponse{
 		Service: serviceToPB(updatedService, req.OrganizationName),
 	}, nil
@@ -137,6 +152,12 @@ func (s *Server) DeleteService(ctx context.Context, req *adminv1.DeleteServiceRe
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "service.delete",
+		Target: service.Name,
+	})
+
 	return &adminv1.DeleteServiceResponse{}, nil
 }
 
@@ -208,6 +229,13 @@ func (s *Server) IssueServiceAuthToken(ctx context.Context, req *adminv1.IssueSe
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "service.token.issue",
+		Target: service.Name,
+		After:  map[string]any{"token_id": token.Token().ID.String()},
+	})
+
 	return &adminv1.IssueServiceAuthTokenResponse{
 		Token: token.Token().String(),
 	}, nil
@@ -244,6 +272,13 @@ func (s *Server) RevokeServiceAuthToken(ctx context.Context, req *adminv1.Revoke
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "service.token.revoke",
+		Target: service.Name,
+		Before: map[string]any{"token_id": token.ID},
+	})
+
 	return &adminv1.RevokeServiceAuthTokenResponse{}, nil
 }
 
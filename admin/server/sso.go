@@ -0,0 +1,400 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/publicemail"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// ssoDomainVerificationRecordPrefix is prepended to an SSO domain to get the name of its verification DNS TXT record.
+	ssoDomainVerificationRecordPrefix = "_rill-challenge."
+	// ssoDomainVerificationValuePrefix is prepended to an SSO domain's verification token to get the value of its verification DNS TXT record.
+	ssoDomainVerificationValuePrefix = "rill-domain-verification="
+	// ssoDomainVerificationTimeout is the timeout for looking up the verification DNS TXT record of an SSO domain.
+	ssoDomainVerificationTimeout = 10 * time.Second
+)
+
+func (s *Server) GetOrganizationSSO(ctx context.Context, req *adminv1.GetOrganizationSSORequest) (*adminv1.GetOrganizationSSOResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !(claims.OrganizationPermissions(ctx, org.ID).ManageOrg || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can view the SSO provider")
+	}
+
+	provider, err := s.admin.DB.FindOrganizationSSOProvider(ctx, org.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "the org does not have an SSO provider")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dto, err := s.organizationSSOToPB(ctx, provider)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.GetOrganizationSSOResponse{Sso: dto}, nil
+}
+
+func (s *Server) SetOrganizationSSO(ctx context.Context, req *adminv1.SetOrganizationSSORequest) (*adminv1.SetOrganizationSSOResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.issuer", req.Issuer),
+		attribute.String("args.default_role", req.DefaultRole),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !(claims.OrganizationPermissions(ctx, org.ID).ManageOrg || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can set the SSO provider")
+	}
+
+	role, err := s.admin.DB.FindOrganizationRole(ctx, req.DefaultRole)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "role not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Check that the issuer is a reachable OIDC provider.
+	// Discovery errors are not returned as-is since they may contain responses from the issuer (which the caller controls).
+	_, err = auth.NewSSOProvider(ctx, req.Issuer)
+	if err != nil {
+		if errors.Is(err, auth.ErrSSODiscovery) {
+			return nil, status.Error(codes.InvalidArgument, auth.ErrSSODiscovery.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	prev, err := s.admin.DB.FindOrganizationSSOProvider(ctx, org.ID)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	provider, err := s.admin.DB.UpsertOrganizationSSOProvider(ctx, &database.UpsertOrganizationSSOProviderOptions{
+		OrgID:            org.ID,
+		Issuer:           req.Issuer,
+		ClientID:         req.ClientId,
+		ClientSecret:     req.ClientSecret,
+		DefaultOrgRoleID: role.ID,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	e := &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.sso.set",
+		Target: provider.Issuer,
+		After:  ssoAuditState(provider, role.Name),
+	}
+	if prev != nil {
+		prevRole, err := s.admin.DB.FindOrganizationRoleByID(ctx, prev.DefaultOrgRoleID)
+		if err == nil {
+			e.Before = ssoAuditState(prev, prevRole.Name)
+		}
+	}
+	s.recordAuditEvent(ctx, e)
+
+	dto, err := s.organizationSSOToPB(ctx, provider)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.SetOrganizationSSOResponse{Sso: dto}, nil
+}
+
+func (s *Server) RemoveOrganizationSSO(ctx context.Context, req *adminv1.RemoveOrganizationSSORequest) (*adminv1.RemoveOrganizationSSOResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !(claims.OrganizationPermissions(ctx, org.ID).ManageOrg || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can remove the SSO provider")
+	}
+
+	err = s.admin.DB.DeleteOrganizationSSOProvider(ctx, org.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "the org does not have an SSO provider")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.sso.remove",
+	})
+
+	return &adminv1.RemoveOrganizationSSOResponse{}, nil
+}
+
+func (s *Server) organizationSSOToPB(ctx context.Context, p *database.OrganizationSSOProvider) (*adminv1.OrganizationSSO, error) {
+	role, err := s.admin.DB.FindOrganizationRoleByID(ctx, p.DefaultOrgRoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := s.admin.DB.FindOrganizationSSODomains(ctx, p.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	domainPBs := make([]*adminv1.OrganizationSSODomain, len(domains))
+	for i, d := range domains {
+		domainPBs[i] = ssoDomainToPB(d)
+	}
+
+	return &adminv1.OrganizationSSO{
+		Issuer:      p.Issuer,
+		ClientId:    p.ClientID,
+		DefaultRole: role.Name,
+		RedirectUrl: urlutil.MustJoinURL(s.opts.ExternalURL, "/auth/callback"),
+		Domains:     domainPBs,
+		CreatedOn:   timestamppb.New(p.CreatedOn),
+		UpdatedOn:   timestamppb.New(p.UpdatedOn),
+	}, nil
+}
+
+func (s *Server) AddOrganizationSSODomain(ctx context.Context, req *adminv1.AddOrganizationSSODomainRequest) (*adminv1.AddOrganizationSSODomainResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.domain", req.Domain),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !(claims.OrganizationPermissions(ctx, org.ID).ManageOrg || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can add SSO domains")
+	}
+
+	domain := strings.ToLower(req.Domain)
+	if publicemail.IsPublic(domain) {
+		return nil, status.Errorf(codes.InvalidArgument, "public domain %q cannot be used for SSO", domain)
+	}
+
+	token := make([]byte, 16)
+	_, err = rand.Read(token)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	d, err := s.admin.DB.InsertOrganizationSSODomain(ctx, &database.InsertOrganizationSSODomainOptions{
+		OrgID:             org.ID,
+		Domain:            domain,
+		VerificationToken: hex.EncodeToString(token),
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return nil, status.Error(codes.AlreadyExists, "the domain is already added to an org")
+		}
+		s.logger.Error("failed to add SSO domain", zap.String("org_id", org.ID), zap.Error(err), observability.ZapCtx(ctx))
+		return nil, status.Error(codes.Internal, "failed to add SSO domain")
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.sso.domain.add",
+		Target: d.Domain,
+	})
+
+	return &adminv1.AddOrganizationSSODomainResponse{Domain: ssoDomainToPB(d)}, nil
+}
+
+func (s *Server) VerifyOrganizationSSODomain(ctx context.Context, req *adminv1.VerifyOrganizationSSODomainRequest) (*adminv1.VerifyOrganizationSSODomainResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.domain", req.Domain),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !(claims.OrganizationPermissions(ctx, org.ID).ManageOrg || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can verify SSO domains")
+	}
+
+	d, err := s.admin.DB.FindOrganizationSSODomain(ctx, org.ID, req.Domain)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "SSO domain not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if d.VerifiedOn != nil {
+		return &adminv1.VerifyOrganizationSSODomainResponse{Domain: ssoDomainToPB(d)}, nil
+	}
+
+	// Superusers can approve a domain without the DNS record
+	if !claims.Superuser(ctx) {
+		err = checkSSODomainVerificationRecord(ctx, d)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	d, err = s.admin.DB.UpdateOrganizationSSODomainVerified(ctx, d.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.sso.domain.verify",
+		Target: d.Domain,
+	})
+
+	return &adminv1.VerifyOrganizationSSODomainResponse{Domain: ssoDomainToPB(d)}, nil
+}
+
+func (s *Server) RemoveOrganizationSSODomain(ctx context.Context, req *adminv1.RemoveOrganizationSSODomainRequest) (*adminv1.RemoveOrganizationSSODomainResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.domain", req.Domain),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !(claims.OrganizationPermissions(ctx, org.ID).ManageOrg || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can remove SSO domains")
+	}
+
+	d, err := s.admin.DB.FindOrganizationSSODomain(ctx, org.ID, req.Domain)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "SSO domain not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.admin.DB.DeleteOrganizationSSODomain(ctx, d.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.sso.domain.remove",
+		Target: d.Domain,
+	})
+
+	return &adminv1.RemoveOrganizationSSODomainResponse{}, nil
+}
+
+// checkSSODomainVerificationRecord checks that the DNS TXT record that proves ownership of an SSO domain exists.
+func checkSSODomainVerificationRecord(ctx context.Context, d *database.OrganizationSSODomain) error {
+	ctx, cancel := context.WithTimeout(ctx, ssoDomainVerificationTimeout)
+	defer cancel()
+
+	name := ssoDomainVerificationRecordPrefix + d.Domain
+	records, err := net.DefaultResolver.LookupTXT(ctx, name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			return fmt.Errorf("failed to look up the TXT record %q: %w", name, err)
+		}
+	}
+
+	value := ssoDomainVerificationValuePrefix + d.VerificationToken
+	for _, r := range records {
+		if strings.TrimSpace(r) == value {
+			return nil
+		}
+	}
+	return fmt.Errorf("the TXT record %q with value %q was not found", name, value)
+}
+
+func ssoDomainToPB(d *database.OrganizationSSODomain) *adminv1.OrganizationSSODomain {
+	res := &adminv1.OrganizationSSODomain{
+		Domain:                  d.Domain,
+		Verified:                d.VerifiedOn != nil,
+		VerificationRecordName:  ssoDomainVerificationRecordPrefix + d.Domain,
+		VerificationRecordValue: ssoDomainVerificationValuePrefix + d.VerificationToken,
+		CreatedOn:               timestamppb.New(d.CreatedOn),
+	}
+	if d.VerifiedOn != nil {
+		res.VerifiedOn = timestamppb.New(*d.VerifiedOn)
+	}
+	return res
+}
+
+// ssoAuditState returns the fields of an SSO provider to record in the audit log. The client secret is redacted by the audit log.
+func ssoAuditState(p *database.OrganizationSSOProvider, roleName string) map[string]any {
+	return map[string]any{
+		"issuer":        p.Issuer,
+		"client_id":     p.ClientID,
+		"client_secret": p.ClientSecret,
+		"default_role":  roleName,
+	}
+}
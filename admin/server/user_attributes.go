@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func (s *Server) GetUserAttributes(ctx context.Context, req *adminv1.GetUserAttributesRequest) (*adminv1.GetUserAttributesResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ReadOrgMembers {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to read org members")
+	}
+
+	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.checkUserIsOrgMember(ctx, org.ID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := s.userAttributes(ctx, org.ID, user.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pb, err := structpb.NewStruct(attrs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.GetUserAttributesResponse{Attributes: pb}, nil
+}
+
+func (s *Server) SetUserAttributes(ctx context.Context, req *adminv1.SetUserAttributesRequest) (*adminv1.SetUserAttributesResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrgMembers {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to set user attributes")
+	}
+
+	attrs := req.Attributes.AsMap()
+	err = admin.ValidateUserAttributes(attrs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.checkUserIsOrgMember(ctx, org.ID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	prev, err := s.userAttributes(ctx, org.ID, user.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res, err := s.admin.DB.UpsertOrganizationUserAttributes(ctx, org.ID, user.ID, attrs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordAuditEvent(ctx, &admin.AuditEvent{
+		OrgID:  org.ID,
+		Action: "org.member.attributes.update",
+		Target: user.Email,
+		Before: prev,
+		After:  map[string]any(res.Attributes),
+	})
+
+	pb, err := structpb.NewStruct(res.Attributes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.SetUserAttributesResponse{Attributes: pb}, nil
+}
+
+// checkUserIsOrgMember returns a NotFound error if the user is not a member of the org.
+// It prevents org admins from reading or setting attributes of users outside their org.
+func (s *Server) checkUserIsOrgMember(ctx context.Context, orgID, userID string) error {
+	isMember, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, userID, orgID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !isMember {
+		return status.Error(codes.NotFound, "user is not a member of the org")
+	}
+	return nil
+}
+
+// userAttributes returns the custom attributes of a user in an org. It returns an empty map if none have been set.
+func (s *Server) userAttributes(ctx context.Context, orgID, userID string) (map[string]any, error) {
+	res, err := s.admin.DB.FindOrganizationUserAttributes(ctx, orgID, userID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return map[string]any{}, nil
+		}
+		return nil, err
+	}
+	if res.Attributes == nil {
+		return map[string]any{}, nil
+	}
+	return res.Attributes, nil
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net/mail"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/rilldata/rill/admin/database"
@@ -214,3 +216,100 @@ func (s *Service) prepareOrganization(ctx context.Context, orgID, userID string)
 	}
 	return org, nil
 }
+
+// ErrUserDeactivated is returned when a user who was deactivated in an org by its identity provider logs in through the org's SSO provider.
+var ErrUserDeactivated = errors.New("the user has been deactivated in the org")
+
+// AddOrganizationMemberForSSO adds a user who logged in through an org's SSO provider to the org with the provider's default role.
+// It returns false if the user was already a member of the org, and ErrUserDeactivated if the user was deactivated in the org by its identity provider.
+func (s *Service) AddOrganizationMemberForSSO(ctx context.Context, provider *database.OrganizationSSOProvider, user *database.User) (bool, error) {
+	isMember, err := s.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, provider.OrgID)
+	if err != nil {
+		return false, err
+	}
+	if isMember {
+		return false, nil
+	}
+
+	// Deactivated users must be reactivated by the identity provider
+	deactivated, err := s.DB.CheckUserIsDeactivatedInOrganization(ctx, user.ID, provider.OrgID)
+	if err != nil {
+		return false, err
+	}
+	if deactivated {
+		return false, ErrUserDeactivated
+	}
+
+	org, err := s.DB.FindOrganization(ctx, provider.OrgID)
+	if err != nil {
+		return false, err
+	}
+
+	role, err := s.DB.FindOrganizationRoleByID(ctx, provider.DefaultOrgRoleID)
+	if err != nil {
+		return false, err
+	}
+
+	txCtx, tx, err := s.DB.NewTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.DB.InsertOrganizationMemberUser(txCtx, org.ID, user.ID, role.ID)
+	if err != nil {
+		return false, err
+	}
+	err = s.DB.InsertUsergroupMember(txCtx, *org.AllUsergroupID, user.ID)
+	if err != nil && !errors.Is(err, database.ErrNotUnique) {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	err = s.RecordAuditEvent(ctx, &AuditEvent{
+		OrgID:     org.ID,
+		ActorType: "user",
+		ActorID:   user.ID,
+		ActorName: user.Email,
+		Action:    "org.member.add",
+		Target:    user.Email,
+		After:     map[string]any{"role": role.Name, "sso": true},
+	})
+	if err != nil {
+		s.Logger.Error("failed to record audit event", zap.String("action", "org.member.add"), zap.String("org_id", org.ID), zap.Error(err))
+	}
+
+	s.Logger.Info("added user to org through SSO", zap.String("user_id", user.ID), zap.String("org", org.Name), zap.String("role", role.Name))
+
+	return true, nil
+}
+
+// ReservedUserAttributes are the user attributes set by the admin service in the JWTs used to access deployments.
+// They can't be overridden by custom user attributes.
+var ReservedUserAttributes = []string{"name", "email", "domain", "groups", "admin"}
+
+// maxUserAttributes is the maximum number of custom attributes a user can have in an org.
+const maxUserAttributes = 50
+
+var userAttributeKeyRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]{0,63}$`)
+
+// ValidateUserAttributes validates custom user attributes.
+// The keys must be identifiers so they can be referenced in templates like `{{ .user.region }}`.
+func ValidateUserAttributes(attrs map[string]any) error {
+	if len(attrs) > maxUserAttributes {
+		return fmt.Errorf("a user can have at most %d attributes", maxUserAttributes)
+	}
+	for k := range attrs {
+		if !userAttributeKeyRegexp.MatchString(k) {
+			return fmt.Errorf("invalid attribute name %q: must start with a letter or underscore and contain only letters, digits and underscores", k)
+		}
+		if slices.Contains(ReservedUserAttributes, strings.ToLower(k)) {
+			return fmt.Errorf("attribute name %q is reserved", k)
+		}
+	}
+	return nil
+}
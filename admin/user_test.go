@@ -0,0 +1,23 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUserAttributes(t *testing.T) {
+	require.NoError(t, ValidateUserAttributes(nil))
+	require.NoError(t, ValidateUserAttributes(map[string]any{"region": "emea", "tenant_id": 42, "_x": true}))
+
+	require.Error(t, ValidateUserAttributes(map[string]any{"tenant-id": 42}))
+	require.Error(t, ValidateUserAttributes(map[string]any{"1st": "a"}))
+	require.Error(t, ValidateUserAttributes(map[string]any{"email": "a@example.com"}))
+	require.Error(t, ValidateUserAttributes(map[string]any{"Groups": []any{"a"}}))
+
+	many := make(map[string]any)
+	for i := 0; i <= maxUserAttributes; i++ {
+		many[string(rune('a'+i%26))+string(rune('a'+i/26))] = i
+	}
+	require.Error(t, ValidateUserAttributes(many))
+}
@@ -0,0 +1,26 @@
+package worker
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// reencryptProjectVariables re-encrypts project variables that are not encrypted with the current key of the database encryption keyring.
+// It should be run after adding a new key to the keyring. The old key can be removed from the keyring after it completes.
+func (w *Worker) reencryptProjectVariables(ctx context.Context) error {
+	total := 0
+	for {
+		n, err := w.admin.DB.ReencryptProjectVariables(ctx, 100)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			break
+		}
+		total += n
+	}
+
+	w.logger.Info("re-encrypted project variables", zap.Int("projects", total))
+	return nil
+}
@@ -0,0 +1,26 @@
+package worker
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// reencryptSSOClientSecrets re-encrypts the client secrets of org SSO providers that are not encrypted with the current key of the database encryption keyring.
+// Like reencryptProjectVariables, it should be run after adding a new key to the keyring.
+func (w *Worker) reencryptSSOClientSecrets(ctx context.Context) error {
+	total := 0
+	for {
+		n, err := w.admin.DB.ReencryptOrganizationSSOProviders(ctx, 100)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			break
+		}
+		total += n
+	}
+
+	w.logger.Info("re-encrypted SSO client secrets", zap.Int("providers", total))
+	return nil
+}
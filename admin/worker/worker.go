@@ -62,6 +62,10 @@ func (w *Worker) RunJob(ctx context.Context, name string) error {
 		return w.runJob(ctx, name, w.checkSlots)
 	case "reset_all_deployments":
 		return w.runJob(ctx, name, w.resetAllDeployments)
+	case "reencrypt_project_variables":
+		return w.runJob(ctx, name, w.reencryptProjectVariables)
+	case "reencrypt_sso_client_secrets":
+		return w.runJob(ctx, name, w.reencryptSSOClientSecrets)
 	// NOTE: Add new ad-hoc jobs here
 	default:
 		return fmt.Errorf("unknown job: %s", name)
@@ -0,0 +1,115 @@
+package fmt
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/spf13/cobra"
+)
+
+// FmtCmd represents the fmt command
+func FmtCmd(ch *cmdutil.Helper) *cobra.Command {
+	var check bool
+
+	fmtCmd := &cobra.Command{
+		Use:   "fmt [<path>]",
+		Short: "Format SQL files",
+		Long: `Normalize the formatting of the SQL files of DuckDB models in a project.
+
+Other SQL files, such as init.sql, macros and models for other connectors, are not changed.
+
+Leading comments (including annotations) are kept. Files that use templating or contain other comments are skipped.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			projectPath := "."
+			if len(args) > 0 {
+				var err error
+				projectPath, err = fileutil.ExpandHome(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			if !rillv1beta.HasRillProject(projectPath) {
+				fullpath, err := filepath.Abs(projectPath)
+				if err != nil {
+					return err
+				}
+				return fmt.Errorf("directory at %q doesn't contain a valid Rill project", fullpath)
+			}
+
+			repo, instanceID, err := cmdutil.RepoForProjectPath(projectPath)
+			if err != nil {
+				return err
+			}
+
+			parser, err := rillv1.Parse(ctx, repo, instanceID, "", "duckdb", []string{"duckdb"})
+			if err != nil {
+				return err
+			}
+
+			paths, err := repo.ListRecursive(ctx, "**/*.sql")
+			if err != nil {
+				return err
+			}
+
+			var changed, failed int
+			for _, path := range paths {
+				if !parser.PathIsFormattable(path) {
+					continue
+				}
+
+				blob, err := repo.Get(ctx, path)
+				if err != nil {
+					return err
+				}
+
+				formatted, err := rillv1.FormatSQL(blob)
+				if err != nil {
+					if errors.Is(err, rillv1.ErrFormatUnsupported) {
+						ch.Printer.PrintlnWarn(fmt.Sprintf("Skipped %s (uses templating or comments)", path))
+						continue
+					}
+					failed++
+					ch.Printer.PrintlnError(fmt.Sprintf("Failed to format %s: %s", path, err.Error()))
+					continue
+				}
+				if formatted == blob {
+					continue
+				}
+
+				changed++
+				if check {
+					ch.Printer.Println(path)
+					continue
+				}
+
+				err = repo.Put(ctx, path, strings.NewReader(formatted))
+				if err != nil {
+					return err
+				}
+				ch.Printer.PrintlnSuccess(fmt.Sprintf("Formatted %s", path))
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("failed to format %d file(s)", failed)
+			}
+			if check && changed > 0 {
+				return fmt.Errorf("%d file(s) are not formatted", changed)
+			}
+			return nil
+		},
+	}
+
+	fmtCmd.Flags().BoolVar(&check, "check", false, "List files that are not formatted and exit with an error instead of writing them")
+
+	return fmtCmd
+}
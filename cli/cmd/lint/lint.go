@@ -0,0 +1,102 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/spf13/cobra"
+)
+
+// LintCmd represents the lint command
+func LintCmd(ch *cmdutil.Helper) *cobra.Command {
+	var environment string
+
+	lintCmd := &cobra.Command{
+		Use:   "lint [<path>]",
+		Short: "Check a project for common mistakes",
+		Long: `Check a project for parse errors and common mistakes.
+
+The following rules are checked in addition to parse errors:
+  select-star                A model that feeds a metrics view uses SELECT *
+  unreferenced-model         A model is not referenced by any other resource
+  measure-without-aggregate  A measure expression doesn't call an aggregate function
+  non-deterministic-model    A materialized model calls a function like now() or random()`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			projectPath := "."
+			if len(args) > 0 {
+				var err error
+				projectPath, err = fileutil.ExpandHome(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			if !rillv1beta.HasRillProject(projectPath) {
+				fullpath, err := filepath.Abs(projectPath)
+				if err != nil {
+					return err
+				}
+				return fmt.Errorf("directory at %q doesn't contain a valid Rill project", fullpath)
+			}
+
+			errs, warns, err := lintProject(ctx, projectPath, environment)
+			if err != nil {
+				return err
+			}
+
+			for _, e := range errs {
+				ch.Printer.PrintlnError(formatDiagnostic(e))
+			}
+			for _, w := range warns {
+				ch.Printer.PrintlnWarn(formatDiagnostic(w))
+			}
+
+			if len(errs)+len(warns) > 0 {
+				return fmt.Errorf("found %d error(s) and %d warning(s)", len(errs), len(warns))
+			}
+			ch.Printer.PrintlnSuccess("No issues found")
+			return nil
+		},
+	}
+
+	lintCmd.Flags().StringVar(&environment, "environment", "prod", `Environment name to parse the project for (e.g. "dev" or "prod")`)
+
+	return lintCmd
+}
+
+// lintProject parses the project at projectPath and returns its parse errors and lint diagnostics.
+func lintProject(ctx context.Context, projectPath, environment string) ([]*runtimev1.ParseError, []*runtimev1.ParseError, error) {
+	repo, instanceID, err := cmdutil.RepoForProjectPath(projectPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p, err := rillv1.Parse(ctx, repo, instanceID, environment, "duckdb", []string{"duckdb"})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	diags, err := p.Lint(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p.Errors, diags, nil
+}
+
+// formatDiagnostic formats a diagnostic as "path:line: message".
+func formatDiagnostic(e *runtimev1.ParseError) string {
+	if e.StartLocation != nil {
+		return fmt.Sprintf("%s:%d: %s", e.FilePath, e.StartLocation.Line, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.FilePath, e.Message)
+}
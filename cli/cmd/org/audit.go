@@ -0,0 +1,117 @@
+package org
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AuditCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectName, actor, action, since, pageToken string
+	var pageSize uint32
+	cfg := ch.Config
+
+	auditCmd := &cobra.Command{
+		Use:               "audit",
+		Args:              cobra.NoArgs,
+		Short:             "Show the audit log of an organization",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(cfg), cmdutil.CheckOrganization(cfg)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			req := &adminv1.ListAuditEventsRequest{
+				Organization: cfg.Org,
+				Project:      projectName,
+				Actor:        actor,
+				Action:       action,
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			}
+			if since != "" {
+				t, err := parseSince(since)
+				if err != nil {
+					return err
+				}
+				req.Since = timestamppb.New(t)
+			}
+
+			res, err := client.ListAuditEvents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if len(res.Events) == 0 {
+				ch.Printer.PrintlnWarn("No audit events found")
+				return nil
+			}
+
+			err = ch.Printer.PrintResource(toAuditTable(res.Events))
+			if err != nil {
+				return err
+			}
+
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+
+			return nil
+		},
+	}
+
+	auditCmd.Flags().StringVar(&cfg.Org, "org", cfg.Org, "Organization")
+	auditCmd.Flags().StringVar(&projectName, "project", "", "Only show events for this project")
+	auditCmd.Flags().StringVar(&actor, "actor", "", "Only show events by this user email or service name")
+	auditCmd.Flags().StringVar(&action, "action", "", "Only show events with this action or action prefix (e.g. \"project.member\")")
+	auditCmd.Flags().StringVar(&since, "since", "", "Only show events after this time (RFC3339 timestamp or duration like \"24h\")")
+	auditCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of events to return per page")
+	auditCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return auditCmd
+}
+
+// parseSince parses either a RFC3339 timestamp or a duration relative to now.
+func parseSince(s string) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --since %q: must be a RFC3339 timestamp or a duration", s)
+	}
+	return t, nil
+}
+
+func toAuditTable(events []*adminv1.AuditEvent) []*auditEvent {
+	rows := make([]*auditEvent, len(events))
+	for i, e := range events {
+		actor := e.ActorName
+		if actor == "" {
+			actor = e.ActorType
+		}
+		rows[i] = &auditEvent{
+			Time:    e.CreatedOn.AsTime().Format(cmdutil.TSFormatLayout),
+			Actor:   actor,
+			Action:  e.Action,
+			Project: e.ProjectName,
+			Target:  e.Target,
+		}
+	}
+	return rows
+}
+
+type auditEvent struct {
+	Time    string `header:"time,timestamp(ms|utc|human)" json:"time"`
+	Actor   string `header:"actor" json:"actor"`
+	Action  string `header:"action" json:"action"`
+	Project string `header:"project" json:"project"`
+	Target  string `header:"target" json:"target"`
+}
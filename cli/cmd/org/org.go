@@ -3,6 +3,8 @@ package org
 import (
 	"strings"
 
+	"github.com/rilldata/rill/cli/cmd/org/role"
+	"github.com/rilldata/rill/cli/cmd/org/sso"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	"github.com/spf13/cobra"
@@ -21,6 +23,9 @@ func OrgCmd(ch *cmdutil.Helper) *cobra.Command {
 	orgCmd.AddCommand(ListCmd(ch))
 	orgCmd.AddCommand(DeleteCmd(ch))
 	orgCmd.AddCommand(RenameCmd(ch))
+	orgCmd.AddCommand(AuditCmd(ch))
+	orgCmd.AddCommand(role.RoleCmd(ch))
+	orgCmd.AddCommand(sso.SSOCmd(ch))
 
 	return orgCmd
 }
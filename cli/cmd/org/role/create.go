@@ -0,0 +1,53 @@
+package role
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CreateCmd(ch *cmdutil.Helper) *cobra.Command {
+	var permissions []string
+
+	createCmd := &cobra.Command{
+		Use:   "create <role-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create a custom project role",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			if len(permissions) == 0 {
+				return fmt.Errorf("at least one permission must be specified with --permissions")
+			}
+
+			perms, err := parsePermissions(permissions)
+			if err != nil {
+				return err
+			}
+
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.CreateProjectRole(cmd.Context(), &adminv1.CreateProjectRoleRequest{
+				Organization: cfg.Org,
+				Name:         args[0],
+				Permissions:  perms,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.Printer.PrintlnSuccess(fmt.Sprintf("Created role %q in organization %q with permissions: %s", res.Role.Name, cfg.Org, formatPermissions(res.Role.Permissions)))
+			return nil
+		},
+	}
+
+	createCmd.Flags().StringSliceVar(&permissions, "permissions", nil, fmt.Sprintf("Permissions granted by the role [%s]", strings.Join(permissionNames(), ", ")))
+
+	return createCmd
+}
@@ -0,0 +1,39 @@
+package role
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DeleteCmd(ch *cmdutil.Helper) *cobra.Command {
+	deleteCmd := &cobra.Command{
+		Use:   "delete <role-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delete a custom project role",
+		Long:  "Delete a custom project role. Members and user groups assigned the role lose the access it granted.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.DeleteProjectRole(cmd.Context(), &adminv1.DeleteProjectRoleRequest{
+				Organization: cfg.Org,
+				Name:         args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.Printer.PrintlnSuccess(fmt.Sprintf("Deleted role %q from organization %q", args[0], cfg.Org))
+			return nil
+		},
+	}
+
+	return deleteCmd
+}
@@ -0,0 +1,82 @@
+package role
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func RoleCmd(ch *cmdutil.Helper) *cobra.Command {
+	cfg := ch.Config
+	roleCmd := &cobra.Command{
+		Use:               "role",
+		Short:             "Manage project roles",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(cfg), cmdutil.CheckOrganization(cfg)),
+	}
+
+	roleCmd.PersistentFlags().StringVar(&cfg.Org, "org", cfg.Org, "Organization Name")
+	roleCmd.AddCommand(ListCmd(ch))
+	roleCmd.AddCommand(CreateCmd(ch))
+	roleCmd.AddCommand(DeleteCmd(ch))
+
+	return roleCmd
+}
+
+// permissionNames returns the names of all project permissions, e.g. "manage_reports".
+func permissionNames() []string {
+	fields := (&adminv1.ProjectPermissions{}).ProtoReflect().Descriptor().Fields()
+	names := make([]string, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		names[i] = string(fields.Get(i).Name())
+	}
+	return names
+}
+
+// parsePermissions builds project permissions from a list of permission names.
+func parsePermissions(names []string) (*adminv1.ProjectPermissions, error) {
+	perms := &adminv1.ProjectPermissions{}
+	msg := perms.ProtoReflect()
+	for _, name := range names {
+		field := msg.Descriptor().Fields().ByName(protoreflect.Name(strings.TrimSpace(name)))
+		if field == nil {
+			return nil, fmt.Errorf("unknown permission %q (valid permissions: %s)", name, strings.Join(permissionNames(), ", "))
+		}
+		msg.Set(field, protoreflect.ValueOfBool(true))
+	}
+	return perms, nil
+}
+
+// formatPermissions returns the names of the permissions that are granted.
+func formatPermissions(perms *adminv1.ProjectPermissions) string {
+	var names []string
+	msg := perms.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if msg.Get(fields.Get(i)).Bool() {
+			names = append(names, string(fields.Get(i).Name()))
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func toTable(roles []*adminv1.ProjectRole) []*role {
+	res := make([]*role, 0, len(roles))
+	for _, r := range roles {
+		res = append(res, &role{
+			Name:        r.Name,
+			Builtin:     r.Builtin,
+			Permissions: formatPermissions(r.Permissions),
+		})
+	}
+	return res
+}
+
+type role struct {
+	Name        string `header:"name" json:"name"`
+	Builtin     bool   `header:"builtin" json:"builtin"`
+	Permissions string `header:"permissions" json:"permissions"`
+}
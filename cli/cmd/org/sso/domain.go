@@ -0,0 +1,116 @@
+package sso
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DomainCmd(ch *cmdutil.Helper) *cobra.Command {
+	domainCmd := &cobra.Command{
+		Use:   "domain",
+		Short: "Manage the email domains that log in through the organization's SSO provider",
+		Long:  "Manage the email domains that log in through the organization's SSO provider. A domain must be verified with a DNS TXT record before its users log in through the provider.",
+	}
+
+	domainCmd.AddCommand(DomainAddCmd(ch))
+	domainCmd.AddCommand(DomainVerifyCmd(ch))
+	domainCmd.AddCommand(DomainRemoveCmd(ch))
+
+	return domainCmd
+}
+
+func DomainAddCmd(ch *cmdutil.Helper) *cobra.Command {
+	addCmd := &cobra.Command{
+		Use:   "add <domain>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Add an email domain for SSO",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.AddOrganizationSSODomain(cmd.Context(), &adminv1.AddOrganizationSSODomainRequest{
+				Organization: cfg.Org,
+				Domain:       args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.Printer.PrintlnSuccess(fmt.Sprintf("Added domain %q. To verify it, create a DNS TXT record and run `rill org sso domain verify %s`:", res.Domain.Domain, res.Domain.Domain))
+			printSSODomainRecord(ch, res.Domain)
+			return nil
+		},
+	}
+
+	return addCmd
+}
+
+func DomainVerifyCmd(ch *cmdutil.Helper) *cobra.Command {
+	verifyCmd := &cobra.Command{
+		Use:   "verify <domain>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Verify an email domain for SSO by checking its DNS TXT record",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.VerifyOrganizationSSODomain(cmd.Context(), &adminv1.VerifyOrganizationSSODomainRequest{
+				Organization: cfg.Org,
+				Domain:       args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.Printer.PrintlnSuccess(fmt.Sprintf("Verified domain %q. Its users will log in through the organization's SSO provider.", res.Domain.Domain))
+			return nil
+		},
+	}
+
+	return verifyCmd
+}
+
+func DomainRemoveCmd(ch *cmdutil.Helper) *cobra.Command {
+	removeCmd := &cobra.Command{
+		Use:   "remove <domain>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove an email domain for SSO",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.RemoveOrganizationSSODomain(cmd.Context(), &adminv1.RemoveOrganizationSSODomainRequest{
+				Organization: cfg.Org,
+				Domain:       args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.Printer.PrintlnWarn(fmt.Sprintf("Removed domain %q. Its users will log in with Rill's default login again.", args[0]))
+			return nil
+		},
+	}
+
+	return removeCmd
+}
+
+func printSSODomainRecord(ch *cmdutil.Helper, d *adminv1.OrganizationSSODomain) {
+	ch.Printer.PrintlnSuccess(fmt.Sprintf("  Name:  %s", d.VerificationRecordName))
+	ch.Printer.PrintlnSuccess(fmt.Sprintf("  Value: %s", d.VerificationRecordValue))
+}
@@ -0,0 +1,37 @@
+package sso
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func RemoveCmd(ch *cmdutil.Helper) *cobra.Command {
+	removeCmd := &cobra.Command{
+		Use:   "remove",
+		Args:  cobra.NoArgs,
+		Short: "Remove the organization's SSO provider",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.RemoveOrganizationSSO(cmd.Context(), &adminv1.RemoveOrganizationSSORequest{
+				Organization: cfg.Org,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.Printer.PrintlnWarn(fmt.Sprintf("Removed the SSO provider for organization %q. Its users will log in with Rill's default login again.", cfg.Org))
+			return nil
+		},
+	}
+
+	return removeCmd
+}
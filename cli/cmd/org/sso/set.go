@@ -0,0 +1,55 @@
+package sso
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func SetCmd(ch *cmdutil.Helper) *cobra.Command {
+	var issuer, clientID, clientSecret, defaultRole string
+
+	setCmd := &cobra.Command{
+		Use:   "set",
+		Args:  cobra.NoArgs,
+		Short: "Set the organization's SSO provider",
+		Long: "Set a custom OIDC provider for the organization. Users with an email address in one of the organization's whitelisted domains " +
+			"log in through the provider and automatically join the organization with the default role.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			cmdutil.StringPromptIfEmpty(&issuer, "Enter the OIDC issuer URL")
+			cmdutil.StringPromptIfEmpty(&clientID, "Enter the OIDC client ID")
+			cmdutil.StringPromptIfEmpty(&clientSecret, "Enter the OIDC client secret")
+
+			res, err := client.SetOrganizationSSO(cmd.Context(), &adminv1.SetOrganizationSSORequest{
+				Organization: cfg.Org,
+				Issuer:       issuer,
+				ClientId:     clientID,
+				ClientSecret: clientSecret,
+				DefaultRole:  defaultRole,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.Printer.PrintlnSuccess(fmt.Sprintf("Set the SSO provider for organization %q", cfg.Org))
+			printSSO(ch, res.Sso)
+			return nil
+		},
+	}
+
+	setCmd.Flags().StringVar(&issuer, "issuer", "", "OIDC issuer URL (e.g. https://example.okta.com)")
+	setCmd.Flags().StringVar(&clientID, "client-id", "", "OIDC client ID")
+	setCmd.Flags().StringVar(&clientSecret, "client-secret", "", "OIDC client secret")
+	setCmd.Flags().StringVar(&defaultRole, "default-role", "viewer", "Role of users who join the organization through SSO [admin, collaborator, viewer]")
+
+	return setCmd
+}
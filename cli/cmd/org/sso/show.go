@@ -0,0 +1,35 @@
+package sso
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ShowCmd(ch *cmdutil.Helper) *cobra.Command {
+	showCmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.NoArgs,
+		Short: "Show the organization's SSO provider",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.GetOrganizationSSO(cmd.Context(), &adminv1.GetOrganizationSSORequest{
+				Organization: cfg.Org,
+			})
+			if err != nil {
+				return err
+			}
+
+			printSSO(ch, res.Sso)
+			return nil
+		},
+	}
+
+	return showCmd
+}
@@ -0,0 +1,46 @@
+package sso
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func SSOCmd(ch *cmdutil.Helper) *cobra.Command {
+	cfg := ch.Config
+	ssoCmd := &cobra.Command{
+		Use:               "sso",
+		Short:             "Manage single sign-on with a custom OIDC provider",
+		Long:              "Manage single sign-on with a custom OIDC provider. Users with an email address in one of the organization's verified SSO domains must log in through the provider.",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(cfg), cmdutil.CheckOrganization(cfg)),
+	}
+
+	ssoCmd.PersistentFlags().StringVar(&cfg.Org, "org", cfg.Org, "Organization Name")
+	ssoCmd.AddCommand(ShowCmd(ch))
+	ssoCmd.AddCommand(SetCmd(ch))
+	ssoCmd.AddCommand(RemoveCmd(ch))
+	ssoCmd.AddCommand(DomainCmd(ch))
+
+	return ssoCmd
+}
+
+func printSSO(ch *cmdutil.Helper, sso *adminv1.OrganizationSSO) {
+	ch.Printer.PrintlnSuccess(fmt.Sprintf("Issuer: %s", sso.Issuer))
+	ch.Printer.PrintlnSuccess(fmt.Sprintf("Client ID: %s", sso.ClientId))
+	ch.Printer.PrintlnSuccess(fmt.Sprintf("Default role: %s", sso.DefaultRole))
+	ch.Printer.PrintlnSuccess(fmt.Sprintf("Redirect URL: %s", sso.RedirectUrl))
+	if len(sso.Domains) == 0 {
+		ch.Printer.PrintlnWarn("No SSO domains are configured for the organization, so no users will log in through the provider. Use `rill org sso domain add` to add one.")
+		return
+	}
+	for _, d := range sso.Domains {
+		if d.Verified {
+			ch.Printer.PrintlnSuccess(fmt.Sprintf("Domain: %s (verified)", d.Domain))
+			continue
+		}
+		ch.Printer.PrintlnWarn(fmt.Sprintf("Domain: %s (not verified, create this DNS TXT record and run `rill org sso domain verify %s`)", d.Domain, d.Domain))
+		printSSODomainRecord(ch, d)
+	}
+}
@@ -32,6 +32,7 @@ func ProjectCmd(ch *cmdutil.Helper) *cobra.Command {
 	projectCmd.AddCommand(JwtCmd(ch))
 	projectCmd.AddCommand(RenameCmd(ch))
 	projectCmd.AddCommand(LogsCmd(ch))
+	projectCmd.AddCommand(QueriesCmd(ch))
 
 	return projectCmd
 }
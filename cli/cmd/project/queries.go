@@ -0,0 +1,149 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/printer"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	runtimeclient "github.com/rilldata/rill/runtime/client"
+	"github.com/spf13/cobra"
+)
+
+func QueriesCmd(ch *cmdutil.Helper) *cobra.Command {
+	var name, path string
+	var slow, showSQL bool
+	var threshold time.Duration
+	var limit int
+
+	queriesCmd := &cobra.Command{
+		Use:   "queries [<project-name>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show recent queries",
+		Long:  "Show recent queries you executed against the project's deployment, newest first. Query args are redacted.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			if !cmd.Flags().Changed("project") && len(args) == 0 && cfg.Interactive {
+				name, err = inferProjectName(cmd.Context(), client, cfg.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			proj, err := client.GetProject(context.Background(), &adminv1.GetProjectRequest{
+				OrganizationName: cfg.Org,
+				Name:             name,
+			})
+			if err != nil {
+				return err
+			}
+
+			depl := proj.ProdDeployment
+			if depl == nil {
+				return fmt.Errorf("project %q is not currently deployed", name)
+			}
+
+			rt, err := runtimeclient.New(depl.RuntimeHost, proj.Jwt)
+			if err != nil {
+				return fmt.Errorf("failed to connect to runtime: %w", err)
+			}
+
+			req := &runtimev1.ListQueryHistoryRequest{
+				InstanceId: depl.RuntimeInstanceId,
+				Limit:      int32(limit),
+			}
+			if slow {
+				req.MinDurationMs = uint32(threshold.Milliseconds())
+			}
+
+			res, err := rt.ListQueryHistory(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to get query history: %w", err)
+			}
+
+			if len(res.Entries) == 0 {
+				ch.Printer.PrintlnWarn("No queries found")
+				return nil
+			}
+
+			if ch.Printer.Format() == printer.JSON {
+				return ch.Printer.PrintJSON(res.Entries)
+			}
+
+			if showSQL {
+				for _, e := range res.Entries {
+					ch.Printer.PrintBold(fmt.Sprintf("%s %s (%s)\n", printTime(e.StartTime), e.QueryType, formatQueryDuration(e.DurationMs)))
+					for _, stmt := range e.Statements {
+						ch.Printer.Printf("%s\n", stmt.Sql)
+						if len(stmt.Args) > 0 {
+							ch.Printer.Printf("-- args: %s\n", strings.Join(stmt.Args, ", "))
+						}
+					}
+					ch.Printer.Println()
+				}
+				return nil
+			}
+
+			return ch.Printer.PrintResource(toQueryRows(res.Entries))
+		},
+	}
+
+	queriesCmd.Flags().SortFlags = false
+	queriesCmd.Flags().StringVar(&name, "project", "", "Project Name")
+	queriesCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	queriesCmd.Flags().BoolVar(&slow, "slow", false, "Only show slow queries")
+	queriesCmd.Flags().DurationVar(&threshold, "threshold", time.Second, "Minimum duration of queries shown with --slow")
+	queriesCmd.Flags().IntVar(&limit, "limit", 100, "Maximum number of queries to show, use -1 for all queries")
+	queriesCmd.Flags().BoolVar(&showSQL, "sql", false, "Show the SQL executed for each query")
+
+	return queriesCmd
+}
+
+func toQueryRows(entries []*runtimev1.QueryHistoryEntry) []*queryRow {
+	rows := make([]*queryRow, 0, len(entries))
+	for _, e := range entries {
+		r := &queryRow{
+			Time:        printTime(e.StartTime),
+			Type:        e.QueryType,
+			MetricsView: e.MetricsView,
+			Duration:    formatQueryDuration(e.DurationMs),
+			CacheHit:    e.CacheHit,
+			Subject:     e.Subject,
+			Error:       e.Error,
+		}
+		if e.Rows >= 0 {
+			r.Rows = fmt.Sprintf("%d", e.Rows)
+		}
+		rows = append(rows, r)
+	}
+	return rows
+}
+
+func formatQueryDuration(ms uint32) string {
+	return (time.Duration(ms) * time.Millisecond).String()
+}
+
+type queryRow struct {
+	Time        string `header:"time" json:"time"`
+	Type        string `header:"type" json:"type"`
+	MetricsView string `header:"metrics view" json:"metrics_view"`
+	Duration    string `header:"duration" json:"duration"`
+	Rows        string `header:"rows" json:"rows"`
+	CacheHit    bool   `header:"cache hit" json:"cache_hit"`
+	Subject     string `header:"subject" json:"subject"`
+	Error       string `header:"error" json:"error"`
+}
@@ -11,14 +11,19 @@ import (
 	"github.com/rilldata/rill/cli/cmd/deploy"
 	"github.com/rilldata/rill/cli/cmd/docs"
 	"github.com/rilldata/rill/cli/cmd/env"
+	fmtcmd "github.com/rilldata/rill/cli/cmd/fmt"
+	"github.com/rilldata/rill/cli/cmd/lint"
 	"github.com/rilldata/rill/cli/cmd/org"
 	"github.com/rilldata/rill/cli/cmd/project"
 	"github.com/rilldata/rill/cli/cmd/runtime"
 	"github.com/rilldata/rill/cli/cmd/service"
 	"github.com/rilldata/rill/cli/cmd/start"
 	"github.com/rilldata/rill/cli/cmd/sudo"
+	testcmd "github.com/rilldata/rill/cli/cmd/test"
 	"github.com/rilldata/rill/cli/cmd/upgrade"
 	"github.com/rilldata/rill/cli/cmd/user"
+	"github.com/rilldata/rill/cli/cmd/usergroup"
+	"github.com/rilldata/rill/cli/cmd/validate"
 	versioncmd "github.com/rilldata/rill/cli/cmd/version"
 	"github.com/rilldata/rill/cli/cmd/whoami"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
@@ -138,6 +143,10 @@ func runCmd(ctx context.Context, ver config.Version) error {
 	// Add sub-commands
 	rootCmd.AddCommand(start.StartCmd(ch))
 	rootCmd.AddCommand(admin.AdminCmd(ch))
+	rootCmd.AddCommand(testcmd.TestCmd(ch))
+	rootCmd.AddCommand(validate.ValidateCmd(ch))
+	rootCmd.AddCommand(lint.LintCmd(ch))
+	rootCmd.AddCommand(fmtcmd.FmtCmd(ch))
 	rootCmd.AddCommand(runtime.RuntimeCmd(ch))
 	rootCmd.AddCommand(docs.DocsCmd(ch, rootCmd))
 	rootCmd.AddCommand(completionCmd)
@@ -158,6 +167,7 @@ func runCmd(ctx context.Context, ver config.Version) error {
 		auth.LogoutCmd(ch),
 		sudo.SudoCmd(ch),
 		service.ServiceCmd(ch),
+		usergroup.UsergroupCmd(ch),
 	}
 	for _, cmd := range adminCmds {
 		cmd.PersistentFlags().StringVar(&cfg.AdminURL, "api-url", cfg.AdminURL, "Base URL for the admin API")
@@ -18,7 +18,9 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/email"
 	"github.com/rilldata/rill/runtime/pkg/graceful"
 	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/querycache"
 	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/pkg/secrets"
 	"github.com/rilldata/rill/runtime/server"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -55,6 +57,8 @@ type Config struct {
 	TracesExporter          observability.Exporter `default:"" split_words:"true"`
 	MetastoreDriver         string                 `default:"sqlite" split_words:"true"`
 	MetastoreURL            string                 `default:"file:rill?mode=memory&cache=shared" split_words:"true"`
+	MetastoreKeyring        string                 `split_words:"true"`
+	SecretsFilePath         string                 `split_words:"true"`
 	AllowedOrigins          []string               `default:"*" split_words:"true"`
 	SessionKeyPairs         []string               `split_words:"true"`
 	AuthEnable              bool                   `default:"false" split_words:"true"`
@@ -74,6 +78,14 @@ type Config struct {
 	SecurityEngineCacheSize int                    `default:"1000" split_words:"true"`
 	LogBufferCapacity       int                    `default:"10000" split_words:"true"`    // 10k log lines
 	LogBufferSizeBytes      int64                  `default:"16777216" split_words:"true"` // 16MB by default
+	QueryHistoryCapacity    int                    `default:"1000" split_words:"true"`     // 1k queries per instance
+	QueryHistorySizeBytes   int64                  `default:"16777216" split_words:"true"` // 16MB by default
+	QueryHistoryDir         string                 `split_words:"true"`
+	// QueryCacheBackend is the backend for the query cache: memory, disk or redis.
+	// The disk backend stores results in the SQLite file at QueryCachePath. The redis backend uses RedisURL.
+	QueryCacheBackend string        `default:"memory" split_words:"true"`
+	QueryCachePath    string        `split_words:"true"`
+	QueryCacheTTL     time.Duration `default:"24h" split_words:"true"` // Only used by the redis backend
 	// AllowHostAccess controls whether instance can use host credentials and
 	// local_file sources can access directory outside repo
 	AllowHostAccess bool `default:"false" split_words:"true"`
@@ -181,6 +193,32 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 			// Create ctx that cancels on termination signals
 			ctx := graceful.WithCancelOnTerminate(context.Background())
 
+			// Init query cache
+			var queryCache querycache.Cache
+			switch conf.QueryCacheBackend {
+			case "", "memory":
+				queryCache = querycache.NewMemory(conf.QueryCacheSizeBytes)
+			case "disk":
+				if conf.QueryCachePath == "" {
+					logger.Fatal("query cache path must be set for the disk query cache backend")
+				}
+				queryCache, err = querycache.NewDisk(conf.QueryCachePath, conf.QueryCacheSizeBytes)
+				if err != nil {
+					logger.Fatal("failed to open query cache", zap.Error(err))
+				}
+			case "redis":
+				if conf.RedisURL == "" {
+					logger.Fatal("redis url must be set for the redis query cache backend")
+				}
+				opts, err := redis.ParseURL(conf.RedisURL)
+				if err != nil {
+					logger.Fatal("failed to parse redis url", zap.Error(err))
+				}
+				queryCache = querycache.NewRedis(redis.NewClient(opts), conf.QueryCacheTTL)
+			default:
+				logger.Fatal("invalid query cache backend", zap.String("backend", conf.QueryCacheBackend))
+			}
+
 			// Init runtime
 			opts := &runtime.Options{
 				ConnectionCacheSize:          conf.ConnectionCacheSize,
@@ -189,16 +227,24 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 				SecurityEngineCacheSize:      conf.SecurityEngineCacheSize,
 				ControllerLogBufferCapacity:  conf.LogBufferCapacity,
 				ControllerLogBufferSizeBytes: conf.LogBufferSizeBytes,
+				QueryHistoryCapacity:         conf.QueryHistoryCapacity,
+				QueryHistorySizeBytes:        conf.QueryHistorySizeBytes,
+				QueryHistoryDir:              conf.QueryHistoryDir,
+				QueryCache:                   queryCache,
 				AllowHostAccess:              conf.AllowHostAccess,
 				SafeSourceRefresh:            conf.SafeSourceRefresh,
 				SystemConnectors: []*runtimev1.Connector{
 					{
 						Type:   conf.MetastoreDriver,
 						Name:   "metastore",
-						Config: map[string]string{"dsn": conf.MetastoreURL},
+						Config: map[string]string{"dsn": conf.MetastoreURL, "encryption_keyring": conf.MetastoreKeyring},
 					},
 				},
 			}
+			// Resolve "secret://vault/..." references from local files if configured
+			if conf.SecretsFilePath != "" {
+				opts.SecretStores = map[string]secrets.Store{"vault": secrets.NewFileStore(conf.SecretsFilePath)}
+			}
 			rt, err := runtime.New(ctx, opts, logger, activityClient, emailClient)
 			if err != nil {
 				logger.Fatal("error: could not create runtime", zap.Error(err))
@@ -0,0 +1,118 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
+	"github.com/rilldata/rill/runtime/modeltest"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// TestCmd represents the test command
+func TestCmd(ch *cmdutil.Helper) *cobra.Command {
+	var models []string
+
+	testCmd := &cobra.Command{
+		Use:   "test [<path>]",
+		Short: "Run model tests",
+		Long: `Run the model tests in the project's tests/ directory.
+
+Tests run against fixture data in an ephemeral DuckDB database and don't ingest sources or connect to external services.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			projectPath := "."
+			if len(args) > 0 {
+				var err error
+				projectPath, err = fileutil.ExpandHome(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			if !rillv1beta.HasRillProject(projectPath) {
+				fullpath, err := filepath.Abs(projectPath)
+				if err != nil {
+					return err
+				}
+				return fmt.Errorf("directory at %q doesn't contain a valid Rill project", fullpath)
+			}
+
+			repo, instanceID, err := cmdutil.RepoForProjectPath(projectPath)
+			if err != nil {
+				return err
+			}
+
+			parser, err := rillv1.Parse(ctx, repo, instanceID, modeltest.Environment, "duckdb", []string{"duckdb"})
+			if err != nil {
+				return err
+			}
+
+			tests, err := modeltest.Load(ctx, repo)
+			if err != nil {
+				return err
+			}
+			if len(models) > 0 {
+				var filtered []*modeltest.Test
+				for _, t := range tests {
+					for _, m := range models {
+						if strings.EqualFold(t.Model, m) {
+							filtered = append(filtered, t)
+							break
+						}
+					}
+				}
+				tests = filtered
+			}
+			if len(tests) == 0 {
+				ch.Printer.PrintlnWarn("No tests found")
+				return nil
+			}
+
+			runner, err := modeltest.NewRunner(repo, parser, zap.NewNop())
+			if err != nil {
+				return err
+			}
+			defer runner.Close()
+
+			failed := 0
+			for _, t := range tests {
+				res := runner.Run(ctx, t)
+				if res.Passed() {
+					ch.Printer.PrintlnSuccess(fmt.Sprintf("PASS %s (%s)", t.Name, t.Path))
+					continue
+				}
+
+				failed++
+				ch.Printer.PrintlnError(fmt.Sprintf("FAIL %s (%s)", t.Name, t.Path))
+				if res.Err != nil {
+					ch.Printer.Printf("  %s\n", res.Err.Error())
+				}
+				for _, row := range res.Missing {
+					ch.Printer.Printf("  - %s\n", row)
+				}
+				for _, row := range res.Unexpected {
+					ch.Printer.Printf("  + %s\n", row)
+				}
+			}
+
+			ch.Printer.Printf("\n%d passed, %d failed\n", len(tests)-failed, failed)
+			if failed > 0 {
+				return errors.New("some tests failed")
+			}
+			return nil
+		},
+	}
+
+	testCmd.Flags().StringSliceVar(&models, "model", nil, "Only run tests for the given model(s)")
+
+	return testCmd
+}
@@ -0,0 +1,146 @@
+package user
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func AttributesCmd(ch *cmdutil.Helper) *cobra.Command {
+	attributesCmd := &cobra.Command{
+		Use:   "attributes",
+		Short: "Manage custom user attributes",
+		Long:  "Manage custom attributes of users in the organization. Attributes can be referenced in security policies, e.g. `{{ .user.region }}`.",
+	}
+
+	attributesCmd.AddCommand(ShowAttributesCmd(ch))
+	attributesCmd.AddCommand(SetAttributesCmd(ch))
+
+	return attributesCmd
+}
+
+func ShowAttributesCmd(ch *cmdutil.Helper) *cobra.Command {
+	var email string
+	cfg := ch.Config
+
+	showCmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show the custom attributes of a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			cmdutil.StringPromptIfEmpty(&email, "Enter email")
+
+			res, err := client.GetUserAttributes(cmd.Context(), &adminv1.GetUserAttributesRequest{
+				Organization: cfg.Org,
+				Email:        email,
+			})
+			if err != nil {
+				return err
+			}
+
+			printAttributes(ch, email, res.Attributes)
+			return nil
+		},
+	}
+
+	showCmd.Flags().StringVar(&cfg.Org, "org", cfg.Org, "Organization")
+	showCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+
+	return showCmd
+}
+
+func SetAttributesCmd(ch *cmdutil.Helper) *cobra.Command {
+	var email string
+	var attributes []string
+	cfg := ch.Config
+
+	setCmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set the custom attributes of a user",
+		Long:  "Set the custom attributes of a user, replacing their current attributes. Values are parsed as JSON if possible (e.g. numbers, booleans or lists), otherwise they are used as strings.",
+		Example: `  rill user attributes set --email jane@example.com --attribute region=emea --attribute tenant_id=42
+  rill user attributes set --email jane@example.com  # Clears the user's attributes`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			cmdutil.StringPromptIfEmpty(&email, "Enter email")
+
+			attrs, err := parseAttributes(attributes)
+			if err != nil {
+				return err
+			}
+
+			res, err := client.SetUserAttributes(cmd.Context(), &adminv1.SetUserAttributesRequest{
+				Organization: cfg.Org,
+				Email:        email,
+				Attributes:   attrs,
+			})
+			if err != nil {
+				return err
+			}
+
+			printAttributes(ch, email, res.Attributes)
+			return nil
+		},
+	}
+
+	setCmd.Flags().StringVar(&cfg.Org, "org", cfg.Org, "Organization")
+	setCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+	setCmd.Flags().StringArrayVar(&attributes, "attribute", nil, "Attribute in the format <name>=<value> (can be repeated)")
+
+	return setCmd
+}
+
+// parseAttributes parses attributes in the format <name>=<value>.
+func parseAttributes(attributes []string) (*structpb.Struct, error) {
+	m := make(map[string]any, len(attributes))
+	for _, a := range attributes {
+		k, v, ok := strings.Cut(a, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid attribute %q: must be in the format <name>=<value>", a)
+		}
+		var val any
+		if err := json.Unmarshal([]byte(v), &val); err != nil {
+			val = v
+		}
+		m[k] = val
+	}
+	return structpb.NewStruct(m)
+}
+
+func printAttributes(ch *cmdutil.Helper, email string, attrs *structpb.Struct) {
+	if len(attrs.GetFields()) == 0 {
+		ch.Printer.PrintlnWarn(fmt.Sprintf("User %q has no custom attributes", email))
+		return
+	}
+
+	keys := make([]string, 0, len(attrs.Fields))
+	for k := range attrs.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	ch.Printer.PrintlnSuccess(fmt.Sprintf("Attributes of user %q:", email))
+	for _, k := range keys {
+		v, err := json.Marshal(attrs.Fields[k].AsInterface())
+		if err != nil {
+			v = []byte(attrs.Fields[k].String())
+		}
+		ch.Printer.PrintlnSuccess(fmt.Sprintf("  %s: %s", k, v))
+	}
+}
@@ -19,15 +19,28 @@ func SetRoleCmd(ch *cmdutil.Helper) *cobra.Command {
 		Use:   "set-role",
 		Short: "Set Role",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cmdutil.SelectPromptIfEmpty(&role, "Select role", userRoles, "")
-			cmdutil.StringPromptIfEmpty(&email, "Enter email")
-
 			client, err := cmdutil.Client(cfg)
 			if err != nil {
 				return err
 			}
 			defer client.Close()
 
+			roles := userRoles
+			if projectName != "" && role == "" {
+				// Projects can also use the org's custom roles
+				res, err := client.ListProjectRoles(cmd.Context(), &adminv1.ListProjectRolesRequest{Organization: cfg.Org})
+				if err != nil {
+					return err
+				}
+				roles = make([]string, len(res.Roles))
+				for i, r := range res.Roles {
+					roles[i] = r.Name
+				}
+			}
+
+			cmdutil.SelectPromptIfEmpty(&role, "Select role", roles, "")
+			cmdutil.StringPromptIfEmpty(&email, "Enter email")
+
 			if projectName != "" {
 				_, err = client.SetProjectMemberRole(cmd.Context(), &adminv1.SetProjectMemberRoleRequest{
 					Organization: cfg.Org,
@@ -58,7 +71,7 @@ func SetRoleCmd(ch *cmdutil.Helper) *cobra.Command {
 	setRoleCmd.Flags().StringVar(&cfg.Org, "org", cfg.Org, "Organization")
 	setRoleCmd.Flags().StringVar(&projectName, "project", "", "Project")
 	setRoleCmd.Flags().StringVar(&email, "email", "", "Email of the user")
-	setRoleCmd.Flags().StringVar(&role, "role", "", fmt.Sprintf("Role of the user [%v]", strings.Join(userRoles, ", ")))
+	setRoleCmd.Flags().StringVar(&role, "role", "", fmt.Sprintf("Role of the user [%v] or a custom project role", strings.Join(userRoles, ", ")))
 
 	return setRoleCmd
 }
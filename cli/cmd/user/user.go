@@ -18,6 +18,7 @@ func UserCmd(ch *cmdutil.Helper) *cobra.Command {
 	userCmd.AddCommand(AddCmd(ch))
 	userCmd.AddCommand(RemoveCmd(ch))
 	userCmd.AddCommand(SetRoleCmd(ch))
+	userCmd.AddCommand(AttributesCmd(ch))
 	userCmd.AddCommand(whitelist.WhitelistCmd(ch))
 
 	return userCmd
@@ -0,0 +1,55 @@
+package usergroup
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func AddProjectCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectNames []string
+	var role string
+
+	addProjectCmd := &cobra.Command{
+		Use:   "add-project <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Grant a user group a role on one or more projects",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			if len(projectNames) == 0 {
+				return fmt.Errorf("at least one --project must be specified")
+			}
+			cmdutil.SelectPromptIfEmpty(&role, "Select role", projectRoles, "")
+
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			for _, projectName := range projectNames {
+				_, err = client.AddProjectMemberUsergroup(cmd.Context(), &adminv1.AddProjectMemberUsergroupRequest{
+					Organization: cfg.Org,
+					Project:      projectName,
+					Usergroup:    args[0],
+					Role:         role,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to add user group to project %q: %w", projectName, err)
+				}
+
+				ch.Printer.PrintlnSuccess(fmt.Sprintf("User group %q added to the project \"%s/%s\" as %q", args[0], cfg.Org, projectName, role))
+			}
+
+			return nil
+		},
+	}
+
+	addProjectCmd.Flags().StringSliceVar(&projectNames, "project", nil, "Project (can be repeated)")
+	addProjectCmd.Flags().StringVar(&role, "role", "", fmt.Sprintf("Role of the user group [%v]", strings.Join(projectRoles, ", ")))
+
+	return addProjectCmd
+}
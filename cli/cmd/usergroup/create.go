@@ -0,0 +1,38 @@
+package usergroup
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CreateCmd(ch *cmdutil.Helper) *cobra.Command {
+	createCmd := &cobra.Command{
+		Use:   "create <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.CreateUsergroup(cmd.Context(), &adminv1.CreateUsergroupRequest{
+				Organization: cfg.Org,
+				Name:         args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.Printer.PrintlnSuccess(fmt.Sprintf("Created user group %q in organization %q", res.Usergroup.Name, cfg.Org))
+			return nil
+		},
+	}
+
+	return createCmd
+}
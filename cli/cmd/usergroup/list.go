@@ -0,0 +1,51 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd(ch *cmdutil.Helper) *cobra.Command {
+	var pageSize uint32
+	var pageToken string
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List user groups",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.ListUsergroups(cmd.Context(), &adminv1.ListUsergroupsRequest{
+				Organization: cfg.Org,
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			err = ch.Printer.PrintResource(toTable(res.Usergroups))
+			if err != nil {
+				return err
+			}
+
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+
+			return nil
+		},
+	}
+
+	listCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of user groups to return per page")
+	listCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return listCmd
+}
@@ -0,0 +1,45 @@
+package usergroup
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func RemoveMemberCmd(ch *cmdutil.Helper) *cobra.Command {
+	var email string
+
+	removeMemberCmd := &cobra.Command{
+		Use:   "remove-member <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove a member from a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := ch.Config
+			cmdutil.StringPromptIfEmpty(&email, "Enter email")
+
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.RemoveUsergroupMember(cmd.Context(), &adminv1.RemoveUsergroupMemberRequest{
+				Organization: cfg.Org,
+				Usergroup:    args[0],
+				Email:        email,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.Printer.PrintlnSuccess(fmt.Sprintf("Removed user %q from user group %q", email, args[0]))
+			return nil
+		},
+	}
+
+	removeMemberCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+
+	return removeMemberCmd
+}
@@ -0,0 +1,39 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func UsergroupCmd(ch *cmdutil.Helper) *cobra.Command {
+	cfg := ch.Config
+	usergroupCmd := &cobra.Command{
+		Use:               "usergroup",
+		Short:             "Manage user groups",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(cfg), cmdutil.CheckOrganization(cfg)),
+	}
+
+	usergroupCmd.PersistentFlags().StringVar(&cfg.Org, "org", cfg.Org, "Organization Name")
+	usergroupCmd.AddCommand(CreateCmd(ch))
+	usergroupCmd.AddCommand(ListCmd(ch))
+	usergroupCmd.AddCommand(AddMemberCmd(ch))
+	usergroupCmd.AddCommand(RemoveMemberCmd(ch))
+	usergroupCmd.AddCommand(AddProjectCmd(ch))
+
+	return usergroupCmd
+}
+
+var projectRoles = []string{"admin", "viewer"}
+
+func toTable(groups []*adminv1.Usergroup) []*usergroup {
+	res := make([]*usergroup, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, &usergroup{Name: g.Name})
+	}
+	return res
+}
+
+type usergroup struct {
+	Name string `header:"name" json:"name"`
+}
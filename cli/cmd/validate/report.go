@@ -0,0 +1,257 @@
+package validate
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/printer"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+)
+
+// Statuses of a resource in a report.
+const (
+	statusOK      = "ok"
+	statusError   = "error"
+	statusSkipped = "skipped"
+)
+
+// report is the outcome of validating a project.
+type report struct {
+	Valid       bool              `json:"valid"`
+	ParseErrors []*parseError     `json:"parse_errors"`
+	Resources   []*resourceReport `json:"resources"`
+}
+
+type parseError struct {
+	Path    string `json:"path" header:"path"`
+	Line    uint32 `json:"line,omitempty" header:"line"`
+	Message string `json:"message" header:"error"`
+}
+
+type resourceReport struct {
+	Kind   string `json:"kind" header:"kind"`
+	Name   string `json:"name" header:"name"`
+	Path   string `json:"path" header:"path"`
+	Status string `json:"status" header:"status"`
+	Error  string `json:"error,omitempty" header:"error"`
+}
+
+// newReport builds a report from the resources of a reconciled project.
+// If stubbedSources is true, sources that don't use the "local_file" connector and are not in stubbed were not ingested,
+// so they and the resources that failed because they depend on them are reported as skipped.
+func newReport(resources []*runtimev1.Resource, stubbedSources bool, stubbed map[string]bool) *report {
+	rep := &report{
+		Valid:       true,
+		ParseErrors: []*parseError{},
+		Resources:   []*resourceReport{},
+	}
+
+	byName := make(map[string]*runtimev1.Resource, len(resources))
+	for _, r := range resources {
+		byName[resourceKey(r.Meta.Name)] = r
+	}
+
+	skipped := make(map[string]bool)
+	var isSkipped func(r *runtimev1.Resource) bool
+	isSkipped = func(r *runtimev1.Resource) bool {
+		key := resourceKey(r.Meta.Name)
+		if s, ok := skipped[key]; ok {
+			return s
+		}
+		skipped[key] = false // Guards against cycles
+
+		res := false
+		if src := r.GetSource(); src != nil && stubbedSources {
+			res = src.Spec.SourceConnector != "local_file" && !stubbed[strings.ToLower(r.Meta.Name.Name)]
+		} else if r.Meta.ReconcileError != "" {
+			for _, ref := range r.Meta.Refs {
+				if dep, ok := byName[resourceKey(ref)]; ok && isSkipped(dep) {
+					res = true
+					break
+				}
+			}
+		}
+
+		skipped[key] = res
+		return res
+	}
+
+	for _, r := range resources {
+		if r.Meta.Name.Kind == runtime.ResourceKindProjectParser {
+			if state := r.GetProjectParser().State; state != nil {
+				for _, e := range state.ParseErrors {
+					pe := &parseError{Path: e.FilePath, Message: e.Message}
+					if e.StartLocation != nil {
+						pe.Line = e.StartLocation.Line
+					}
+					rep.ParseErrors = append(rep.ParseErrors, pe)
+				}
+			}
+			continue
+		}
+		if r.Meta.Hidden {
+			continue
+		}
+
+		rr := &resourceReport{
+			Kind:   formatResourceKind(r.Meta.Name.Kind),
+			Name:   r.Meta.Name.Name,
+			Status: statusOK,
+		}
+		if len(r.Meta.FilePaths) > 0 {
+			rr.Path = r.Meta.FilePaths[0]
+		}
+		if isSkipped(r) {
+			rr.Status = statusSkipped
+		} else if r.Meta.ReconcileError != "" {
+			rr.Status = statusError
+			rr.Error = r.Meta.ReconcileError
+		}
+		rep.Resources = append(rep.Resources, rr)
+	}
+
+	sort.Slice(rep.Resources, func(i, j int) bool {
+		a, b := rep.Resources[i], rep.Resources[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+
+	if len(rep.ParseErrors) > 0 {
+		rep.Valid = false
+	}
+	for _, r := range rep.Resources {
+		if r.Status == statusError {
+			rep.Valid = false
+		}
+	}
+
+	return rep
+}
+
+// printHuman prints the report as tables.
+func (r *report) printHuman(p *printer.Printer) error {
+	if len(r.Resources) > 0 {
+		p.PrintlnSuccess("\nResources\n")
+		if err := p.PrintResource(r.Resources); err != nil {
+			return err
+		}
+	}
+
+	if len(r.ParseErrors) > 0 {
+		p.PrintlnError("\nParse errors\n")
+		if err := p.PrintResource(r.ParseErrors); err != nil {
+			return err
+		}
+	}
+
+	if r.Valid {
+		p.PrintlnSuccess("\nProject is valid")
+	} else {
+		p.PrintlnError("\nProject is not valid")
+	}
+	return nil
+}
+
+// JUnit XML structure (see https://github.com/testmoapp/junitxml for the de facto format).
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Name     string            `xml:"name,attr"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Skipped  int               `xml:"skipped,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Cases    []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnit writes the report as JUnit XML. Each file with parse errors and each resource is a test case.
+func (r *report) writeJUnit(w io.Writer) error {
+	parse := &junitTestSuite{Name: "parse"}
+	for _, e := range r.ParseErrors {
+		msg := e.Message
+		if e.Line > 0 {
+			msg = fmt.Sprintf("%s (line %d)", msg, e.Line)
+		}
+		parse.Cases = append(parse.Cases, &junitTestCase{
+			Name:      e.Path,
+			Classname: "parse",
+			File:      e.Path,
+			Failure:   &junitMessage{Message: msg, Text: msg},
+		})
+		parse.Failures++
+	}
+	parse.Tests = len(parse.Cases)
+
+	resources := &junitTestSuite{Name: "resources"}
+	for _, res := range r.Resources {
+		tc := &junitTestCase{
+			Name:      res.Name,
+			Classname: res.Kind,
+			File:      res.Path,
+		}
+		switch res.Status {
+		case statusError:
+			tc.Failure = &junitMessage{Message: res.Error, Text: res.Error}
+			resources.Failures++
+		case statusSkipped:
+			tc.Skipped = &junitMessage{Message: "depends on a source without stub data"}
+			resources.Skipped++
+		}
+		resources.Cases = append(resources.Cases, tc)
+	}
+	resources.Tests = len(resources.Cases)
+
+	suites := &junitTestSuites{
+		Name:     "rill validate",
+		Tests:    parse.Tests + resources.Tests,
+		Failures: parse.Failures + resources.Failures,
+		Skipped:  resources.Skipped,
+		Suites:   []*junitTestSuite{parse, resources},
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func resourceKey(n *runtimev1.ResourceName) string {
+	return n.Kind + "/" + strings.ToLower(n.Name)
+}
+
+func formatResourceKind(k string) string {
+	k = strings.TrimPrefix(k, "rill.runtime.v1.")
+	k = strings.TrimSuffix(k, "V2")
+	return k
+}
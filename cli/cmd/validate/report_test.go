@@ -0,0 +1,83 @@
+package validate
+
+import (
+	"bytes"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	resources := []*runtimev1.Resource{
+		{
+			Meta: &runtimev1.ResourceMeta{Name: &runtimev1.ResourceName{Kind: runtime.ResourceKindProjectParser, Name: "parser"}},
+			Resource: &runtimev1.Resource_ProjectParser{ProjectParser: &runtimev1.ProjectParser{
+				State: &runtimev1.ProjectParserState{ParseErrors: []*runtimev1.ParseError{
+					{Message: "bad yaml", FilePath: "/models/broken.yaml", StartLocation: &runtimev1.CharLocation{Line: 3}},
+				}},
+			}},
+		},
+		newSource("stubbed", "s3", ""),
+		newSource("local", "local_file", ""),
+		newSource("remote", "s3", "sources are stubbed, but no stub data was provided for this source"),
+		newResource(runtime.ResourceKindModel, "ok_model", "", newName(runtime.ResourceKindSource, "stubbed")),
+		newResource(runtime.ResourceKindModel, "bad_model", "syntax error", newName(runtime.ResourceKindSource, "local")),
+		newResource(runtime.ResourceKindModel, "skipped_model", "dependency error", newName(runtime.ResourceKindSource, "remote")),
+		newResource(runtime.ResourceKindMetricsView, "skipped_mv", `table "skipped_model" does not exist`, newName(runtime.ResourceKindModel, "skipped_model")),
+	}
+
+	rep := newReport(resources, true, map[string]bool{"stubbed": true})
+	require.False(t, rep.Valid)
+	require.Equal(t, []*parseError{{Path: "/models/broken.yaml", Line: 3, Message: "bad yaml"}}, rep.ParseErrors)
+
+	statuses := make(map[string]string)
+	for _, r := range rep.Resources {
+		statuses[r.Name] = r.Status
+	}
+	require.Equal(t, map[string]string{
+		"stubbed":       statusOK,
+		"local":         statusOK,
+		"remote":        statusSkipped,
+		"ok_model":      statusOK,
+		"bad_model":     statusError,
+		"skipped_model": statusSkipped,
+		"skipped_mv":    statusSkipped,
+	}, statuses)
+
+	var buf bytes.Buffer
+	require.NoError(t, rep.writeJUnit(&buf))
+	out := buf.String()
+	require.Contains(t, out, `<testsuites name="rill validate" tests="8" failures="2" skipped="3">`)
+	require.Contains(t, out, `<failure message="bad yaml (line 3)">`)
+	require.Contains(t, out, `<testcase name="bad_model" classname="Model" file="/bad_model.sql">`)
+
+	// Without the parse error and the failing model, the project is valid
+	resources = append(resources[1:5], resources[6:]...)
+	rep = newReport(resources, true, map[string]bool{"stubbed": true})
+	require.True(t, rep.Valid)
+}
+
+func newName(kind, name string) *runtimev1.ResourceName {
+	return &runtimev1.ResourceName{Kind: kind, Name: name}
+}
+
+func newResource(kind, name, reconcileErr string, refs ...*runtimev1.ResourceName) *runtimev1.Resource {
+	return &runtimev1.Resource{
+		Meta: &runtimev1.ResourceMeta{
+			Name:           newName(kind, name),
+			Refs:           refs,
+			FilePaths:      []string{"/" + name + ".sql"},
+			ReconcileError: reconcileErr,
+		},
+	}
+}
+
+func newSource(name, connector, reconcileErr string) *runtimev1.Resource {
+	r := newResource(runtime.ResourceKindSource, name, reconcileErr)
+	r.Resource = &runtimev1.Resource_Source{Source: &runtimev1.SourceV2{
+		Spec: &runtimev1.SourceSpec{SourceConnector: connector},
+	}}
+	return r
+}
@@ -0,0 +1,276 @@
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/c2h5oh/datasize"
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/variable"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/modeltest"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// stubExtensions are the file extensions that are recognized for source stubs, in order of precedence.
+var stubExtensions = []string{".parquet", ".csv", ".json", ".ndjson"}
+
+// ValidateCmd represents the validate command
+func ValidateCmd(ch *cmdutil.Helper) *cobra.Command {
+	var environment string
+	var sourcesMode string
+	var stubsDir string
+	var sampleSize string
+	var format string
+	var timeout time.Duration
+	var variables []string
+
+	validateCmd := &cobra.Command{
+		Use:   "validate [<path>]",
+		Short: "Validate project without starting the web app",
+		Long: `Validate a project by parsing it and reconciling all its resources in a temporary database.
+
+By default, sources are stubbed so validation runs offline: each source is ingested from a file named
+after it in the --stubs directory (CSV, Parquet or JSON), or else from the rows provided for it in model tests.
+Sources without stub data are skipped along with the resources that depend on them.
+With --sources=sample, sources are ingested from their connectors, but only the first rows of each file are read.
+
+The command exits with an error if the project contains any parse, reconcile or validation errors.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			projectPath := "."
+			if len(args) > 0 {
+				var err error
+				projectPath, err = fileutil.ExpandHome(args[0])
+				if err != nil {
+					return err
+				}
+			}
+			projectPath, err := filepath.Abs(projectPath)
+			if err != nil {
+				return err
+			}
+			if !rillv1beta.HasRillProject(projectPath) {
+				return fmt.Errorf("directory at %q doesn't contain a valid Rill project", projectPath)
+			}
+
+			if format != "human" && format != "json" && format != "junit" {
+				return fmt.Errorf("invalid format %q (options: \"human\", \"json\", \"junit\")", format)
+			}
+
+			vars, err := variable.Parse(variables)
+			if err != nil {
+				return err
+			}
+
+			tempDir, err := os.MkdirTemp("", "rill-validate-*")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tempDir)
+
+			inst := &drivers.Instance{
+				ID:               "default",
+				OLAPConnector:    "duckdb",
+				RepoConnector:    "repo",
+				CatalogConnector: "catalog",
+				Environment:      environment,
+				Connectors: []*runtimev1.Connector{
+					{
+						Type:   "file",
+						Name:   "repo",
+						Config: map[string]string{"dsn": projectPath},
+					},
+					{
+						Type:   "duckdb",
+						Name:   "duckdb",
+						Config: map[string]string{"dsn": filepath.Join(tempDir, "main.db")},
+					},
+					{
+						Type:   "sqlite",
+						Name:   "catalog",
+						Config: map[string]string{"dsn": fmt.Sprintf("file:%s?cache=shared", filepath.Join(tempDir, "meta.db"))},
+					},
+				},
+				Variables:   vars,
+				Annotations: map[string]string{},
+			}
+
+			var stubbed map[string]bool
+			switch sourcesMode {
+			case "stub":
+				inst.SourceStubs, err = sourceStubs(ctx, projectPath, environment, stubsDir, tempDir)
+				if err != nil {
+					return err
+				}
+				stubbed = make(map[string]bool, len(inst.SourceStubs))
+				for name := range inst.SourceStubs {
+					stubbed[name] = true
+				}
+			case "sample":
+				inst.SourceExtractPolicy = map[string]any{
+					"rows":  map[string]any{"strategy": "head", "size": sampleSize},
+					"files": map[string]any{"strategy": "head", "size": "1"},
+				}
+			default:
+				return fmt.Errorf("invalid sources mode %q (options: \"stub\", \"sample\")", sourcesMode)
+			}
+
+			rt, err := runtime.New(ctx, &runtime.Options{
+				ConnectionCacheSize:          100,
+				MetastoreConnector:           "metastore",
+				QueryCacheSizeBytes:          int64(datasize.MB * 100),
+				AllowHostAccess:              true,
+				SecurityEngineCacheSize:      1000,
+				ControllerLogBufferCapacity:  10000,
+				ControllerLogBufferSizeBytes: int64(datasize.MB * 16),
+				SystemConnectors: []*runtimev1.Connector{
+					{
+						Type:   "sqlite",
+						Name:   "metastore",
+						Config: map[string]string{"dsn": "file:rill_validate?mode=memory&cache=shared"},
+					},
+				},
+			}, zap.NewNop(), activity.NewNoopClient(), email.New(email.NewNoopSender()))
+			if err != nil {
+				return err
+			}
+			defer rt.Close()
+
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			err = rt.CreateInstance(ctx, inst)
+			if err != nil {
+				return err
+			}
+
+			ctrl, err := rt.Controller(ctx, inst.ID)
+			if err != nil {
+				return err
+			}
+			_, err = ctrl.Get(ctx, runtime.GlobalProjectParserName, false)
+			if err != nil {
+				return err
+			}
+			err = ctrl.WaitUntilIdle(ctx, false)
+			if err != nil {
+				if errors.Is(err, context.DeadlineExceeded) {
+					return fmt.Errorf("validation did not complete within %s", timeout)
+				}
+				return err
+			}
+
+			resources, err := ctrl.List(ctx, "", false)
+			if err != nil {
+				return err
+			}
+
+			rep := newReport(resources, sourcesMode == "stub", stubbed)
+			switch format {
+			case "json":
+				err = ch.Printer.PrintJSON(rep)
+			case "junit":
+				err = rep.writeJUnit(os.Stdout)
+			default:
+				err = rep.printHuman(ch.Printer)
+			}
+			if err != nil {
+				return err
+			}
+
+			if !rep.Valid {
+				return errors.New("project is not valid")
+			}
+			return nil
+		},
+	}
+
+	validateCmd.Flags().StringVar(&environment, "environment", "prod", "Environment to validate the project for")
+	validateCmd.Flags().StringVar(&sourcesMode, "sources", "stub", "How to handle sources (options: \"stub\", \"sample\")")
+	validateCmd.Flags().StringVar(&stubsDir, "stubs", "", "Directory containing stub data for sources, in files named after the sources")
+	validateCmd.Flags().StringVar(&sampleSize, "sample-size", "100KB", "Amount of data to read from each source file when using --sources=sample")
+	validateCmd.Flags().StringVar(&format, "format", "human", "Output format (options: \"human\", \"json\", \"junit\")")
+	validateCmd.Flags().DurationVar(&timeout, "timeout", 10*time.Minute, "Maximum time to spend on validation")
+	validateCmd.Flags().StringSliceVarP(&variables, "env", "e", []string{}, "Set project variables")
+
+	return validateCmd
+}
+
+// sourceStubs finds stub data for the project's sources and returns the paths to the stub files by lowercase source name.
+// Files in stubsDir take precedence over rows provided for sources in model tests, which are written to CSV files in tempDir.
+func sourceStubs(ctx context.Context, projectPath, environment, stubsDir, tempDir string) (map[string]string, error) {
+	repo, instanceID, err := cmdutil.RepoForProjectPath(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	parser, err := rillv1.Parse(ctx, repo, instanceID, environment, "duckdb", []string{"duckdb"})
+	if err != nil {
+		return nil, err
+	}
+
+	tests, err := modeltest.Load(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	stubs := make(map[string]string)
+	for _, r := range parser.Resources {
+		if r.SourceSpec == nil {
+			continue
+		}
+		name := strings.ToLower(r.Name.Name)
+
+		if stubsDir != "" {
+			for _, ext := range stubExtensions {
+				path, err := filepath.Abs(filepath.Join(stubsDir, r.Name.Name+ext))
+				if err != nil {
+					return nil, err
+				}
+				if _, err := os.Stat(path); err == nil {
+					stubs[name] = path
+					break
+				}
+			}
+			if stubs[name] != "" {
+				continue
+			}
+		}
+
+		for _, t := range tests {
+			f, ok := t.Given[name]
+			if !ok {
+				continue
+			}
+			data, err := f.CSV(ctx, repo)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rows for source %q in test %q: %w", r.Name.Name, t.Path, err)
+			}
+			path := filepath.Join(tempDir, fmt.Sprintf("stub_%s.csv", name))
+			err = os.WriteFile(path, []byte(data), 0o600)
+			if err != nil {
+				return nil, err
+			}
+			stubs[name] = path
+			break
+		}
+	}
+
+	return stubs, nil
+}
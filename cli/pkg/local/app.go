@@ -129,6 +129,9 @@ func NewApp(ctx context.Context, ver config.Version, verbose, debug, reset bool,
 		SecurityEngineCacheSize:      1000,
 		ControllerLogBufferCapacity:  10000,
 		ControllerLogBufferSizeBytes: int64(datasize.MB * 16),
+		QueryHistoryCapacity:         1000,
+		QueryHistorySizeBytes:        int64(datasize.MB * 16),
+		QueryHistoryDir:              filepath.Join(dbDirPath, "query_history"),
 	}
 	rt, err := runtime.New(ctx, rtOpts, logger, client, email.New(email.NewNoopSender()))
 	if err != nil {
@@ -171,6 +174,7 @@ func NewApp(ctx context.Context, ver config.Version, verbose, debug, reset bool,
 		OLAPConnector:    olapDriver,
 		RepoConnector:    "repo",
 		CatalogConnector: "catalog",
+		Environment:      "dev",
 		Connectors: []*runtimev1.Connector{
 			{
 				Type:   "file",
@@ -42,11 +42,22 @@ const (
 	AdminService_RemoveOrganizationMember_FullMethodName     = "/rill.admin.v1.AdminService/RemoveOrganizationMember"
 	AdminService_LeaveOrganization_FullMethodName            = "/rill.admin.v1.AdminService/LeaveOrganization"
 	AdminService_SetOrganizationMemberRole_FullMethodName    = "/rill.admin.v1.AdminService/SetOrganizationMemberRole"
+	AdminService_GetUserAttributes_FullMethodName            = "/rill.admin.v1.AdminService/GetUserAttributes"
+	AdminService_SetUserAttributes_FullMethodName            = "/rill.admin.v1.AdminService/SetUserAttributes"
 	AdminService_ListProjectMembers_FullMethodName           = "/rill.admin.v1.AdminService/ListProjectMembers"
 	AdminService_ListProjectInvites_FullMethodName           = "/rill.admin.v1.AdminService/ListProjectInvites"
 	AdminService_AddProjectMember_FullMethodName             = "/rill.admin.v1.AdminService/AddProjectMember"
 	AdminService_RemoveProjectMember_FullMethodName          = "/rill.admin.v1.AdminService/RemoveProjectMember"
 	AdminService_SetProjectMemberRole_FullMethodName         = "/rill.admin.v1.AdminService/SetProjectMemberRole"
+	AdminService_AddProjectMemberUsergroup_FullMethodName    = "/rill.admin.v1.AdminService/AddProjectMemberUsergroup"
+	AdminService_CreateUsergroup_FullMethodName              = "/rill.admin.v1.AdminService/CreateUsergroup"
+	AdminService_ListUsergroups_FullMethodName               = "/rill.admin.v1.AdminService/ListUsergroups"
+	AdminService_AddUsergroupMember_FullMethodName           = "/rill.admin.v1.AdminService/AddUsergroupMember"
+	AdminService_RemoveUsergroupMember_FullMethodName        = "/rill.admin.v1.AdminService/RemoveUsergroupMember"
+	AdminService_ListProjectRoles_FullMethodName             = "/rill.admin.v1.AdminService/ListProjectRoles"
+	AdminService_CreateProjectRole_FullMethodName            = "/rill.admin.v1.AdminService/CreateProjectRole"
+	AdminService_DeleteProjectRole_FullMethodName            = "/rill.admin.v1.AdminService/DeleteProjectRole"
+	AdminService_ListAuditEvents_FullMethodName              = "/rill.admin.v1.AdminService/ListAuditEvents"
 	AdminService_GetCurrentUser_FullMethodName               = "/rill.admin.v1.AdminService/GetCurrentUser"
 	AdminService_IssueRepresentativeAuthToken_FullMethodName = "/rill.admin.v1.AdminService/IssueRepresentativeAuthToken"
 	AdminService_RevokeCurrentAuthToken_FullMethodName       = "/rill.admin.v1.AdminService/RevokeCurrentAuthToken"
@@ -55,12 +66,20 @@ const (
 	AdminService_CreateWhitelistedDomain_FullMethodName      = "/rill.admin.v1.AdminService/CreateWhitelistedDomain"
 	AdminService_RemoveWhitelistedDomain_FullMethodName      = "/rill.admin.v1.AdminService/RemoveWhitelistedDomain"
 	AdminService_ListWhitelistedDomains_FullMethodName       = "/rill.admin.v1.AdminService/ListWhitelistedDomains"
+	AdminService_GetOrganizationSSO_FullMethodName           = "/rill.admin.v1.AdminService/GetOrganizationSSO"
+	AdminService_SetOrganizationSSO_FullMethodName           = "/rill.admin.v1.AdminService/SetOrganizationSSO"
+	AdminService_RemoveOrganizationSSO_FullMethodName        = "/rill.admin.v1.AdminService/RemoveOrganizationSSO"
+	AdminService_AddOrganizationSSODomain_FullMethodName     = "/rill.admin.v1.AdminService/AddOrganizationSSODomain"
+	AdminService_VerifyOrganizationSSODomain_FullMethodName  = "/rill.admin.v1.AdminService/VerifyOrganizationSSODomain"
+	AdminService_RemoveOrganizationSSODomain_FullMethodName  = "/rill.admin.v1.AdminService/RemoveOrganizationSSODomain"
 	AdminService_GetUser_FullMethodName                      = "/rill.admin.v1.AdminService/GetUser"
 	AdminService_SearchUsers_FullMethodName                  = "/rill.admin.v1.AdminService/SearchUsers"
 	AdminService_SearchProjectUsers_FullMethodName           = "/rill.admin.v1.AdminService/SearchProjectUsers"
 	AdminService_ListSuperusers_FullMethodName               = "/rill.admin.v1.AdminService/ListSuperusers"
 	AdminService_GetDeploymentCredentials_FullMethodName     = "/rill.admin.v1.AdminService/GetDeploymentCredentials"
 	AdminService_GetIFrame_FullMethodName                    = "/rill.admin.v1.AdminService/GetIFrame"
+	AdminService_IssueEmbedToken_FullMethodName              = "/rill.admin.v1.AdminService/IssueEmbedToken"
+	AdminService_RevokeEmbedToken_FullMethodName             = "/rill.admin.v1.AdminService/RevokeEmbedToken"
 	AdminService_SetSuperuser_FullMethodName                 = "/rill.admin.v1.AdminService/SetSuperuser"
 	AdminService_SudoGetResource_FullMethodName              = "/rill.admin.v1.AdminService/SudoGetResource"
 	AdminService_SudoUpdateUserQuotas_FullMethodName         = "/rill.admin.v1.AdminService/SudoUpdateUserQuotas"
@@ -139,6 +158,11 @@ type AdminServiceClient interface {
 	LeaveOrganization(ctx context.Context, in *LeaveOrganizationRequest, opts ...grpc.CallOption) (*LeaveOrganizationResponse, error)
 	// SetOrganizationMemberRole sets the role for the member
 	SetOrganizationMemberRole(ctx context.Context, in *SetOrganizationMemberRoleRequest, opts ...grpc.CallOption) (*SetOrganizationMemberRoleResponse, error)
+	// GetUserAttributes returns the custom attributes of a user in the organization
+	GetUserAttributes(ctx context.Context, in *GetUserAttributesRequest, opts ...grpc.CallOption) (*GetUserAttributesResponse, error)
+	// SetUserAttributes replaces the custom attributes of a user in the organization.
+	// The attributes are added to the JWT attributes of the user for the organization's deployments.
+	SetUserAttributes(ctx context.Context, in *SetUserAttributesRequest, opts ...grpc.CallOption) (*SetUserAttributesResponse, error)
 	// ListProjectMembers lists all the project members
 	ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error)
 	// ListProjectInvites lists all the project invites
@@ -149,6 +173,24 @@ type AdminServiceClient interface {
 	RemoveProjectMember(ctx context.Context, in *RemoveProjectMemberRequest, opts ...grpc.CallOption) (*RemoveProjectMemberResponse, error)
 	// SetProjectMemberRole sets the role for the member
 	SetProjectMemberRole(ctx context.Context, in *SetProjectMemberRoleRequest, opts ...grpc.CallOption) (*SetProjectMemberRoleResponse, error)
+	// AddProjectMemberUsergroup grants a user group a role on the project
+	AddProjectMemberUsergroup(ctx context.Context, in *AddProjectMemberUsergroupRequest, opts ...grpc.CallOption) (*AddProjectMemberUsergroupResponse, error)
+	// CreateUsergroup creates a user group in the organization
+	CreateUsergroup(ctx context.Context, in *CreateUsergroupRequest, opts ...grpc.CallOption) (*CreateUsergroupResponse, error)
+	// ListUsergroups lists the user groups in the organization
+	ListUsergroups(ctx context.Context, in *ListUsergroupsRequest, opts ...grpc.CallOption) (*ListUsergroupsResponse, error)
+	// AddUsergroupMember adds an org member to the user group
+	AddUsergroupMember(ctx context.Context, in *AddUsergroupMemberRequest, opts ...grpc.CallOption) (*AddUsergroupMemberResponse, error)
+	// RemoveUsergroupMember removes a member from the user group
+	RemoveUsergroupMember(ctx context.Context, in *RemoveUsergroupMemberRequest, opts ...grpc.CallOption) (*RemoveUsergroupMemberResponse, error)
+	// ListProjectRoles lists the built-in and custom project roles available in the organization
+	ListProjectRoles(ctx context.Context, in *ListProjectRolesRequest, opts ...grpc.CallOption) (*ListProjectRolesResponse, error)
+	// CreateProjectRole creates a custom project role in the organization
+	CreateProjectRole(ctx context.Context, in *CreateProjectRoleRequest, opts ...grpc.CallOption) (*CreateProjectRoleResponse, error)
+	// DeleteProjectRole deletes a custom project role from the organization
+	DeleteProjectRole(ctx context.Context, in *DeleteProjectRoleRequest, opts ...grpc.CallOption) (*DeleteProjectRoleResponse, error)
+	// ListAuditEvents lists the changes made in the organization, newest first
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 	// GetCurrentUser returns the currently authenticated user (if any)
 	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error)
 	// IssueRepresentativeAuthToken returns the temporary token for given email
@@ -166,6 +208,20 @@ type AdminServiceClient interface {
 	RemoveWhitelistedDomain(ctx context.Context, in *RemoveWhitelistedDomainRequest, opts ...grpc.CallOption) (*RemoveWhitelistedDomainResponse, error)
 	// ListWhitelistedDomains lists all the whitelisted domains for the organization
 	ListWhitelistedDomains(ctx context.Context, in *ListWhitelistedDomainsRequest, opts ...grpc.CallOption) (*ListWhitelistedDomainsResponse, error)
+	// GetOrganizationSSO returns the custom SSO provider of an organization
+	GetOrganizationSSO(ctx context.Context, in *GetOrganizationSSORequest, opts ...grpc.CallOption) (*GetOrganizationSSOResponse, error)
+	// SetOrganizationSSO sets a custom OIDC provider for logins by users in the organization's verified SSO domains
+	SetOrganizationSSO(ctx context.Context, in *SetOrganizationSSORequest, opts ...grpc.CallOption) (*SetOrganizationSSOResponse, error)
+	// RemoveOrganizationSSO removes the custom SSO provider of an organization
+	RemoveOrganizationSSO(ctx context.Context, in *RemoveOrganizationSSORequest, opts ...grpc.CallOption) (*RemoveOrganizationSSOResponse, error)
+	// AddOrganizationSSODomain adds an email domain whose users must log in through the organization's SSO provider.
+	// The domain is not used for SSO until it has been verified with VerifyOrganizationSSODomain.
+	AddOrganizationSSODomain(ctx context.Context, in *AddOrganizationSSODomainRequest, opts ...grpc.CallOption) (*AddOrganizationSSODomainResponse, error)
+	// VerifyOrganizationSSODomain checks the DNS TXT record that proves the organization owns an SSO domain.
+	// Superusers can verify a domain without the DNS record.
+	VerifyOrganizationSSODomain(ctx context.Context, in *VerifyOrganizationSSODomainRequest, opts ...grpc.CallOption) (*VerifyOrganizationSSODomainResponse, error)
+	// RemoveOrganizationSSODomain removes an SSO domain of an organization
+	RemoveOrganizationSSODomain(ctx context.Context, in *RemoveOrganizationSSODomainRequest, opts ...grpc.CallOption) (*RemoveOrganizationSSODomainResponse, error)
 	// GetUser returns user by email
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	// GetUsersByEmail returns users by email
@@ -178,6 +234,11 @@ type AdminServiceClient interface {
 	GetDeploymentCredentials(ctx context.Context, in *GetDeploymentCredentialsRequest, opts ...grpc.CallOption) (*GetDeploymentCredentialsResponse, error)
 	// GetIFrame returns the iframe URL for the given project
 	GetIFrame(ctx context.Context, in *GetIFrameRequest, opts ...grpc.CallOption) (*GetIFrameResponse, error)
+	// IssueEmbedToken issues a runtime access token for embedding specific resources of a project.
+	// The token can only access the given resources, and the given filters are applied to all queries against their metrics views.
+	IssueEmbedToken(ctx context.Context, in *IssueEmbedTokenRequest, opts ...grpc.CallOption) (*IssueEmbedTokenResponse, error)
+	// RevokeEmbedToken revokes an embed token before it expires
+	RevokeEmbedToken(ctx context.Context, in *RevokeEmbedTokenRequest, opts ...grpc.CallOption) (*RevokeEmbedTokenResponse, error)
 	// SetSuperuser adds/remove a superuser
 	SetSuperuser(ctx context.Context, in *SetSuperuserRequest, opts ...grpc.CallOption) (*SetSuperuserResponse, error)
 	// SudoGetResource returns details about a resource by ID lookup
@@ -446,6 +507,24 @@ func (c *adminServiceClient) SetOrganizationMemberRole(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *adminServiceClient) GetUserAttributes(ctx context.Context, in *GetUserAttributesRequest, opts ...grpc.CallOption) (*GetUserAttributesResponse, error) {
+	out := new(GetUserAttributesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetUserAttributes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetUserAttributes(ctx context.Context, in *SetUserAttributesRequest, opts ...grpc.CallOption) (*SetUserAttributesResponse, error) {
+	out := new(SetUserAttributesResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetUserAttributes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error) {
 	out := new(ListProjectMembersResponse)
 	err := c.cc.Invoke(ctx, AdminService_ListProjectMembers_FullMethodName, in, out, opts...)
@@ -491,6 +570,87 @@ func (c *adminServiceClient) SetProjectMemberRole(ctx context.Context, in *SetPr
 	return out, nil
 }
 
+func (c *adminServiceClient) AddProjectMemberUsergroup(ctx context.Context, in *AddProjectMemberUsergroupRequest, opts ...grpc.CallOption) (*AddProjectMemberUsergroupResponse, error) {
+	out := new(AddProjectMemberUsergroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddProjectMemberUsergroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateUsergroup(ctx context.Context, in *CreateUsergroupRequest, opts ...grpc.CallOption) (*CreateUsergroupResponse, error) {
+	out := new(CreateUsergroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateUsergroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListUsergroups(ctx context.Context, in *ListUsergroupsRequest, opts ...grpc.CallOption) (*ListUsergroupsResponse, error) {
+	out := new(ListUsergroupsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListUsergroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddUsergroupMember(ctx context.Context, in *AddUsergroupMemberRequest, opts ...grpc.CallOption) (*AddUsergroupMemberResponse, error) {
+	out := new(AddUsergroupMemberResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddUsergroupMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveUsergroupMember(ctx context.Context, in *RemoveUsergroupMemberRequest, opts ...grpc.CallOption) (*RemoveUsergroupMemberResponse, error) {
+	out := new(RemoveUsergroupMemberResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveUsergroupMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListProjectRoles(ctx context.Context, in *ListProjectRolesRequest, opts ...grpc.CallOption) (*ListProjectRolesResponse, error) {
+	out := new(ListProjectRolesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListProjectRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateProjectRole(ctx context.Context, in *CreateProjectRoleRequest, opts ...grpc.CallOption) (*CreateProjectRoleResponse, error) {
+	out := new(CreateProjectRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateProjectRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteProjectRole(ctx context.Context, in *DeleteProjectRoleRequest, opts ...grpc.CallOption) (*DeleteProjectRoleResponse, error) {
+	out := new(DeleteProjectRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteProjectRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error) {
 	out := new(GetCurrentUserResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetCurrentUser_FullMethodName, in, out, opts...)
@@ -563,6 +723,60 @@ func (c *adminServiceClient) ListWhitelistedDomains(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *adminServiceClient) GetOrganizationSSO(ctx context.Context, in *GetOrganizationSSORequest, opts ...grpc.CallOption) (*GetOrganizationSSOResponse, error) {
+	out := new(GetOrganizationSSOResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetOrganizationSSO_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetOrganizationSSO(ctx context.Context, in *SetOrganizationSSORequest, opts ...grpc.CallOption) (*SetOrganizationSSOResponse, error) {
+	out := new(SetOrganizationSSOResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetOrganizationSSO_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveOrganizationSSO(ctx context.Context, in *RemoveOrganizationSSORequest, opts ...grpc.CallOption) (*RemoveOrganizationSSOResponse, error) {
+	out := new(RemoveOrganizationSSOResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveOrganizationSSO_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddOrganizationSSODomain(ctx context.Context, in *AddOrganizationSSODomainRequest, opts ...grpc.CallOption) (*AddOrganizationSSODomainResponse, error) {
+	out := new(AddOrganizationSSODomainResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddOrganizationSSODomain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) VerifyOrganizationSSODomain(ctx context.Context, in *VerifyOrganizationSSODomainRequest, opts ...grpc.CallOption) (*VerifyOrganizationSSODomainResponse, error) {
+	out := new(VerifyOrganizationSSODomainResponse)
+	err := c.cc.Invoke(ctx, AdminService_VerifyOrganizationSSODomain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveOrganizationSSODomain(ctx context.Context, in *RemoveOrganizationSSODomainRequest, opts ...grpc.CallOption) (*RemoveOrganizationSSODomainResponse, error) {
+	out := new(RemoveOrganizationSSODomainResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveOrganizationSSODomain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
 	out := new(GetUserResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetUser_FullMethodName, in, out, opts...)
@@ -617,6 +831,24 @@ func (c *adminServiceClient) GetIFrame(ctx context.Context, in *GetIFrameRequest
 	return out, nil
 }
 
+func (c *adminServiceClient) IssueEmbedToken(ctx context.Context, in *IssueEmbedTokenRequest, opts ...grpc.CallOption) (*IssueEmbedTokenResponse, error) {
+	out := new(IssueEmbedTokenResponse)
+	err := c.cc.Invoke(ctx, AdminService_IssueEmbedToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeEmbedToken(ctx context.Context, in *RevokeEmbedTokenRequest, opts ...grpc.CallOption) (*RevokeEmbedTokenResponse, error) {
+	out := new(RevokeEmbedTokenResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevokeEmbedToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) SetSuperuser(ctx context.Context, in *SetSuperuserRequest, opts ...grpc.CallOption) (*SetSuperuserResponse, error) {
 	out := new(SetSuperuserResponse)
 	err := c.cc.Invoke(ctx, AdminService_SetSuperuser_FullMethodName, in, out, opts...)
@@ -901,6 +1133,11 @@ type AdminServiceServer interface {
 	LeaveOrganization(context.Context, *LeaveOrganizationRequest) (*LeaveOrganizationResponse, error)
 	// SetOrganizationMemberRole sets the role for the member
 	SetOrganizationMemberRole(context.Context, *SetOrganizationMemberRoleRequest) (*SetOrganizationMemberRoleResponse, error)
+	// GetUserAttributes returns the custom attributes of a user in the organization
+	GetUserAttributes(context.Context, *GetUserAttributesRequest) (*GetUserAttributesResponse, error)
+	// SetUserAttributes replaces the custom attributes of a user in the organization.
+	// The attributes are added to the JWT attributes of the user for the organization's deployments.
+	SetUserAttributes(context.Context, *SetUserAttributesRequest) (*SetUserAttributesResponse, error)
 	// ListProjectMembers lists all the project members
 	ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error)
 	// ListProjectInvites lists all the project invites
@@ -911,6 +1148,24 @@ type AdminServiceServer interface {
 	RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*RemoveProjectMemberResponse, error)
 	// SetProjectMemberRole sets the role for the member
 	SetProjectMemberRole(context.Context, *SetProjectMemberRoleRequest) (*SetProjectMemberRoleResponse, error)
+	// AddProjectMemberUsergroup grants a user group a role on the project
+	AddProjectMemberUsergroup(context.Context, *AddProjectMemberUsergroupRequest) (*AddProjectMemberUsergroupResponse, error)
+	// CreateUsergroup creates a user group in the organization
+	CreateUsergroup(context.Context, *CreateUsergroupRequest) (*CreateUsergroupResponse, error)
+	// ListUsergroups lists the user groups in the organization
+	ListUsergroups(context.Context, *ListUsergroupsRequest) (*ListUsergroupsResponse, error)
+	// AddUsergroupMember adds an org member to the user group
+	AddUsergroupMember(context.Context, *AddUsergroupMemberRequest) (*AddUsergroupMemberResponse, error)
+	// RemoveUsergroupMember removes a member from the user group
+	RemoveUsergroupMember(context.Context, *RemoveUsergroupMemberRequest) (*RemoveUsergroupMemberResponse, error)
+	// ListProjectRoles lists the built-in and custom project roles available in the organization
+	ListProjectRoles(context.Context, *ListProjectRolesRequest) (*ListProjectRolesResponse, error)
+	// CreateProjectRole creates a custom project role in the organization
+	CreateProjectRole(context.Context, *CreateProjectRoleRequest) (*CreateProjectRoleResponse, error)
+	// DeleteProjectRole deletes a custom project role from the organization
+	DeleteProjectRole(context.Context, *DeleteProjectRoleRequest) (*DeleteProjectRoleResponse, error)
+	// ListAuditEvents lists the changes made in the organization, newest first
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	// GetCurrentUser returns the currently authenticated user (if any)
 	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error)
 	// IssueRepresentativeAuthToken returns the temporary token for given email
@@ -928,6 +1183,20 @@ type AdminServiceServer interface {
 	RemoveWhitelistedDomain(context.Context, *RemoveWhitelistedDomainRequest) (*RemoveWhitelistedDomainResponse, error)
 	// ListWhitelistedDomains lists all the whitelisted domains for the organization
 	ListWhitelistedDomains(context.Context, *ListWhitelistedDomainsRequest) (*ListWhitelistedDomainsResponse, error)
+	// GetOrganizationSSO returns the custom SSO provider of an organization
+	GetOrganizationSSO(context.Context, *GetOrganizationSSORequest) (*GetOrganizationSSOResponse, error)
+	// SetOrganizationSSO sets a custom OIDC provider for logins by users in the organization's verified SSO domains
+	SetOrganizationSSO(context.Context, *SetOrganizationSSORequest) (*SetOrganizationSSOResponse, error)
+	// RemoveOrganizationSSO removes the custom SSO provider of an organization
+	RemoveOrganizationSSO(context.Context, *RemoveOrganizationSSORequest) (*RemoveOrganizationSSOResponse, error)
+	// AddOrganizationSSODomain adds an email domain whose users must log in through the organization's SSO provider.
+	// The domain is not used for SSO until it has been verified with VerifyOrganizationSSODomain.
+	AddOrganizationSSODomain(context.Context, *AddOrganizationSSODomainRequest) (*AddOrganizationSSODomainResponse, error)
+	// VerifyOrganizationSSODomain checks the DNS TXT record that proves the organization owns an SSO domain.
+	// Superusers can verify a domain without the DNS record.
+	VerifyOrganizationSSODomain(context.Context, *VerifyOrganizationSSODomainRequest) (*VerifyOrganizationSSODomainResponse, error)
+	// RemoveOrganizationSSODomain removes an SSO domain of an organization
+	RemoveOrganizationSSODomain(context.Context, *RemoveOrganizationSSODomainRequest) (*RemoveOrganizationSSODomainResponse, error)
 	// GetUser returns user by email
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	// GetUsersByEmail returns users by email
@@ -940,6 +1209,11 @@ type AdminServiceServer interface {
 	GetDeploymentCredentials(context.Context, *GetDeploymentCredentialsRequest) (*GetDeploymentCredentialsResponse, error)
 	// GetIFrame returns the iframe URL for the given project
 	GetIFrame(context.Context, *GetIFrameRequest) (*GetIFrameResponse, error)
+	// IssueEmbedToken issues a runtime access token for embedding specific resources of a project.
+	// The token can only access the given resources, and the given filters are applied to all queries against their metrics views.
+	IssueEmbedToken(context.Context, *IssueEmbedTokenRequest) (*IssueEmbedTokenResponse, error)
+	// RevokeEmbedToken revokes an embed token before it expires
+	RevokeEmbedToken(context.Context, *RevokeEmbedTokenRequest) (*RevokeEmbedTokenResponse, error)
 	// SetSuperuser adds/remove a superuser
 	SetSuperuser(context.Context, *SetSuperuserRequest) (*SetSuperuserResponse, error)
 	// SudoGetResource returns details about a resource by ID lookup
@@ -1067,6 +1341,12 @@ func (UnimplementedAdminServiceServer) LeaveOrganization(context.Context, *Leave
 func (UnimplementedAdminServiceServer) SetOrganizationMemberRole(context.Context, *SetOrganizationMemberRoleRequest) (*SetOrganizationMemberRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationMemberRole not implemented")
 }
+func (UnimplementedAdminServiceServer) GetUserAttributes(context.Context, *GetUserAttributesRequest) (*GetUserAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAttributes not implemented")
+}
+func (UnimplementedAdminServiceServer) SetUserAttributes(context.Context, *SetUserAttributesRequest) (*SetUserAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserAttributes not implemented")
+}
 func (UnimplementedAdminServiceServer) ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjectMembers not implemented")
 }
@@ -1082,6 +1362,33 @@ func (UnimplementedAdminServiceServer) RemoveProjectMember(context.Context, *Rem
 func (UnimplementedAdminServiceServer) SetProjectMemberRole(context.Context, *SetProjectMemberRoleRequest) (*SetProjectMemberRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetProjectMemberRole not implemented")
 }
+func (UnimplementedAdminServiceServer) AddProjectMemberUsergroup(context.Context, *AddProjectMemberUsergroupRequest) (*AddProjectMemberUsergroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProjectMemberUsergroup not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateUsergroup(context.Context, *CreateUsergroupRequest) (*CreateUsergroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUsergroup not implemented")
+}
+func (UnimplementedAdminServiceServer) ListUsergroups(context.Context, *ListUsergroupsRequest) (*ListUsergroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsergroups not implemented")
+}
+func (UnimplementedAdminServiceServer) AddUsergroupMember(context.Context, *AddUsergroupMemberRequest) (*AddUsergroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUsergroupMember not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveUsergroupMember(context.Context, *RemoveUsergroupMemberRequest) (*RemoveUsergroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUsergroupMember not implemented")
+}
+func (UnimplementedAdminServiceServer) ListProjectRoles(context.Context, *ListProjectRolesRequest) (*ListProjectRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectRoles not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateProjectRole(context.Context, *CreateProjectRoleRequest) (*CreateProjectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProjectRole not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteProjectRole(context.Context, *DeleteProjectRoleRequest) (*DeleteProjectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectRole not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedAdminServiceServer) GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentUser not implemented")
 }
@@ -1106,6 +1413,24 @@ func (UnimplementedAdminServiceServer) RemoveWhitelistedDomain(context.Context,
 func (UnimplementedAdminServiceServer) ListWhitelistedDomains(context.Context, *ListWhitelistedDomainsRequest) (*ListWhitelistedDomainsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWhitelistedDomains not implemented")
 }
+func (UnimplementedAdminServiceServer) GetOrganizationSSO(context.Context, *GetOrganizationSSORequest) (*GetOrganizationSSOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationSSO not implemented")
+}
+func (UnimplementedAdminServiceServer) SetOrganizationSSO(context.Context, *SetOrganizationSSORequest) (*SetOrganizationSSOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationSSO not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveOrganizationSSO(context.Context, *RemoveOrganizationSSORequest) (*RemoveOrganizationSSOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationSSO not implemented")
+}
+func (UnimplementedAdminServiceServer) AddOrganizationSSODomain(context.Context, *AddOrganizationSSODomainRequest) (*AddOrganizationSSODomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrganizationSSODomain not implemented")
+}
+func (UnimplementedAdminServiceServer) VerifyOrganizationSSODomain(context.Context, *VerifyOrganizationSSODomainRequest) (*VerifyOrganizationSSODomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyOrganizationSSODomain not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveOrganizationSSODomain(context.Context, *RemoveOrganizationSSODomainRequest) (*RemoveOrganizationSSODomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationSSODomain not implemented")
+}
 func (UnimplementedAdminServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
@@ -1124,6 +1449,12 @@ func (UnimplementedAdminServiceServer) GetDeploymentCredentials(context.Context,
 func (UnimplementedAdminServiceServer) GetIFrame(context.Context, *GetIFrameRequest) (*GetIFrameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIFrame not implemented")
 }
+func (UnimplementedAdminServiceServer) IssueEmbedToken(context.Context, *IssueEmbedTokenRequest) (*IssueEmbedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueEmbedToken not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokeEmbedToken(context.Context, *RevokeEmbedTokenRequest) (*RevokeEmbedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeEmbedToken not implemented")
+}
 func (UnimplementedAdminServiceServer) SetSuperuser(context.Context, *SetSuperuserRequest) (*SetSuperuserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSuperuser not implemented")
 }
@@ -1629,6 +1960,42 @@ func _AdminService_SetOrganizationMemberRole_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetUserAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetUserAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetUserAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetUserAttributes(ctx, req.(*GetUserAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetUserAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetUserAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetUserAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetUserAttributes(ctx, req.(*SetUserAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListProjectMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectMembersRequest)
 	if err := dec(in); err != nil {
@@ -1719,6 +2086,168 @@ func _AdminService_SetProjectMemberRole_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_AddProjectMemberUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProjectMemberUsergroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddProjectMemberUsergroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddProjectMemberUsergroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddProjectMemberUsergroup(ctx, req.(*AddProjectMemberUsergroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUsergroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateUsergroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateUsergroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateUsergroup(ctx, req.(*CreateUsergroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListUsergroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsergroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListUsergroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListUsergroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListUsergroups(ctx, req.(*ListUsergroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddUsergroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUsergroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddUsergroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddUsergroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddUsergroupMember(ctx, req.(*AddUsergroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveUsergroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUsergroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveUsergroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveUsergroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveUsergroupMember(ctx, req.(*RemoveUsergroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListProjectRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProjectRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProjectRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProjectRoles(ctx, req.(*ListProjectRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateProjectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateProjectRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateProjectRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateProjectRole(ctx, req.(*CreateProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteProjectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteProjectRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteProjectRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteProjectRole(ctx, req.(*DeleteProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCurrentUserRequest)
 	if err := dec(in); err != nil {
@@ -1863,6 +2392,114 @@ func _AdminService_ListWhitelistedDomains_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetOrganizationSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationSSORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetOrganizationSSO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetOrganizationSSO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetOrganizationSSO(ctx, req.(*GetOrganizationSSORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetOrganizationSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrganizationSSORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetOrganizationSSO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetOrganizationSSO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetOrganizationSSO(ctx, req.(*SetOrganizationSSORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveOrganizationSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationSSORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveOrganizationSSO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveOrganizationSSO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveOrganizationSSO(ctx, req.(*RemoveOrganizationSSORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddOrganizationSSODomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationSSODomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddOrganizationSSODomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddOrganizationSSODomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddOrganizationSSODomain(ctx, req.(*AddOrganizationSSODomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_VerifyOrganizationSSODomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyOrganizationSSODomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).VerifyOrganizationSSODomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_VerifyOrganizationSSODomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).VerifyOrganizationSSODomain(ctx, req.(*VerifyOrganizationSSODomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveOrganizationSSODomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationSSODomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveOrganizationSSODomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveOrganizationSSODomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveOrganizationSSODomain(ctx, req.(*RemoveOrganizationSSODomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserRequest)
 	if err := dec(in); err != nil {
@@ -1971,6 +2608,42 @@ func _AdminService_GetIFrame_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_IssueEmbedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueEmbedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).IssueEmbedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_IssueEmbedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).IssueEmbedToken(ctx, req.(*IssueEmbedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeEmbedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeEmbedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeEmbedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevokeEmbedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeEmbedToken(ctx, req.(*RevokeEmbedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_SetSuperuser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetSuperuserRequest)
 	if err := dec(in); err != nil {
@@ -2538,6 +3211,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetOrganizationMemberRole",
 			Handler:    _AdminService_SetOrganizationMemberRole_Handler,
 		},
+		{
+			MethodName: "GetUserAttributes",
+			Handler:    _AdminService_GetUserAttributes_Handler,
+		},
+		{
+			MethodName: "SetUserAttributes",
+			Handler:    _AdminService_SetUserAttributes_Handler,
+		},
 		{
 			MethodName: "ListProjectMembers",
 			Handler:    _AdminService_ListProjectMembers_Handler,
@@ -2558,6 +3239,42 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetProjectMemberRole",
 			Handler:    _AdminService_SetProjectMemberRole_Handler,
 		},
+		{
+			MethodName: "AddProjectMemberUsergroup",
+			Handler:    _AdminService_AddProjectMemberUsergroup_Handler,
+		},
+		{
+			MethodName: "CreateUsergroup",
+			Handler:    _AdminService_CreateUsergroup_Handler,
+		},
+		{
+			MethodName: "ListUsergroups",
+			Handler:    _AdminService_ListUsergroups_Handler,
+		},
+		{
+			MethodName: "AddUsergroupMember",
+			Handler:    _AdminService_AddUsergroupMember_Handler,
+		},
+		{
+			MethodName: "RemoveUsergroupMember",
+			Handler:    _AdminService_RemoveUsergroupMember_Handler,
+		},
+		{
+			MethodName: "ListProjectRoles",
+			Handler:    _AdminService_ListProjectRoles_Handler,
+		},
+		{
+			MethodName: "CreateProjectRole",
+			Handler:    _AdminService_CreateProjectRole_Handler,
+		},
+		{
+			MethodName: "DeleteProjectRole",
+			Handler:    _AdminService_DeleteProjectRole_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AdminService_ListAuditEvents_Handler,
+		},
 		{
 			MethodName: "GetCurrentUser",
 			Handler:    _AdminService_GetCurrentUser_Handler,
@@ -2590,6 +3307,30 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListWhitelistedDomains",
 			Handler:    _AdminService_ListWhitelistedDomains_Handler,
 		},
+		{
+			MethodName: "GetOrganizationSSO",
+			Handler:    _AdminService_GetOrganizationSSO_Handler,
+		},
+		{
+			MethodName: "SetOrganizationSSO",
+			Handler:    _AdminService_SetOrganizationSSO_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationSSO",
+			Handler:    _AdminService_RemoveOrganizationSSO_Handler,
+		},
+		{
+			MethodName: "AddOrganizationSSODomain",
+			Handler:    _AdminService_AddOrganizationSSODomain_Handler,
+		},
+		{
+			MethodName: "VerifyOrganizationSSODomain",
+			Handler:    _AdminService_VerifyOrganizationSSODomain_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationSSODomain",
+			Handler:    _AdminService_RemoveOrganizationSSODomain_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _AdminService_GetUser_Handler,
@@ -2614,6 +3355,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIFrame",
 			Handler:    _AdminService_GetIFrame_Handler,
 		},
+		{
+			MethodName: "IssueEmbedToken",
+			Handler:    _AdminService_IssueEmbedToken_Handler,
+		},
+		{
+			MethodName: "RevokeEmbedToken",
+			Handler:    _AdminService_RevokeEmbedToken_Handler,
+		},
 		{
 			MethodName: "SetSuperuser",
 			Handler:    _AdminService_SetSuperuser_Handler,
@@ -303,6 +303,8 @@ func (m *Instance) validate(all bool) error {
 
 	// no validation rules for AdminConnector
 
+	// no validation rules for Environment
+
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
@@ -1162,6 +1164,8 @@ func (m *CreateInstanceRequest) validate(all bool) error {
 
 	// no validation rules for AdminConnector
 
+	// no validation rules for Environment
+
 	for idx, item := range m.GetConnectors() {
 		_, _ = idx, item
 
@@ -1725,6 +1729,10 @@ func (m *EditInstanceRequest) validate(all bool) error {
 		// no validation rules for AdminConnector
 	}
 
+	if m.Environment != nil {
+		// no validation rules for Environment
+	}
+
 	if m.EmbedCatalog != nil {
 		// no validation rules for EmbedCatalog
 	}
@@ -3456,6 +3464,240 @@ var _ interface {
 	ErrorName() string
 } = ExampleValidationError{}
 
+// Validate checks the field values on FormatFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FormatFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FormatFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FormatFileRequestMultiError, or nil if none found.
+func (m *FormatFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FormatFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_FormatFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := FormatFileRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := FormatFileRequestValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return FormatFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FormatFileRequestMultiError is an error wrapping multiple validation errors
+// returned by FormatFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FormatFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FormatFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FormatFileRequestMultiError) AllErrors() []error { return m }
+
+// FormatFileRequestValidationError is the validation error returned by
+// FormatFileRequest.Validate if the designated constraints aren't met.
+type FormatFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FormatFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FormatFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FormatFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FormatFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FormatFileRequestValidationError) ErrorName() string {
+	return "FormatFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FormatFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFormatFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FormatFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FormatFileRequestValidationError{}
+
+var _FormatFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on FormatFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FormatFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FormatFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FormatFileResponseMultiError, or nil if none found.
+func (m *FormatFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FormatFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Blob
+
+	// no validation rules for Changed
+
+	if len(errors) > 0 {
+		return FormatFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FormatFileResponseMultiError is an error wrapping multiple validation errors
+// returned by FormatFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type FormatFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FormatFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FormatFileResponseMultiError) AllErrors() []error { return m }
+
+// FormatFileResponseValidationError is the validation error returned by
+// FormatFileResponse.Validate if the designated constraints aren't met.
+type FormatFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FormatFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FormatFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FormatFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FormatFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FormatFileResponseValidationError) ErrorName() string {
+	return "FormatFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FormatFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFormatFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FormatFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FormatFileResponseValidationError{}
+
 // Validate checks the field values on ListExamplesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -4527,6 +4769,546 @@ var _ interface {
 	ErrorName() string
 } = GetLogsResponseValidationError{}
 
+// Validate checks the field values on ListQueryHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueryHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueryHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueryHistoryRequestMultiError, or nil if none found.
+func (m *ListQueryHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueryHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for MinDurationMs
+
+	if m.GetLimit() < -1 {
+		err := ListQueryHistoryRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListQueryHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueryHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by ListQueryHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListQueryHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueryHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueryHistoryRequestMultiError) AllErrors() []error { return m }
+
+// ListQueryHistoryRequestValidationError is the validation error returned by
+// ListQueryHistoryRequest.Validate if the designated constraints aren't met.
+type ListQueryHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueryHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueryHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueryHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueryHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueryHistoryRequestValidationError) ErrorName() string {
+	return "ListQueryHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueryHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueryHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueryHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueryHistoryRequestValidationError{}
+
+// Validate checks the field values on ListQueryHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueryHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueryHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueryHistoryResponseMultiError, or nil if none found.
+func (m *ListQueryHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueryHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQueryHistoryResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQueryHistoryResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQueryHistoryResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListQueryHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueryHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by ListQueryHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListQueryHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueryHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueryHistoryResponseMultiError) AllErrors() []error { return m }
+
+// ListQueryHistoryResponseValidationError is the validation error returned by
+// ListQueryHistoryResponse.Validate if the designated constraints aren't met.
+type ListQueryHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueryHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueryHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueryHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueryHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueryHistoryResponseValidationError) ErrorName() string {
+	return "ListQueryHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueryHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueryHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueryHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueryHistoryResponseValidationError{}
+
+// Validate checks the field values on QueryHistoryEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueryHistoryEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryHistoryEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryHistoryEntryMultiError, or nil if none found.
+func (m *QueryHistoryEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryHistoryEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryHistoryEntryValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryHistoryEntryValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryHistoryEntryValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for QueryType
+
+	// no validation rules for MetricsView
+
+	for idx, item := range m.GetStatements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryHistoryEntryValidationError{
+						field:  fmt.Sprintf("Statements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryHistoryEntryValidationError{
+						field:  fmt.Sprintf("Statements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryHistoryEntryValidationError{
+					field:  fmt.Sprintf("Statements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DurationMs
+
+	// no validation rules for Rows
+
+	// no validation rules for CacheHit
+
+	// no validation rules for Priority
+
+	// no validation rules for Subject
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return QueryHistoryEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryHistoryEntryMultiError is an error wrapping multiple validation errors
+// returned by QueryHistoryEntry.ValidateAll() if the designated constraints
+// aren't met.
+type QueryHistoryEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryHistoryEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryHistoryEntryMultiError) AllErrors() []error { return m }
+
+// QueryHistoryEntryValidationError is the validation error returned by
+// QueryHistoryEntry.Validate if the designated constraints aren't met.
+type QueryHistoryEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryHistoryEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryHistoryEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryHistoryEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryHistoryEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryHistoryEntryValidationError) ErrorName() string {
+	return "QueryHistoryEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryHistoryEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryHistoryEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryHistoryEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryHistoryEntryValidationError{}
+
+// Validate checks the field values on QueryHistoryStatement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryHistoryStatement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryHistoryStatement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryHistoryStatementMultiError, or nil if none found.
+func (m *QueryHistoryStatement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryHistoryStatement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sql
+
+	// no validation rules for DurationMs
+
+	if len(errors) > 0 {
+		return QueryHistoryStatementMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryHistoryStatementMultiError is an error wrapping multiple validation
+// errors returned by QueryHistoryStatement.ValidateAll() if the designated
+// constraints aren't met.
+type QueryHistoryStatementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryHistoryStatementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryHistoryStatementMultiError) AllErrors() []error { return m }
+
+// QueryHistoryStatementValidationError is the validation error returned by
+// QueryHistoryStatement.Validate if the designated constraints aren't met.
+type QueryHistoryStatementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryHistoryStatementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryHistoryStatementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryHistoryStatementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryHistoryStatementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryHistoryStatementValidationError) ErrorName() string {
+	return "QueryHistoryStatementValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryHistoryStatementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryHistoryStatement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryHistoryStatementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryHistoryStatementValidationError{}
+
 // Validate checks the field values on WatchLogsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
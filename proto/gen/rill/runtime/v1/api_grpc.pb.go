@@ -31,11 +31,13 @@ const (
 	RuntimeService_PutFile_FullMethodName                = "/rill.runtime.v1.RuntimeService/PutFile"
 	RuntimeService_DeleteFile_FullMethodName             = "/rill.runtime.v1.RuntimeService/DeleteFile"
 	RuntimeService_RenameFile_FullMethodName             = "/rill.runtime.v1.RuntimeService/RenameFile"
+	RuntimeService_FormatFile_FullMethodName             = "/rill.runtime.v1.RuntimeService/FormatFile"
 	RuntimeService_ListExamples_FullMethodName           = "/rill.runtime.v1.RuntimeService/ListExamples"
 	RuntimeService_UnpackExample_FullMethodName          = "/rill.runtime.v1.RuntimeService/UnpackExample"
 	RuntimeService_UnpackEmpty_FullMethodName            = "/rill.runtime.v1.RuntimeService/UnpackEmpty"
 	RuntimeService_GetLogs_FullMethodName                = "/rill.runtime.v1.RuntimeService/GetLogs"
 	RuntimeService_WatchLogs_FullMethodName              = "/rill.runtime.v1.RuntimeService/WatchLogs"
+	RuntimeService_ListQueryHistory_FullMethodName       = "/rill.runtime.v1.RuntimeService/ListQueryHistory"
 	RuntimeService_ListResources_FullMethodName          = "/rill.runtime.v1.RuntimeService/ListResources"
 	RuntimeService_WatchResources_FullMethodName         = "/rill.runtime.v1.RuntimeService/WatchResources"
 	RuntimeService_GetResource_FullMethodName            = "/rill.runtime.v1.RuntimeService/GetResource"
@@ -81,6 +83,9 @@ type RuntimeServiceClient interface {
 	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
 	// RenameFile renames a file in a repo
 	RenameFile(ctx context.Context, in *RenameFileRequest, opts ...grpc.CallOption) (*RenameFileResponse, error)
+	// FormatFile normalizes the formatting of a SQL file in a repo.
+	// Unless dry_run is set, the formatted contents are written back to the file.
+	FormatFile(ctx context.Context, in *FormatFileRequest, opts ...grpc.CallOption) (*FormatFileResponse, error)
 	// ListExamples lists all the examples embedded into binary
 	ListExamples(ctx context.Context, in *ListExamplesRequest, opts ...grpc.CallOption) (*ListExamplesResponse, error)
 	// UnpackExample unpacks an example project
@@ -91,6 +96,9 @@ type RuntimeServiceClient interface {
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
 	// WatchLogs streams new logs emitted from a controller
 	WatchLogs(ctx context.Context, in *WatchLogsRequest, opts ...grpc.CallOption) (RuntimeService_WatchLogsClient, error)
+	// ListQueryHistory returns recent queries executed against an instance, newest first.
+	// Callers that can't manage instances only see their own queries, and statement args are redacted.
+	ListQueryHistory(ctx context.Context, in *ListQueryHistoryRequest, opts ...grpc.CallOption) (*ListQueryHistoryResponse, error)
 	// ListResources lists the resources stored in the catalog
 	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
 	// WatchResources streams updates to catalog resources (including creation and deletion events)
@@ -266,6 +274,15 @@ func (c *runtimeServiceClient) RenameFile(ctx context.Context, in *RenameFileReq
 	return out, nil
 }
 
+func (c *runtimeServiceClient) FormatFile(ctx context.Context, in *FormatFileRequest, opts ...grpc.CallOption) (*FormatFileResponse, error) {
+	out := new(FormatFileResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_FormatFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) ListExamples(ctx context.Context, in *ListExamplesRequest, opts ...grpc.CallOption) (*ListExamplesResponse, error) {
 	out := new(ListExamplesResponse)
 	err := c.cc.Invoke(ctx, RuntimeService_ListExamples_FullMethodName, in, out, opts...)
@@ -334,6 +351,15 @@ func (x *runtimeServiceWatchLogsClient) Recv() (*WatchLogsResponse, error) {
 	return m, nil
 }
 
+func (c *runtimeServiceClient) ListQueryHistory(ctx context.Context, in *ListQueryHistoryRequest, opts ...grpc.CallOption) (*ListQueryHistoryResponse, error) {
+	out := new(ListQueryHistoryResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ListQueryHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
 	out := new(ListResourcesResponse)
 	err := c.cc.Invoke(ctx, RuntimeService_ListResources_FullMethodName, in, out, opts...)
@@ -512,6 +538,9 @@ type RuntimeServiceServer interface {
 	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
 	// RenameFile renames a file in a repo
 	RenameFile(context.Context, *RenameFileRequest) (*RenameFileResponse, error)
+	// FormatFile normalizes the formatting of a SQL file in a repo.
+	// Unless dry_run is set, the formatted contents are written back to the file.
+	FormatFile(context.Context, *FormatFileRequest) (*FormatFileResponse, error)
 	// ListExamples lists all the examples embedded into binary
 	ListExamples(context.Context, *ListExamplesRequest) (*ListExamplesResponse, error)
 	// UnpackExample unpacks an example project
@@ -522,6 +551,9 @@ type RuntimeServiceServer interface {
 	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
 	// WatchLogs streams new logs emitted from a controller
 	WatchLogs(*WatchLogsRequest, RuntimeService_WatchLogsServer) error
+	// ListQueryHistory returns recent queries executed against an instance, newest first.
+	// Callers that can't manage instances only see their own queries, and statement args are redacted.
+	ListQueryHistory(context.Context, *ListQueryHistoryRequest) (*ListQueryHistoryResponse, error)
 	// ListResources lists the resources stored in the catalog
 	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
 	// WatchResources streams updates to catalog resources (including creation and deletion events)
@@ -599,6 +631,9 @@ func (UnimplementedRuntimeServiceServer) DeleteFile(context.Context, *DeleteFile
 func (UnimplementedRuntimeServiceServer) RenameFile(context.Context, *RenameFileRequest) (*RenameFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameFile not implemented")
 }
+func (UnimplementedRuntimeServiceServer) FormatFile(context.Context, *FormatFileRequest) (*FormatFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FormatFile not implemented")
+}
 func (UnimplementedRuntimeServiceServer) ListExamples(context.Context, *ListExamplesRequest) (*ListExamplesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListExamples not implemented")
 }
@@ -614,6 +649,9 @@ func (UnimplementedRuntimeServiceServer) GetLogs(context.Context, *GetLogsReques
 func (UnimplementedRuntimeServiceServer) WatchLogs(*WatchLogsRequest, RuntimeService_WatchLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchLogs not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ListQueryHistory(context.Context, *ListQueryHistoryRequest) (*ListQueryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueryHistory not implemented")
+}
 func (UnimplementedRuntimeServiceServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
 }
@@ -888,6 +926,24 @@ func _RuntimeService_RenameFile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_FormatFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).FormatFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_FormatFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).FormatFile(ctx, req.(*FormatFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_ListExamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListExamplesRequest)
 	if err := dec(in); err != nil {
@@ -981,6 +1037,24 @@ func (x *runtimeServiceWatchLogsServer) Send(m *WatchLogsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _RuntimeService_ListQueryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListQueryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ListQueryHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListQueryHistory(ctx, req.(*ListQueryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListResourcesRequest)
 	if err := dec(in); err != nil {
@@ -1287,6 +1361,10 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenameFile",
 			Handler:    _RuntimeService_RenameFile_Handler,
 		},
+		{
+			MethodName: "FormatFile",
+			Handler:    _RuntimeService_FormatFile_Handler,
+		},
 		{
 			MethodName: "ListExamples",
 			Handler:    _RuntimeService_ListExamples_Handler,
@@ -1303,6 +1381,10 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLogs",
 			Handler:    _RuntimeService_GetLogs_Handler,
 		},
+		{
+			MethodName: "ListQueryHistory",
+			Handler:    _RuntimeService_ListQueryHistory_Handler,
+		},
 		{
 			MethodName: "ListResources",
 			Handler:    _RuntimeService_ListResources_Handler,
@@ -634,6 +634,394 @@ var _ interface {
 	ErrorName() string
 } = QueryBatchResponseValidationError{}
 
+// Validate checks the field values on ExplainQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExplainQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExplainQueryRequestMultiError, or nil if none found.
+func (m *ExplainQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if m.GetQuery() == nil {
+		err := ExplainQueryRequestValidationError{
+			field:  "Query",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplainQueryRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplainQueryRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainQueryRequestValidationError{
+				field:  "Query",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Analyze
+
+	if len(errors) > 0 {
+		return ExplainQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplainQueryRequestMultiError is an error wrapping multiple validation
+// errors returned by ExplainQueryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExplainQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainQueryRequestMultiError) AllErrors() []error { return m }
+
+// ExplainQueryRequestValidationError is the validation error returned by
+// ExplainQueryRequest.Validate if the designated constraints aren't met.
+type ExplainQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainQueryRequestValidationError) ErrorName() string {
+	return "ExplainQueryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainQueryRequestValidationError{}
+
+// Validate checks the field values on ExplainQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExplainQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExplainQueryResponseMultiError, or nil if none found.
+func (m *ExplainQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStatements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExplainQueryResponseValidationError{
+						field:  fmt.Sprintf("Statements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExplainQueryResponseValidationError{
+						field:  fmt.Sprintf("Statements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExplainQueryResponseValidationError{
+					field:  fmt.Sprintf("Statements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExplainQueryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplainQueryResponseMultiError is an error wrapping multiple validation
+// errors returned by ExplainQueryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExplainQueryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainQueryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainQueryResponseMultiError) AllErrors() []error { return m }
+
+// ExplainQueryResponseValidationError is the validation error returned by
+// ExplainQueryResponse.Validate if the designated constraints aren't met.
+type ExplainQueryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainQueryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainQueryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainQueryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainQueryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainQueryResponseValidationError) ErrorName() string {
+	return "ExplainQueryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainQueryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainQueryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainQueryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainQueryResponseValidationError{}
+
+// Validate checks the field values on ExplainedStatement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExplainedStatement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainedStatement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExplainedStatementMultiError, or nil if none found.
+func (m *ExplainedStatement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainedStatement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sql
+
+	// no validation rules for Plan
+
+	if len(errors) > 0 {
+		return ExplainedStatementMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplainedStatementMultiError is an error wrapping multiple validation errors
+// returned by ExplainedStatement.ValidateAll() if the designated constraints
+// aren't met.
+type ExplainedStatementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainedStatementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainedStatementMultiError) AllErrors() []error { return m }
+
+// ExplainedStatementValidationError is the validation error returned by
+// ExplainedStatement.Validate if the designated constraints aren't met.
+type ExplainedStatementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainedStatementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainedStatementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainedStatementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainedStatementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainedStatementValidationError) ErrorName() string {
+	return "ExplainedStatementValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainedStatementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainedStatement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainedStatementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainedStatementValidationError{}
+
 // Validate checks the field values on ExportRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2804,6 +3192,8 @@ func (m *MetricsViewAggregationRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for TimeDimension
+
 	if all {
 		switch v := interface{}(m.GetTimeStart()).(type) {
 		case interface{ ValidateAll() error }:
@@ -4299,6 +4689,8 @@ func (m *MetricsViewComparisonRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for TimeDimension
+
 	if all {
 		switch v := interface{}(m.GetWhere()).(type) {
 		case interface{ ValidateAll() error }:
@@ -5593,6 +5985,8 @@ func (m *MetricsViewTimeSeriesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for TimeDimension
+
 	if all {
 		switch v := interface{}(m.GetWhere()).(type) {
 		case interface{ ValidateAll() error }:
@@ -7394,6 +7788,8 @@ func (m *MetricsViewTimeRangeRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for TimeDimension
+
 	// no validation rules for Priority
 
 	if len(errors) > 0 {
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	QueryService_Query_FullMethodName                       = "/rill.runtime.v1.QueryService/Query"
 	QueryService_QueryBatch_FullMethodName                  = "/rill.runtime.v1.QueryService/QueryBatch"
+	QueryService_ExplainQuery_FullMethodName                = "/rill.runtime.v1.QueryService/ExplainQuery"
 	QueryService_Export_FullMethodName                      = "/rill.runtime.v1.QueryService/Export"
 	QueryService_MetricsViewAggregation_FullMethodName      = "/rill.runtime.v1.QueryService/MetricsViewAggregation"
 	QueryService_MetricsViewToplist_FullMethodName          = "/rill.runtime.v1.QueryService/MetricsViewToplist"
@@ -52,6 +53,9 @@ type QueryServiceClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	// Batch request with different queries
 	QueryBatch(ctx context.Context, in *QueryBatchRequest, opts ...grpc.CallOption) (QueryService_QueryBatchClient, error)
+	// ExplainQuery resolves a query and returns the SQL statements it executed along with the OLAP's query plan for each of them.
+	// The query is resolved without using the query cache, and each statement is executed again to analyze it.
+	ExplainQuery(ctx context.Context, in *ExplainQueryRequest, opts ...grpc.CallOption) (*ExplainQueryResponse, error)
 	// Export builds a URL to download the results of a query as a file.
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
 	// MetricsViewAggregation is a generic API for running group-by queries against a metrics view.
@@ -148,6 +152,15 @@ func (x *queryServiceQueryBatchClient) Recv() (*QueryBatchResponse, error) {
 	return m, nil
 }
 
+func (c *queryServiceClient) ExplainQuery(ctx context.Context, in *ExplainQueryRequest, opts ...grpc.CallOption) (*ExplainQueryResponse, error) {
+	out := new(ExplainQueryResponse)
+	err := c.cc.Invoke(ctx, QueryService_ExplainQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
 	out := new(ExportResponse)
 	err := c.cc.Invoke(ctx, QueryService_Export_FullMethodName, in, out, opts...)
@@ -345,6 +358,9 @@ type QueryServiceServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 	// Batch request with different queries
 	QueryBatch(*QueryBatchRequest, QueryService_QueryBatchServer) error
+	// ExplainQuery resolves a query and returns the SQL statements it executed along with the OLAP's query plan for each of them.
+	// The query is resolved without using the query cache, and each statement is executed again to analyze it.
+	ExplainQuery(context.Context, *ExplainQueryRequest) (*ExplainQueryResponse, error)
 	// Export builds a URL to download the results of a query as a file.
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
 	// MetricsViewAggregation is a generic API for running group-by queries against a metrics view.
@@ -403,6 +419,9 @@ func (UnimplementedQueryServiceServer) Query(context.Context, *QueryRequest) (*Q
 func (UnimplementedQueryServiceServer) QueryBatch(*QueryBatchRequest, QueryService_QueryBatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method QueryBatch not implemented")
 }
+func (UnimplementedQueryServiceServer) ExplainQuery(context.Context, *ExplainQueryRequest) (*ExplainQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainQuery not implemented")
+}
 func (UnimplementedQueryServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
@@ -518,6 +537,24 @@ func (x *queryServiceQueryBatchServer) Send(m *QueryBatchResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _QueryService_ExplainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ExplainQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_ExplainQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ExplainQuery(ctx, req.(*ExplainQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportRequest)
 	if err := dec(in); err != nil {
@@ -907,6 +944,10 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _QueryService_Query_Handler,
 		},
+		{
+			MethodName: "ExplainQuery",
+			Handler:    _QueryService_ExplainQuery_Handler,
+		},
 		{
 			MethodName: "Export",
 			Handler:    _QueryService_Export_Handler,
@@ -497,6 +497,47 @@ func (m *Resource) validate(all bool) error {
 			}
 		}
 
+	case *Resource_Seed:
+		if v == nil {
+			err := ResourceValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSeed()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Seed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Seed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSeed()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceValidationError{
+					field:  "Seed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -1713,6 +1754,35 @@ func (m *SourceSpec) validate(all bool) error {
 
 	// no validation rules for TimeoutSeconds
 
+	if all {
+		switch v := interface{}(m.GetSecurity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SourceSpecValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SourceSpecValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecurity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SourceSpecValidationError{
+				field:  "Security",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	// no validation rules for StageChanges
 
 	// no validation rules for StreamIngestion
@@ -1930,6 +2000,178 @@ var _ interface {
 	ErrorName() string
 } = SourceStateValidationError{}
 
+// Validate checks the field values on TableSecurity with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TableSecurity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableSecurity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TableSecurityMultiError, or
+// nil if none found.
+func (m *TableSecurity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableSecurity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Access
+
+	// no validation rules for RowFilter
+
+	for idx, item := range m.GetInclude() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableSecurityValidationError{
+						field:  fmt.Sprintf("Include[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableSecurityValidationError{
+						field:  fmt.Sprintf("Include[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableSecurityValidationError{
+					field:  fmt.Sprintf("Include[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetExclude() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableSecurityValidationError{
+						field:  fmt.Sprintf("Exclude[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableSecurityValidationError{
+						field:  fmt.Sprintf("Exclude[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableSecurityValidationError{
+					field:  fmt.Sprintf("Exclude[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TableSecurityMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableSecurityMultiError is an error wrapping multiple validation errors
+// returned by TableSecurity.ValidateAll() if the designated constraints
+// aren't met.
+type TableSecurityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableSecurityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableSecurityMultiError) AllErrors() []error { return m }
+
+// TableSecurityValidationError is the validation error returned by
+// TableSecurity.Validate if the designated constraints aren't met.
+type TableSecurityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableSecurityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableSecurityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableSecurityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableSecurityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableSecurityValidationError) ErrorName() string { return "TableSecurityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableSecurityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableSecurity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableSecurityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableSecurityValidationError{}
+
 // Validate checks the field values on ModelV2 with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2145,6 +2387,35 @@ func (m *ModelSpec) validate(all bool) error {
 
 	// no validation rules for UsesTemplating
 
+	if all {
+		switch v := interface{}(m.GetSecurity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecurity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelSpecValidationError{
+				field:  "Security",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	// no validation rules for StageChanges
 
 	// no validation rules for MaterializeDelaySeconds
@@ -2701,26 +2972,94 @@ func (m *MetricsViewSpec) validate(all bool) error {
 
 	// no validation rules for DefaultTheme
 
-	if len(errors) > 0 {
-		return MetricsViewSpecMultiError(errors)
-	}
-
-	return nil
-}
-
-// MetricsViewSpecMultiError is an error wrapping multiple validation errors
-// returned by MetricsViewSpec.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsViewSpecMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSpecMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	for idx, item := range m.GetJoins() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Joins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Joins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("Joins[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRollups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Rollups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Rollups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("Rollups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpecMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewSpec.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsViewSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
 // AllErrors returns a list of validation violation errors.
 func (m MetricsViewSpecMultiError) AllErrors() []error { return m }
@@ -2830,6 +3169,42 @@ func (m *MetricsViewState) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetRollups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewStateValidationError{
+						field:  fmt.Sprintf("Rollups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewStateValidationError{
+						field:  fmt.Sprintf("Rollups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewStateValidationError{
+					field:  fmt.Sprintf("Rollups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RollupsHash
+
 	if len(errors) > 0 {
 		return MetricsViewStateMultiError(errors)
 	}
@@ -2908,6 +3283,143 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewStateValidationError{}
 
+// Validate checks the field values on MetricsViewRollupState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewRollupState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewRollupState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewRollupStateMultiError, or nil if none found.
+func (m *MetricsViewRollupState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewRollupState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Table
+
+	// no validation rules for RowCount
+
+	if all {
+		switch v := interface{}(m.GetRefsRefreshedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewRollupStateValidationError{
+					field:  "RefsRefreshedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewRollupStateValidationError{
+					field:  "RefsRefreshedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefsRefreshedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewRollupStateValidationError{
+				field:  "RefsRefreshedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewRollupStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewRollupStateMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewRollupState.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewRollupStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewRollupStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewRollupStateMultiError) AllErrors() []error { return m }
+
+// MetricsViewRollupStateValidationError is the validation error returned by
+// MetricsViewRollupState.Validate if the designated constraints aren't met.
+type MetricsViewRollupStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewRollupStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewRollupStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewRollupStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewRollupStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewRollupStateValidationError) ErrorName() string {
+	return "MetricsViewRollupStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewRollupStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewRollupState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewRollupStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewRollupStateValidationError{}
+
 // Validate checks the field values on Migration with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -5633,47 +6145,98 @@ var _ interface {
 	ErrorName() string
 } = ThemeStateValidationError{}
 
-// Validate checks the field values on Schedule with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Schedule) Validate() error {
+// Validate checks the field values on Seed with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Seed) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Schedule with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ScheduleMultiError, or nil
-// if none found.
-func (m *Schedule) ValidateAll() error {
+// ValidateAll checks the field values on Seed with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SeedMultiError, or nil if none found.
+func (m *Seed) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Schedule) validate(all bool) error {
+func (m *Seed) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cron
-
-	// no validation rules for TickerSeconds
-
-	// no validation rules for TimeZone
-
-	if len(errors) > 0 {
-		return ScheduleMultiError(errors)
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeedValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeedValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeedValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	return nil
-}
-
-// ScheduleMultiError is an error wrapping multiple validation errors returned
-// by Schedule.ValidateAll() if the designated constraints aren't met.
-type ScheduleMultiError []error
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeedValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeedValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeedValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SeedMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeedMultiError is an error wrapping multiple validation errors returned by
+// Seed.ValidateAll() if the designated constraints aren't met.
+type SeedMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ScheduleMultiError) Error() string {
+func (m SeedMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5682,11 +6245,11 @@ func (m ScheduleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ScheduleMultiError) AllErrors() []error { return m }
+func (m SeedMultiError) AllErrors() []error { return m }
 
-// ScheduleValidationError is the validation error returned by
-// Schedule.Validate if the designated constraints aren't met.
-type ScheduleValidationError struct {
+// SeedValidationError is the validation error returned by Seed.Validate if the
+// designated constraints aren't met.
+type SeedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5694,22 +6257,22 @@ type ScheduleValidationError struct {
 }
 
 // Field function returns field value.
-func (e ScheduleValidationError) Field() string { return e.field }
+func (e SeedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ScheduleValidationError) Reason() string { return e.reason }
+func (e SeedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ScheduleValidationError) Cause() error { return e.cause }
+func (e SeedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ScheduleValidationError) Key() bool { return e.key }
+func (e SeedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ScheduleValidationError) ErrorName() string { return "ScheduleValidationError" }
+func (e SeedValidationError) ErrorName() string { return "SeedValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ScheduleValidationError) Error() string {
+func (e SeedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5721,14 +6284,14 @@ func (e ScheduleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchedule.%s: %s%s",
+		"invalid %sSeed.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ScheduleValidationError{}
+var _ error = SeedValidationError{}
 
 var _ interface {
 	Field() string
@@ -5736,78 +6299,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ScheduleValidationError{}
+} = SeedValidationError{}
 
-// Validate checks the field values on ParseError with the rules defined in the
+// Validate checks the field values on SeedSpec with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ParseError) Validate() error {
+func (m *SeedSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ParseError with the rules defined in
+// ValidateAll checks the field values on SeedSpec with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ParseErrorMultiError, or
-// nil if none found.
-func (m *ParseError) ValidateAll() error {
+// result is a list of violation errors wrapped in SeedSpecMultiError, or nil
+// if none found.
+func (m *SeedSpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ParseError) validate(all bool) error {
+func (m *SeedSpec) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for Connector
 
-	// no validation rules for FilePath
+	// no validation rules for Path
 
-	if all {
-		switch v := interface{}(m.GetStartLocation()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ParseErrorValidationError{
-					field:  "StartLocation",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ParseErrorValidationError{
-					field:  "StartLocation",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartLocation()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ParseErrorValidationError{
-				field:  "StartLocation",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Format
 
-	// no validation rules for External
+	// no validation rules for ContentHash
+
+	// no validation rules for ColumnTypes
+
+	// no validation rules for Trigger
 
 	if len(errors) > 0 {
-		return ParseErrorMultiError(errors)
+		return SeedSpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// ParseErrorMultiError is an error wrapping multiple validation errors
-// returned by ParseError.ValidateAll() if the designated constraints aren't met.
-type ParseErrorMultiError []error
+// SeedSpecMultiError is an error wrapping multiple validation errors returned
+// by SeedSpec.ValidateAll() if the designated constraints aren't met.
+type SeedSpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ParseErrorMultiError) Error() string {
+func (m SeedSpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5816,11 +6356,11 @@ func (m ParseErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ParseErrorMultiError) AllErrors() []error { return m }
+func (m SeedSpecMultiError) AllErrors() []error { return m }
 
-// ParseErrorValidationError is the validation error returned by
-// ParseError.Validate if the designated constraints aren't met.
-type ParseErrorValidationError struct {
+// SeedSpecValidationError is the validation error returned by
+// SeedSpec.Validate if the designated constraints aren't met.
+type SeedSpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5828,22 +6368,22 @@ type ParseErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e ParseErrorValidationError) Field() string { return e.field }
+func (e SeedSpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ParseErrorValidationError) Reason() string { return e.reason }
+func (e SeedSpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ParseErrorValidationError) Cause() error { return e.cause }
+func (e SeedSpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ParseErrorValidationError) Key() bool { return e.key }
+func (e SeedSpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ParseErrorValidationError) ErrorName() string { return "ParseErrorValidationError" }
+func (e SeedSpecValidationError) ErrorName() string { return "SeedSpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ParseErrorValidationError) Error() string {
+func (e SeedSpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5855,14 +6395,14 @@ func (e ParseErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sParseError.%s: %s%s",
+		"invalid %sSeedSpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ParseErrorValidationError{}
+var _ error = SeedSpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -5870,46 +6410,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ParseErrorValidationError{}
+} = SeedSpecValidationError{}
 
-// Validate checks the field values on ValidationError with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ValidationError) Validate() error {
+// Validate checks the field values on SeedState with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SeedState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidationError with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ValidationErrorMultiError, or nil if none found.
-func (m *ValidationError) ValidateAll() error {
+// ValidateAll checks the field values on SeedState with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SeedStateMultiError, or nil
+// if none found.
+func (m *SeedState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidationError) validate(all bool) error {
+func (m *SeedState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for Connector
+
+	// no validation rules for Table
+
+	// no validation rules for SpecHash
+
+	if all {
+		switch v := interface{}(m.GetRefreshedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeedStateValidationError{
+					field:  "RefreshedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeedStateValidationError{
+					field:  "RefreshedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeedStateValidationError{
+				field:  "RefreshedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ValidationErrorMultiError(errors)
+		return SeedStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidationErrorMultiError is an error wrapping multiple validation errors
-// returned by ValidationError.ValidateAll() if the designated constraints
-// aren't met.
-type ValidationErrorMultiError []error
+// SeedStateMultiError is an error wrapping multiple validation errors returned
+// by SeedState.ValidateAll() if the designated constraints aren't met.
+type SeedStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidationErrorMultiError) Error() string {
+func (m SeedStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5918,11 +6490,11 @@ func (m ValidationErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidationErrorMultiError) AllErrors() []error { return m }
+func (m SeedStateMultiError) AllErrors() []error { return m }
 
-// ValidationErrorValidationError is the validation error returned by
-// ValidationError.Validate if the designated constraints aren't met.
-type ValidationErrorValidationError struct {
+// SeedStateValidationError is the validation error returned by
+// SeedState.Validate if the designated constraints aren't met.
+type SeedStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5930,22 +6502,22 @@ type ValidationErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidationErrorValidationError) Field() string { return e.field }
+func (e SeedStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidationErrorValidationError) Reason() string { return e.reason }
+func (e SeedStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidationErrorValidationError) Cause() error { return e.cause }
+func (e SeedStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidationErrorValidationError) Key() bool { return e.key }
+func (e SeedStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidationErrorValidationError) ErrorName() string { return "ValidationErrorValidationError" }
+func (e SeedStateValidationError) ErrorName() string { return "SeedStateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ValidationErrorValidationError) Error() string {
+func (e SeedStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5957,14 +6529,14 @@ func (e ValidationErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidationError.%s: %s%s",
+		"invalid %sSeedState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidationErrorValidationError{}
+var _ error = SeedStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -5972,48 +6544,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidationErrorValidationError{}
+} = SeedStateValidationError{}
 
-// Validate checks the field values on DependencyError with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DependencyError) Validate() error {
+// Validate checks the field values on Schedule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Schedule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DependencyError with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DependencyErrorMultiError, or nil if none found.
-func (m *DependencyError) ValidateAll() error {
+// ValidateAll checks the field values on Schedule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScheduleMultiError, or nil
+// if none found.
+func (m *Schedule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DependencyError) validate(all bool) error {
+func (m *Schedule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for Cron
 
-	// no validation rules for Dependency
+	// no validation rules for TickerSeconds
+
+	// no validation rules for TimeZone
 
 	if len(errors) > 0 {
-		return DependencyErrorMultiError(errors)
+		return ScheduleMultiError(errors)
 	}
 
 	return nil
 }
 
-// DependencyErrorMultiError is an error wrapping multiple validation errors
-// returned by DependencyError.ValidateAll() if the designated constraints
-// aren't met.
-type DependencyErrorMultiError []error
+// ScheduleMultiError is an error wrapping multiple validation errors returned
+// by Schedule.ValidateAll() if the designated constraints aren't met.
+type ScheduleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DependencyErrorMultiError) Error() string {
+func (m ScheduleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6022,11 +6595,11 @@ func (m DependencyErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DependencyErrorMultiError) AllErrors() []error { return m }
+func (m ScheduleMultiError) AllErrors() []error { return m }
 
-// DependencyErrorValidationError is the validation error returned by
-// DependencyError.Validate if the designated constraints aren't met.
-type DependencyErrorValidationError struct {
+// ScheduleValidationError is the validation error returned by
+// Schedule.Validate if the designated constraints aren't met.
+type ScheduleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6034,22 +6607,22 @@ type DependencyErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e DependencyErrorValidationError) Field() string { return e.field }
+func (e ScheduleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DependencyErrorValidationError) Reason() string { return e.reason }
+func (e ScheduleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DependencyErrorValidationError) Cause() error { return e.cause }
+func (e ScheduleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DependencyErrorValidationError) Key() bool { return e.key }
+func (e ScheduleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DependencyErrorValidationError) ErrorName() string { return "DependencyErrorValidationError" }
+func (e ScheduleValidationError) ErrorName() string { return "ScheduleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DependencyErrorValidationError) Error() string {
+func (e ScheduleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6061,14 +6634,14 @@ func (e DependencyErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDependencyError.%s: %s%s",
+		"invalid %sSchedule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DependencyErrorValidationError{}
+var _ error = ScheduleValidationError{}
 
 var _ interface {
 	Field() string
@@ -6076,24 +6649,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DependencyErrorValidationError{}
+} = ScheduleValidationError{}
 
-// Validate checks the field values on ExecutionError with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ParseError with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ExecutionError) Validate() error {
+func (m *ParseError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExecutionError with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExecutionErrorMultiError,
-// or nil if none found.
-func (m *ExecutionError) ValidateAll() error {
+// ValidateAll checks the field values on ParseError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ParseErrorMultiError, or
+// nil if none found.
+func (m *ParseError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExecutionError) validate(all bool) error {
+func (m *ParseError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6102,20 +6675,154 @@ func (m *ExecutionError) validate(all bool) error {
 
 	// no validation rules for Message
 
+	// no validation rules for FilePath
+
+	if all {
+		switch v := interface{}(m.GetStartLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ParseErrorValidationError{
+					field:  "StartLocation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ParseErrorValidationError{
+					field:  "StartLocation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ParseErrorValidationError{
+				field:  "StartLocation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for External
+
 	if len(errors) > 0 {
-		return ExecutionErrorMultiError(errors)
+		return ParseErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExecutionErrorMultiError is an error wrapping multiple validation errors
-// returned by ExecutionError.ValidateAll() if the designated constraints
+// ParseErrorMultiError is an error wrapping multiple validation errors
+// returned by ParseError.ValidateAll() if the designated constraints aren't met.
+type ParseErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ParseErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ParseErrorMultiError) AllErrors() []error { return m }
+
+// ParseErrorValidationError is the validation error returned by
+// ParseError.Validate if the designated constraints aren't met.
+type ParseErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ParseErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ParseErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ParseErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ParseErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ParseErrorValidationError) ErrorName() string { return "ParseErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ParseErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sParseError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ParseErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ParseErrorValidationError{}
+
+// Validate checks the field values on ValidationError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ValidationError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidationError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidationErrorMultiError, or nil if none found.
+func (m *ValidationError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidationError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ValidationErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidationErrorMultiError is an error wrapping multiple validation errors
+// returned by ValidationError.ValidateAll() if the designated constraints
 // aren't met.
-type ExecutionErrorMultiError []error
+type ValidationErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExecutionErrorMultiError) Error() string {
+func (m ValidationErrorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6124,11 +6831,685 @@ func (m ExecutionErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExecutionErrorMultiError) AllErrors() []error { return m }
+func (m ValidationErrorMultiError) AllErrors() []error { return m }
 
-// ExecutionErrorValidationError is the validation error returned by
-// ExecutionError.Validate if the designated constraints aren't met.
-type ExecutionErrorValidationError struct {
+// ValidationErrorValidationError is the validation error returned by
+// ValidationError.Validate if the designated constraints aren't met.
+type ValidationErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidationErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidationErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidationErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidationErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidationErrorValidationError) ErrorName() string { return "ValidationErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ValidationErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidationError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidationErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidationErrorValidationError{}
+
+// Validate checks the field values on DependencyError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DependencyError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DependencyError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DependencyErrorMultiError, or nil if none found.
+func (m *DependencyError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DependencyError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for Dependency
+
+	if len(errors) > 0 {
+		return DependencyErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// DependencyErrorMultiError is an error wrapping multiple validation errors
+// returned by DependencyError.ValidateAll() if the designated constraints
+// aren't met.
+type DependencyErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DependencyErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DependencyErrorMultiError) AllErrors() []error { return m }
+
+// DependencyErrorValidationError is the validation error returned by
+// DependencyError.Validate if the designated constraints aren't met.
+type DependencyErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DependencyErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DependencyErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DependencyErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DependencyErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DependencyErrorValidationError) ErrorName() string { return "DependencyErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DependencyErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDependencyError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DependencyErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DependencyErrorValidationError{}
+
+// Validate checks the field values on ExecutionError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExecutionError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecutionError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExecutionErrorMultiError,
+// or nil if none found.
+func (m *ExecutionError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecutionError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ExecutionErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecutionErrorMultiError is an error wrapping multiple validation errors
+// returned by ExecutionError.ValidateAll() if the designated constraints
+// aren't met.
+type ExecutionErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecutionErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecutionErrorMultiError) AllErrors() []error { return m }
+
+// ExecutionErrorValidationError is the validation error returned by
+// ExecutionError.Validate if the designated constraints aren't met.
+type ExecutionErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecutionErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecutionErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecutionErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecutionErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecutionErrorValidationError) ErrorName() string { return "ExecutionErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExecutionErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecutionError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecutionErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecutionErrorValidationError{}
+
+// Validate checks the field values on CharLocation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CharLocation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CharLocation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CharLocationMultiError, or
+// nil if none found.
+func (m *CharLocation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CharLocation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Line
+
+	if len(errors) > 0 {
+		return CharLocationMultiError(errors)
+	}
+
+	return nil
+}
+
+// CharLocationMultiError is an error wrapping multiple validation errors
+// returned by CharLocation.ValidateAll() if the designated constraints aren't met.
+type CharLocationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CharLocationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CharLocationMultiError) AllErrors() []error { return m }
+
+// CharLocationValidationError is the validation error returned by
+// CharLocation.Validate if the designated constraints aren't met.
+type CharLocationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CharLocationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CharLocationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CharLocationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CharLocationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CharLocationValidationError) ErrorName() string { return "CharLocationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CharLocationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCharLocation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CharLocationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CharLocationValidationError{}
+
+// Validate checks the field values on TableSecurity_ColumnCondition with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TableSecurity_ColumnCondition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableSecurity_ColumnCondition with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// TableSecurity_ColumnConditionMultiError, or nil if none found.
+func (m *TableSecurity_ColumnCondition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableSecurity_ColumnCondition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Condition
+
+	if len(errors) > 0 {
+		return TableSecurity_ColumnConditionMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableSecurity_ColumnConditionMultiError is an error wrapping multiple
+// validation errors returned by TableSecurity_ColumnCondition.ValidateAll()
+// if the designated constraints aren't met.
+type TableSecurity_ColumnConditionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableSecurity_ColumnConditionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableSecurity_ColumnConditionMultiError) AllErrors() []error { return m }
+
+// TableSecurity_ColumnConditionValidationError is the validation error
+// returned by TableSecurity_ColumnCondition.Validate if the designated
+// constraints aren't met.
+type TableSecurity_ColumnConditionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableSecurity_ColumnConditionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableSecurity_ColumnConditionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableSecurity_ColumnConditionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableSecurity_ColumnConditionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableSecurity_ColumnConditionValidationError) ErrorName() string {
+	return "TableSecurity_ColumnConditionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TableSecurity_ColumnConditionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableSecurity_ColumnCondition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableSecurity_ColumnConditionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableSecurity_ColumnConditionValidationError{}
+
+// Validate checks the field values on MetricsViewSpec_DimensionV2 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_DimensionV2) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_DimensionV2 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_DimensionV2MultiError, or nil if none found.
+func (m *MetricsViewSpec_DimensionV2) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_DimensionV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Column
+
+	// no validation rules for Expression
+
+	// no validation rules for Table
+
+	// no validation rules for Label
+
+	// no validation rules for Description
+
+	// no validation rules for Unnest
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_DimensionV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_DimensionV2MultiError is an error wrapping multiple
+// validation errors returned by MetricsViewSpec_DimensionV2.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewSpec_DimensionV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_DimensionV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_DimensionV2MultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_DimensionV2ValidationError is the validation error returned
+// by MetricsViewSpec_DimensionV2.Validate if the designated constraints
+// aren't met.
+type MetricsViewSpec_DimensionV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_DimensionV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_DimensionV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_DimensionV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_DimensionV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_DimensionV2ValidationError) ErrorName() string {
+	return "MetricsViewSpec_DimensionV2ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_DimensionV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_DimensionV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_DimensionV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_DimensionV2ValidationError{}
+
+// Validate checks the field values on MetricsViewSpec_MeasureV2 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_MeasureV2) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_MeasureV2 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_MeasureV2MultiError, or nil if none found.
+func (m *MetricsViewSpec_MeasureV2) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_MeasureV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Expression
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSpec_MeasureV2ValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSpec_MeasureV2ValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSpec_MeasureV2ValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Label
+
+	// no validation rules for Description
+
+	// no validation rules for FormatPreset
+
+	// no validation rules for FormatD3
+
+	// no validation rules for ValidPercentOfTotal
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_MeasureV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_MeasureV2MultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSpec_MeasureV2.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewSpec_MeasureV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_MeasureV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_MeasureV2MultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_MeasureV2ValidationError is the validation error returned by
+// MetricsViewSpec_MeasureV2.Validate if the designated constraints aren't met.
+type MetricsViewSpec_MeasureV2ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6136,22 +7517,24 @@ type ExecutionErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExecutionErrorValidationError) Field() string { return e.field }
+func (e MetricsViewSpec_MeasureV2ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExecutionErrorValidationError) Reason() string { return e.reason }
+func (e MetricsViewSpec_MeasureV2ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExecutionErrorValidationError) Cause() error { return e.cause }
+func (e MetricsViewSpec_MeasureV2ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExecutionErrorValidationError) Key() bool { return e.key }
+func (e MetricsViewSpec_MeasureV2ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExecutionErrorValidationError) ErrorName() string { return "ExecutionErrorValidationError" }
+func (e MetricsViewSpec_MeasureV2ValidationError) ErrorName() string {
+	return "MetricsViewSpec_MeasureV2ValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ExecutionErrorValidationError) Error() string {
+func (e MetricsViewSpec_MeasureV2ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6163,14 +7546,14 @@ func (e ExecutionErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExecutionError.%s: %s%s",
+		"invalid %sMetricsViewSpec_MeasureV2.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExecutionErrorValidationError{}
+var _ error = MetricsViewSpec_MeasureV2ValidationError{}
 
 var _ interface {
 	Field() string
@@ -6178,45 +7561,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExecutionErrorValidationError{}
+} = MetricsViewSpec_MeasureV2ValidationError{}
 
-// Validate checks the field values on CharLocation with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CharLocation) Validate() error {
+// Validate checks the field values on MetricsViewSpec_MeasureWindow with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_MeasureWindow) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CharLocation with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CharLocationMultiError, or
-// nil if none found.
-func (m *CharLocation) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewSpec_MeasureWindow with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_MeasureWindowMultiError, or nil if none found.
+func (m *MetricsViewSpec_MeasureWindow) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CharLocation) validate(all bool) error {
+func (m *MetricsViewSpec_MeasureWindow) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Line
+	// no validation rules for Kind
+
+	// no validation rules for Period
+
+	// no validation rules for Size
+
+	// no validation rules for Dimension
 
 	if len(errors) > 0 {
-		return CharLocationMultiError(errors)
+		return MetricsViewSpec_MeasureWindowMultiError(errors)
 	}
 
 	return nil
 }
 
-// CharLocationMultiError is an error wrapping multiple validation errors
-// returned by CharLocation.ValidateAll() if the designated constraints aren't met.
-type CharLocationMultiError []error
+// MetricsViewSpec_MeasureWindowMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewSpec_MeasureWindow.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewSpec_MeasureWindowMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CharLocationMultiError) Error() string {
+func (m MetricsViewSpec_MeasureWindowMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6225,11 +7615,12 @@ func (m CharLocationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CharLocationMultiError) AllErrors() []error { return m }
+func (m MetricsViewSpec_MeasureWindowMultiError) AllErrors() []error { return m }
 
-// CharLocationValidationError is the validation error returned by
-// CharLocation.Validate if the designated constraints aren't met.
-type CharLocationValidationError struct {
+// MetricsViewSpec_MeasureWindowValidationError is the validation error
+// returned by MetricsViewSpec_MeasureWindow.Validate if the designated
+// constraints aren't met.
+type MetricsViewSpec_MeasureWindowValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6237,22 +7628,24 @@ type CharLocationValidationError struct {
 }
 
 // Field function returns field value.
-func (e CharLocationValidationError) Field() string { return e.field }
+func (e MetricsViewSpec_MeasureWindowValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CharLocationValidationError) Reason() string { return e.reason }
+func (e MetricsViewSpec_MeasureWindowValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CharLocationValidationError) Cause() error { return e.cause }
+func (e MetricsViewSpec_MeasureWindowValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CharLocationValidationError) Key() bool { return e.key }
+func (e MetricsViewSpec_MeasureWindowValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CharLocationValidationError) ErrorName() string { return "CharLocationValidationError" }
+func (e MetricsViewSpec_MeasureWindowValidationError) ErrorName() string {
+	return "MetricsViewSpec_MeasureWindowValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CharLocationValidationError) Error() string {
+func (e MetricsViewSpec_MeasureWindowValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6264,14 +7657,14 @@ func (e CharLocationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCharLocation.%s: %s%s",
+		"invalid %sMetricsViewSpec_MeasureWindow.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CharLocationValidationError{}
+var _ error = MetricsViewSpec_MeasureWindowValidationError{}
 
 var _ interface {
 	Field() string
@@ -6279,56 +7672,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CharLocationValidationError{}
+} = MetricsViewSpec_MeasureWindowValidationError{}
 
-// Validate checks the field values on MetricsViewSpec_DimensionV2 with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewSpec_JoinV2 with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSpec_DimensionV2) Validate() error {
+func (m *MetricsViewSpec_JoinV2) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSpec_DimensionV2 with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MetricsViewSpec_JoinV2 with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSpec_DimensionV2MultiError, or nil if none found.
-func (m *MetricsViewSpec_DimensionV2) ValidateAll() error {
+// MetricsViewSpec_JoinV2MultiError, or nil if none found.
+func (m *MetricsViewSpec_JoinV2) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSpec_DimensionV2) validate(all bool) error {
+func (m *MetricsViewSpec_JoinV2) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	// no validation rules for Column
-
-	// no validation rules for Expression
-
-	// no validation rules for Label
-
-	// no validation rules for Description
+	// no validation rules for Table
 
-	// no validation rules for Unnest
+	// no validation rules for On
 
 	if len(errors) > 0 {
-		return MetricsViewSpec_DimensionV2MultiError(errors)
+		return MetricsViewSpec_JoinV2MultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSpec_DimensionV2MultiError is an error wrapping multiple
-// validation errors returned by MetricsViewSpec_DimensionV2.ValidateAll() if
-// the designated constraints aren't met.
-type MetricsViewSpec_DimensionV2MultiError []error
+// MetricsViewSpec_JoinV2MultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSpec_JoinV2.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSpec_JoinV2MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSpec_DimensionV2MultiError) Error() string {
+func (m MetricsViewSpec_JoinV2MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6337,12 +7722,11 @@ func (m MetricsViewSpec_DimensionV2MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSpec_DimensionV2MultiError) AllErrors() []error { return m }
+func (m MetricsViewSpec_JoinV2MultiError) AllErrors() []error { return m }
 
-// MetricsViewSpec_DimensionV2ValidationError is the validation error returned
-// by MetricsViewSpec_DimensionV2.Validate if the designated constraints
-// aren't met.
-type MetricsViewSpec_DimensionV2ValidationError struct {
+// MetricsViewSpec_JoinV2ValidationError is the validation error returned by
+// MetricsViewSpec_JoinV2.Validate if the designated constraints aren't met.
+type MetricsViewSpec_JoinV2ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6350,24 +7734,24 @@ type MetricsViewSpec_DimensionV2ValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSpec_DimensionV2ValidationError) Field() string { return e.field }
+func (e MetricsViewSpec_JoinV2ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSpec_DimensionV2ValidationError) Reason() string { return e.reason }
+func (e MetricsViewSpec_JoinV2ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSpec_DimensionV2ValidationError) Cause() error { return e.cause }
+func (e MetricsViewSpec_JoinV2ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSpec_DimensionV2ValidationError) Key() bool { return e.key }
+func (e MetricsViewSpec_JoinV2ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSpec_DimensionV2ValidationError) ErrorName() string {
-	return "MetricsViewSpec_DimensionV2ValidationError"
+func (e MetricsViewSpec_JoinV2ValidationError) ErrorName() string {
+	return "MetricsViewSpec_JoinV2ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSpec_DimensionV2ValidationError) Error() string {
+func (e MetricsViewSpec_JoinV2ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6379,14 +7763,14 @@ func (e MetricsViewSpec_DimensionV2ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSpec_DimensionV2.%s: %s%s",
+		"invalid %sMetricsViewSpec_JoinV2.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSpec_DimensionV2ValidationError{}
+var _ error = MetricsViewSpec_JoinV2ValidationError{}
 
 var _ interface {
 	Field() string
@@ -6394,24 +7778,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSpec_DimensionV2ValidationError{}
+} = MetricsViewSpec_JoinV2ValidationError{}
 
-// Validate checks the field values on MetricsViewSpec_MeasureV2 with the rules
+// Validate checks the field values on MetricsViewSpec_RollupV2 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSpec_MeasureV2) Validate() error {
+func (m *MetricsViewSpec_RollupV2) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSpec_MeasureV2 with the
+// ValidateAll checks the field values on MetricsViewSpec_RollupV2 with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSpec_MeasureV2MultiError, or nil if none found.
-func (m *MetricsViewSpec_MeasureV2) ValidateAll() error {
+// MetricsViewSpec_RollupV2MultiError, or nil if none found.
+func (m *MetricsViewSpec_RollupV2) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSpec_MeasureV2) validate(all bool) error {
+func (m *MetricsViewSpec_RollupV2) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6420,32 +7804,22 @@ func (m *MetricsViewSpec_MeasureV2) validate(all bool) error {
 
 	// no validation rules for Name
 
-	// no validation rules for Expression
-
-	// no validation rules for Label
-
-	// no validation rules for Description
-
-	// no validation rules for FormatPreset
-
-	// no validation rules for FormatD3
-
-	// no validation rules for ValidPercentOfTotal
+	// no validation rules for TimeGrain
 
 	if len(errors) > 0 {
-		return MetricsViewSpec_MeasureV2MultiError(errors)
+		return MetricsViewSpec_RollupV2MultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSpec_MeasureV2MultiError is an error wrapping multiple validation
-// errors returned by MetricsViewSpec_MeasureV2.ValidateAll() if the
-// designated constraints aren't met.
-type MetricsViewSpec_MeasureV2MultiError []error
+// MetricsViewSpec_RollupV2MultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSpec_RollupV2.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSpec_RollupV2MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSpec_MeasureV2MultiError) Error() string {
+func (m MetricsViewSpec_RollupV2MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6454,11 +7828,11 @@ func (m MetricsViewSpec_MeasureV2MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSpec_MeasureV2MultiError) AllErrors() []error { return m }
+func (m MetricsViewSpec_RollupV2MultiError) AllErrors() []error { return m }
 
-// MetricsViewSpec_MeasureV2ValidationError is the validation error returned by
-// MetricsViewSpec_MeasureV2.Validate if the designated constraints aren't met.
-type MetricsViewSpec_MeasureV2ValidationError struct {
+// MetricsViewSpec_RollupV2ValidationError is the validation error returned by
+// MetricsViewSpec_RollupV2.Validate if the designated constraints aren't met.
+type MetricsViewSpec_RollupV2ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6466,24 +7840,24 @@ type MetricsViewSpec_MeasureV2ValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSpec_MeasureV2ValidationError) Field() string { return e.field }
+func (e MetricsViewSpec_RollupV2ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSpec_MeasureV2ValidationError) Reason() string { return e.reason }
+func (e MetricsViewSpec_RollupV2ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSpec_MeasureV2ValidationError) Cause() error { return e.cause }
+func (e MetricsViewSpec_RollupV2ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSpec_MeasureV2ValidationError) Key() bool { return e.key }
+func (e MetricsViewSpec_RollupV2ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSpec_MeasureV2ValidationError) ErrorName() string {
-	return "MetricsViewSpec_MeasureV2ValidationError"
+func (e MetricsViewSpec_RollupV2ValidationError) ErrorName() string {
+	return "MetricsViewSpec_RollupV2ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSpec_MeasureV2ValidationError) Error() string {
+func (e MetricsViewSpec_RollupV2ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6495,14 +7869,14 @@ func (e MetricsViewSpec_MeasureV2ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSpec_MeasureV2.%s: %s%s",
+		"invalid %sMetricsViewSpec_RollupV2.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSpec_MeasureV2ValidationError{}
+var _ error = MetricsViewSpec_RollupV2ValidationError{}
 
 var _ interface {
 	Field() string
@@ -6510,7 +7884,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSpec_MeasureV2ValidationError{}
+} = MetricsViewSpec_RollupV2ValidationError{}
 
 // Validate checks the field values on MetricsViewSpec_SecurityV2 with the
 // rules defined in the proto definition for this message. If any rules are
@@ -6606,6 +7980,40 @@ func (m *MetricsViewSpec_SecurityV2) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetMask() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpec_SecurityV2ValidationError{
+						field:  fmt.Sprintf("Mask[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpec_SecurityV2ValidationError{
+						field:  fmt.Sprintf("Mask[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpec_SecurityV2ValidationError{
+					field:  fmt.Sprintf("Mask[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return MetricsViewSpec_SecurityV2MultiError(errors)
 	}
@@ -7044,3 +8452,112 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MetricsViewSpec_SecurityV2_FieldConditionV2ValidationError{}
+
+// Validate checks the field values on MetricsViewSpec_SecurityV2_MaskV2 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *MetricsViewSpec_SecurityV2_MaskV2) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_SecurityV2_MaskV2
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_SecurityV2_MaskV2MultiError, or nil if none found.
+func (m *MetricsViewSpec_SecurityV2_MaskV2) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_SecurityV2_MaskV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Condition
+
+	// no validation rules for With
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_SecurityV2_MaskV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_SecurityV2_MaskV2MultiError is an error wrapping multiple
+// validation errors returned by
+// MetricsViewSpec_SecurityV2_MaskV2.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSpec_SecurityV2_MaskV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_SecurityV2_MaskV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_SecurityV2_MaskV2MultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_SecurityV2_MaskV2ValidationError is the validation error
+// returned by MetricsViewSpec_SecurityV2_MaskV2.Validate if the designated
+// constraints aren't met.
+type MetricsViewSpec_SecurityV2_MaskV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_SecurityV2_MaskV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_SecurityV2_MaskV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_SecurityV2_MaskV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_SecurityV2_MaskV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_SecurityV2_MaskV2ValidationError) ErrorName() string {
+	return "MetricsViewSpec_SecurityV2_MaskV2ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_SecurityV2_MaskV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_SecurityV2_MaskV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_SecurityV2_MaskV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_SecurityV2_MaskV2ValidationError{}
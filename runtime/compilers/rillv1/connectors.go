@@ -68,6 +68,8 @@ func (a *connectorAnalyzer) analyzeResource(ctx context.Context, r *Resource) er
 		return a.trackConnector(r.ModelSpec.Connector, r, false)
 	} else if r.MigrationSpec != nil {
 		return a.trackConnector(r.MigrationSpec.Connector, r, false)
+	} else if r.SeedSpec != nil {
+		return a.trackConnector(r.SeedSpec.Connector, r, false)
 	}
 	// Other resource kinds currently don't use connectors.
 	return nil
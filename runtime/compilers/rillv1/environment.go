@@ -0,0 +1,109 @@
+package rillv1
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment-specific overrides
+//
+// rill.yaml and resource YAML files can contain blocks of properties that only apply in a specific environment.
+// The "dev" and "prod" keys are shorthands for the built-in environments. Overrides for other environments go under "environment_overrides".
+// For example:
+//
+//	connector: s3
+//	path: s3://bucket/data/*.parquet
+//	dev:
+//	  path: s3://bucket/data/sample.parquet
+//	environment_overrides:
+//	  staging:
+//	    path: s3://bucket/data/staging/*.parquet
+//
+// The overrides for the parser's environment are merged into the base properties. Maps are merged recursively, all other values are replaced.
+// The override blocks are removed from the YAML before it is parsed, so they don't need to be handled by the resource parsers.
+
+// environmentOverridesKey is the YAML key for overrides of arbitrarily named environments.
+const environmentOverridesKey = "environment_overrides"
+
+// builtinEnvironments are the environments that can be overridden with a top-level key of the same name.
+var builtinEnvironments = map[string]bool{"dev": true, "prod": true}
+
+// applyEnvironmentOverrides merges the overrides for env into the top-level mapping of node and removes all override blocks.
+// It returns true if node contained any override blocks (and was therefore changed).
+func applyEnvironmentOverrides(node *yaml.Node, env string) (bool, error) {
+	if node.Kind == yaml.DocumentNode {
+		if len(node.Content) == 0 {
+			return false, nil
+		}
+		node = node.Content[0]
+	}
+	if node.Kind != yaml.MappingNode {
+		return false, nil
+	}
+
+	var changed bool
+	var overrides []*yaml.Node
+	content := make([]*yaml.Node, 0, len(node.Content))
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, val := node.Content[i], node.Content[i+1]
+		switch {
+		case builtinEnvironments[key.Value]:
+			if val.Kind != yaml.MappingNode {
+				return false, fmt.Errorf("environment overrides for %q must be a map", key.Value)
+			}
+			if key.Value == env {
+				overrides = append(overrides, val)
+			}
+			changed = true
+		case key.Value == environmentOverridesKey:
+			if val.Kind != yaml.MappingNode {
+				return false, fmt.Errorf("%q must be a map of environment names to overrides", environmentOverridesKey)
+			}
+			for j := 0; j+1 < len(val.Content); j += 2 {
+				if val.Content[j+1].Kind != yaml.MappingNode {
+					return false, fmt.Errorf("environment overrides for %q must be a map", val.Content[j].Value)
+				}
+				if val.Content[j].Value == env {
+					overrides = append(overrides, val.Content[j+1])
+				}
+			}
+			changed = true
+		default:
+			content = append(content, key, val)
+		}
+	}
+	node.Content = content
+
+	for _, o := range overrides {
+		mergeYAMLMappings(node, o)
+	}
+
+	return changed, nil
+}
+
+// mergeYAMLMappings merges the mapping src into the mapping dst.
+// Keys that map to mappings in both are merged recursively. Other values in src replace the values in dst.
+func mergeYAMLMappings(dst, src *yaml.Node) {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, val := src.Content[i], src.Content[i+1]
+
+		found := false
+		for j := 0; j+1 < len(dst.Content); j += 2 {
+			if dst.Content[j].Value != key.Value {
+				continue
+			}
+			found = true
+			if dst.Content[j+1].Kind == yaml.MappingNode && val.Kind == yaml.MappingNode {
+				mergeYAMLMappings(dst.Content[j+1], val)
+			} else {
+				dst.Content[j+1] = val
+			}
+			break
+		}
+
+		if !found {
+			dst.Content = append(dst.Content, key, val)
+		}
+	}
+}
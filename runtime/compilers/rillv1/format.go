@@ -0,0 +1,84 @@
+package rillv1
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
+)
+
+// ErrFormatUnsupported is returned by FormatSQL for SQL that can't be formatted without losing information.
+var ErrFormatUnsupported = errors.New("formatting is not supported for this file")
+
+// PathIsFormattable returns true if the file at path contains SQL that FormatSQL can format.
+// Only the SQL files of models that run on one of the parser's DuckDB connectors qualify,
+// since other SQL files (such as init.sql, macros and models for other connectors) may not be valid DuckDB SQL.
+func (p *Parser) PathIsFormattable(path string) bool {
+	path = normalizePath(path)
+	if !strings.HasSuffix(path, ".sql") {
+		return false
+	}
+	for _, r := range p.resourcesForPath[path] {
+		if r.ModelSpec != nil && slices.Contains(p.DuckDBConnectors, r.ModelSpec.Connector) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatSQL normalizes the formatting of a DuckDB SQL file.
+// The leading comments of the file (which contain annotations like "-- @materialize: true") are kept as-is.
+// Since DuckDB's SQL serializer drops comments and doesn't understand templating, it returns ErrFormatUnsupported
+// for SQL that uses templating or has comments after the first statement begins.
+func FormatSQL(sql string) (string, error) {
+	header, body := splitLeadingComments(sql)
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return sql, nil
+	}
+
+	if strings.Contains(body, "{{") || hasSQLComments(body) {
+		return "", ErrFormatUnsupported
+	}
+
+	ast, err := duckdbsql.Parse(body)
+	if err != nil {
+		return "", newDuckDBError(err)
+	}
+	res, err := ast.Format()
+	if err != nil {
+		return "", err
+	}
+
+	return header + strings.TrimSpace(res) + "\n", nil
+}
+
+// splitLeadingComments splits SQL into the blank lines and line comments at its start and the remainder.
+func splitLeadingComments(sql string) (string, string) {
+	var n int
+	for n < len(sql) {
+		end := strings.IndexByte(sql[n:], '\n')
+		if end < 0 {
+			end = len(sql)
+		} else {
+			end += n + 1
+		}
+		line := strings.TrimSpace(sql[n:end])
+		if line != "" && !strings.HasPrefix(line, "--") {
+			break
+		}
+		n = end
+	}
+	return sql[:n], sql[n:]
+}
+
+// hasSQLComments returns true if the SQL contains comments (ignoring comment markers in string literals).
+func hasSQLComments(sql string) bool {
+	for _, m := range sqlLiteralsRegex.FindAllString(sql, -1) {
+		if strings.HasPrefix(m, "--") || strings.HasPrefix(m, "/*") {
+			return true
+		}
+	}
+	return false
+}
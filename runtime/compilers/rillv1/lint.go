@@ -0,0 +1,218 @@
+package rillv1
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
+)
+
+// Lint rules. The rule name is included in each diagnostic's message so users can tell the rules apart.
+const (
+	LintRuleSelectStar              = "select-star"
+	LintRuleUnreferencedModel       = "unreferenced-model"
+	LintRuleMeasureWithoutAggregate = "measure-without-aggregate"
+	LintRuleNonDeterministicModel   = "non-deterministic-model"
+)
+
+// aggregateFuncRegex matches calls to common DuckDB and Druid aggregate functions.
+var aggregateFuncRegex = regexp.MustCompile(`(?i)\b(any_value|approx_count_distinct|approx_quantile|arg_max|arg_min|array_agg|avg|bit_and|bit_or|bit_xor|bool_and|bool_or|corr|count|count_star|covar_pop|covar_samp|ds_hll|ds_theta|entropy|favg|first|fsum|histogram|kurtosis|last|list|max|max_by|mean|median|min|min_by|mode|product|quantile|quantile_cont|quantile_disc|regr_slope|skewness|stddev|stddev_pop|stddev_samp|string_agg|sum|var_pop|var_samp|variance)\s*\(`)
+
+// nonDeterministicFuncRegex matches calls to functions that return a different result each time they're evaluated.
+// Some of them are SQL keywords that are called without parentheses.
+var nonDeterministicFuncRegex = regexp.MustCompile(`(?i)\b(?:(current_date|current_time|current_timestamp)\b|(gen_random_uuid|get_current_time|get_current_timestamp|now|random|today|transaction_timestamp|uuid)\s*\()`)
+
+// selectStarRegex matches a "SELECT *" clause. It's used to find the line to report select-star diagnostics on.
+var selectStarRegex = regexp.MustCompile(`(?is)\bselect\s+(distinct\s+)?([a-z_][a-z0-9_]*\.)?\*`)
+
+// Lint checks the project's resources for common mistakes that are not parse errors.
+// It returns diagnostics in the same format as parse errors. The output is sorted by file path and line.
+func (p *Parser) Lint(ctx context.Context) ([]*runtimev1.ParseError, error) {
+	l := &linter{
+		parser: p,
+		files:  make(map[string]string),
+	}
+
+	err := l.lint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort output to ensure deterministic ordering
+	slices.SortStableFunc(l.result, func(a, b *runtimev1.ParseError) int {
+		if a.FilePath != b.FilePath {
+			return strings.Compare(a.FilePath, b.FilePath)
+		}
+		return int(a.StartLocation.GetLine()) - int(b.StartLocation.GetLine())
+	})
+
+	return l.result, nil
+}
+
+// linter implements the lint rules for a parsed project
+type linter struct {
+	parser *Parser
+	files  map[string]string
+	result []*runtimev1.ParseError
+}
+
+// lint runs all lint rules and adds diagnostics to l.result.
+func (l *linter) lint(ctx context.Context) error {
+	// Track which resources are referenced by other resources
+	referenced := make(map[ResourceName]bool)
+	for _, r := range l.parser.Resources {
+		for _, ref := range r.Refs {
+			referenced[ref.Normalized()] = true
+		}
+	}
+
+	for _, r := range l.parser.Resources {
+		var err error
+		switch r.Name.Kind {
+		case ResourceKindModel:
+			err = l.lintModel(ctx, r, referenced[r.Name.Normalized()])
+		case ResourceKindMetricsView:
+			err = l.lintMetricsView(ctx, r)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lintModel applies the lint rules for models.
+func (l *linter) lintModel(ctx context.Context, r *Resource, isReferenced bool) error {
+	path := resourceSQLPath(r)
+
+	if !isReferenced {
+		l.add(path, nil, LintRuleUnreferencedModel, fmt.Sprintf("model %q is not referenced by any other resource", r.Name.Name))
+	}
+
+	if r.ModelSpec.GetMaterialize() {
+		// Look for non-deterministic functions outside of string literals and comments
+		sql := stripSQLLiterals(r.ModelSpec.Sql)
+		if m := nonDeterministicFuncRegex.FindStringSubmatch(sql); m != nil {
+			fn := strings.ToLower(m[1] + m[2])
+			loc, err := l.locate(ctx, path, regexp.MustCompile(`(?i)\b`+fn+`\b`))
+			if err != nil {
+				return err
+			}
+			l.add(path, loc, LintRuleNonDeterministicModel, fmt.Sprintf("materialized model %q calls the non-deterministic function %q, so its data depends on when it was last refreshed", r.Name.Name, fn))
+		}
+	}
+
+	return nil
+}
+
+// lintMetricsView applies the lint rules for metrics views.
+func (l *linter) lintMetricsView(ctx context.Context, r *Resource) error {
+	path := r.Paths[0]
+
+	for _, m := range r.MetricsViewSpec.Measures {
+		if m.Type != runtimev1.MetricsViewSpec_MEASURE_TYPE_UNSPECIFIED && m.Type != runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE {
+			continue
+		}
+		if aggregateFuncRegex.MatchString(stripSQLLiterals(m.Expression)) {
+			continue
+		}
+		loc, err := l.locate(ctx, path, regexp.MustCompile(regexp.QuoteMeta(m.Expression)))
+		if err != nil {
+			return err
+		}
+		l.add(path, loc, LintRuleMeasureWithoutAggregate, fmt.Sprintf("measure %q does not use an aggregate function", m.Name))
+	}
+
+	// Check the models that feed the metrics view for SELECT *
+	for _, ref := range r.Refs {
+		if ref.Kind != ResourceKindModel {
+			continue
+		}
+		m, ok := l.parser.Resources[ref.Normalized()]
+		if !ok || m.ModelSpec.UsesTemplating || !slices.Contains(l.parser.DuckDBConnectors, m.ModelSpec.Connector) {
+			continue
+		}
+
+		ast, err := duckdbsql.Parse(m.ModelSpec.Sql)
+		if err != nil {
+			// Parse errors are reported by the parser
+			continue
+		}
+		star := false
+		for _, col := range ast.ExtractColumnRefs() {
+			if col.IsStar {
+				star = true
+				break
+			}
+		}
+		if !star {
+			continue
+		}
+
+		modelPath := resourceSQLPath(m)
+		loc, err := l.locate(ctx, modelPath, selectStarRegex)
+		if err != nil {
+			return err
+		}
+		l.add(modelPath, loc, LintRuleSelectStar, fmt.Sprintf("model %q feeds metrics view %q but uses SELECT *, so upstream schema changes may break the dashboard; list the columns explicitly", m.Name.Name, r.Name.Name))
+	}
+
+	return nil
+}
+
+// add adds a diagnostic to the result.
+func (l *linter) add(path string, loc *runtimev1.CharLocation, rule, msg string) {
+	l.result = append(l.result, &runtimev1.ParseError{
+		Message:       fmt.Sprintf("%s (%s)", msg, rule),
+		FilePath:      path,
+		StartLocation: loc,
+	})
+}
+
+// locate returns the location of the first match of re in the file at path.
+// It returns nil if there's no match.
+func (l *linter) locate(ctx context.Context, path string, re *regexp.Regexp) (*runtimev1.CharLocation, error) {
+	data, ok := l.files[path]
+	if !ok {
+		var err error
+		data, err = l.parser.Repo.Get(ctx, path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		l.files[path] = data
+	}
+
+	idx := re.FindStringIndex(data)
+	if idx == nil {
+		return nil, nil
+	}
+	return &runtimev1.CharLocation{Line: uint32(strings.Count(data[:idx[0]], "\n") + 1)}, nil
+}
+
+// resourceSQLPath returns the path of a resource's SQL file, falling back to its first path if it doesn't have one.
+func resourceSQLPath(r *Resource) string {
+	for _, p := range r.Paths {
+		if strings.HasSuffix(p, ".sql") {
+			return p
+		}
+	}
+	return r.Paths[0]
+}
+
+// sqlLiteralsRegex matches string literals, quoted identifiers and comments in SQL.
+var sqlLiteralsRegex = regexp.MustCompile(`'(?:[^']|'')*'|"(?:[^"]|"")*"|--[^\n]*|(?s:/\*.*?\*/)`)
+
+// stripSQLLiterals removes string literals, quoted identifiers and comments from SQL.
+// It's used to avoid false positives when matching function names with regular expressions.
+func stripSQLLiterals(sql string) string {
+	return sqlLiteralsRegex.ReplaceAllString(sql, "''")
+}
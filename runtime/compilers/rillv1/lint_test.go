@@ -0,0 +1,200 @@
+package rillv1
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		// Disable ref inference, which requires parsing the SQL with DuckDB
+		`rill.yaml`: `
+models:
+  parser:
+    duckdb:
+      infer_refs: false
+`,
+		// Referenced by d1, not materialized
+		`models/m1.sql`: `
+SELECT now() AS ts, 'random()' AS s
+`,
+		// Not referenced, materialized and calls a non-deterministic function
+		`models/m2.sql`: `-- @materialize: true
+SELECT id,
+  random() AS r
+FROM m1
+`,
+		`dashboards/d1.yaml`: `
+model: m1
+dimensions:
+  - column: s
+measures:
+  - name: total
+    expression: count(*)
+  - name: amount
+    expression: amount * 2
+  - name: label
+    expression: "'sum(x)'"
+  - name: derived
+    expression: total * 2
+    type: derived
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
+	require.NoError(t, err)
+	require.Empty(t, p.Errors)
+
+	diags, err := p.Lint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*runtimev1.ParseError{
+		{
+			Message:       `measure "amount" does not use an aggregate function (measure-without-aggregate)`,
+			FilePath:      "/dashboards/d1.yaml",
+			StartLocation: &runtimev1.CharLocation{Line: 9},
+		},
+		{
+			Message:       `measure "label" does not use an aggregate function (measure-without-aggregate)`,
+			FilePath:      "/dashboards/d1.yaml",
+			StartLocation: &runtimev1.CharLocation{Line: 11},
+		},
+		{
+			Message:  `model "m2" is not referenced by any other resource (unreferenced-model)`,
+			FilePath: "/models/m2.sql",
+		},
+		{
+			Message:       `materialized model "m2" calls the non-deterministic function "random", so its data depends on when it was last refreshed (non-deterministic-model)`,
+			FilePath:      "/models/m2.sql",
+			StartLocation: &runtimev1.CharLocation{Line: 3},
+		},
+	}, diags)
+}
+
+func TestLintSelectStar(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`models/m1.sql`: `
+SELECT 1 AS id
+`,
+		`models/m2.sql`: `
+SELECT
+  *
+FROM m1
+`,
+		`dashboards/d1.yaml`: `
+model: m2
+measures:
+  - name: total
+    expression: count(*)
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb", []string{"duckdb"})
+	require.NoError(t, err)
+	require.Empty(t, p.Errors)
+
+	diags, err := p.Lint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*runtimev1.ParseError{
+		{
+			Message:       `model "m2" feeds metrics view "d1" but uses SELECT *, so upstream schema changes may break the dashboard; list the columns explicitly (select-star)`,
+			FilePath:      "/models/m2.sql",
+			StartLocation: &runtimev1.CharLocation{Line: 2},
+		},
+	}, diags)
+}
+
+func TestFormatSQL(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want string
+		err  error
+	}{
+		{
+			name: "empty",
+			sql:  "\n",
+			want: "\n",
+		},
+		{
+			name: "only comments",
+			sql:  "-- @materialize: true\n",
+			want: "-- @materialize: true\n",
+		},
+		{
+			name: "reformat",
+			sql:  "-- @materialize: true\nselect id, sum(amount) as total\n  from orders where status='paid' group by id",
+			want: "-- @materialize: true\nSELECT id, sum(amount) AS total FROM orders WHERE (status = 'paid') GROUP BY id\n",
+		},
+		{
+			name: "templating",
+			sql:  "-- @materialize: true\nSELECT * FROM {{ ref \"m1\" }}",
+			err:  ErrFormatUnsupported,
+		},
+		{
+			name: "trailing comment",
+			sql:  "SELECT 1 -- one",
+			err:  ErrFormatUnsupported,
+		},
+		{
+			name: "block comment",
+			sql:  "SELECT /* one */ 1",
+			err:  ErrFormatUnsupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatSQL(tt.sql)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPathIsFormattable(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: `
+models:
+  parser:
+    duckdb:
+      infer_refs: false
+`,
+		`init.sql`:       `INSTALL spatial`,
+		`models/m1.sql`:  `SELECT 1 AS id`,
+		`models/m2.sql`:  "-- @connector: postgres\nSELECT 1 AS id",
+		`sources/s1.sql`: "-- @connector: duckdb\nSELECT 1 AS id",
+		`macros/m3.sql`:  "-- @params: x\n{{ .x }} * 2",
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb", []string{"duckdb"})
+	require.NoError(t, err)
+
+	require.True(t, p.PathIsFormattable("/models/m1.sql"))
+	require.False(t, p.PathIsFormattable("/models/m2.sql"))
+	require.False(t, p.PathIsFormattable("/init.sql"))
+	require.False(t, p.PathIsFormattable("/sources/s1.sql"))
+	require.False(t, p.PathIsFormattable("/macros/m3.sql"))
+	require.False(t, p.PathIsFormattable("/models/missing.sql"))
+}
+
+func TestSplitLeadingComments(t *testing.T) {
+	header, body := splitLeadingComments("\n-- @materialize: true\n  -- comment\nSELECT 1 -- not a header\n")
+	require.Equal(t, "\n-- @materialize: true\n  -- comment\n", header)
+	require.Equal(t, "SELECT 1 -- not a header\n", body)
+
+	header, body = splitLeadingComments("SELECT '--'")
+	require.Equal(t, "", header)
+	require.Equal(t, "SELECT '--'", body)
+	require.False(t, hasSQLComments(body))
+}
@@ -0,0 +1,275 @@
+package rillv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/rilldata/rill/runtime/pkg/sqlparse"
+)
+
+// Macros
+//
+// SQL files in the macros/ directory define reusable SQL snippets. The macro's name is the file name without extension.
+// Parameters are declared with a "-- @params: a, b" annotation and referenced in the snippet as "{{ .a }}" and "{{ .b }}".
+// For example, macros/to_usd.sql could contain:
+//
+//	-- @params: amount, currency
+//	{{ .amount }} * (SELECT rate FROM {{ ref "fx_rates" }} WHERE currency = {{ .currency }})
+//
+// A model can then call it with "{{ macro "to_usd" "price" "currency" }}". The arguments are inserted verbatim.
+// Macros can call other macros and pass their own parameters as arguments, e.g. "{{ macro "round" .amount }}".
+// Macros are expanded at parse time, so the resulting SQL (which may contain other template functions) is what ends up in the resource's spec.
+
+// maxMacroDepth is the max depth of nested macro calls. It prevents infinite expansion of macros that call themselves.
+const maxMacroDepth = 10
+
+// macroAnnotationsRegex matches annotation comments, which are removed from the macro's body.
+var macroAnnotationsRegex = regexp.MustCompile(`(?m)^--[ \t]*@.*$\n?`)
+
+// macroParamRegex matches valid parameter names.
+var macroParamRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// macro is a parsed macro definition.
+type macro struct {
+	name   string
+	path   string
+	params []string
+	tree   *parse.Tree
+}
+
+// pathIsMacro returns true if the path is a macro definition.
+func pathIsMacro(path string) bool {
+	return strings.HasPrefix(path, "/macros/") && strings.HasSuffix(path, ".sql")
+}
+
+// parseMacro parses a macro definition and adds it to p.macros.
+func (p *Parser) parseMacro(ctx context.Context, path string) error {
+	data, err := p.Repo.Get(ctx, path)
+	if err != nil {
+		return fmt.Errorf("error loading %q: %w", path, err)
+	}
+	if len(data) > maxFileSize {
+		return fmt.Errorf("size %d bytes exceeds max size of %d bytes", len(data), maxFileSize)
+	}
+
+	name := fileutil.Stem(path)
+	if m, ok := p.macros[strings.ToLower(name)]; ok && m.path != path {
+		return fmt.Errorf("name collision: macro %q is also defined in %q", name, m.path)
+	}
+
+	var params []string
+	if s, ok := sqlparse.ExtractAnnotations(data)["params"]; ok {
+		for _, param := range strings.Split(s, ",") {
+			param = strings.TrimSpace(param)
+			if !macroParamRegex.MatchString(param) {
+				return fmt.Errorf("invalid macro parameter name %q", param)
+			}
+			params = append(params, param)
+		}
+	}
+
+	body := strings.TrimSpace(macroAnnotationsRegex.ReplaceAllString(data, ""))
+	t, err := template.New(name).Funcs(templateParseFuncs()).Parse(body)
+	if err != nil {
+		return err
+	}
+
+	p.macros[strings.ToLower(name)] = &macro{
+		name:   name,
+		path:   path,
+		params: params,
+		tree:   t.Tree,
+	}
+	return nil
+}
+
+// expandMacros replaces calls to "macro" in a SQL template with the expanded macro bodies.
+// Calls to "macro" in the macro bodies are expanded recursively.
+// It records path as a user of the called macros, so it can be reparsed when they change.
+// If the template doesn't call any macros, it is returned unchanged.
+func (p *Parser) expandMacros(path, sql string) (string, error) {
+	return p.expandMacrosAtDepth(path, sql, 0)
+}
+
+func (p *Parser) expandMacrosAtDepth(path, sql string, depth int) (string, error) {
+	if !strings.Contains(sql, "macro") {
+		return sql, nil
+	}
+
+	t, err := template.New("").Funcs(templateParseFuncs()).Parse(sql)
+	if err != nil {
+		// Leave it to AnalyzeTemplate to report the error
+		return sql, nil
+	}
+
+	found := false
+	err = rewriteTemplateActions(t.Root, func(n *parse.ActionNode) (string, bool, error) {
+		args, ok := macroCallArgs(n)
+		if !ok {
+			return "", false, nil
+		}
+		found = true
+
+		if len(args) == 0 {
+			return "", false, errors.New(`"macro" requires the macro name as its first argument`)
+		}
+		name := strings.ToLower(args[0])
+		if p.pathsForMacro[name] == nil {
+			p.pathsForMacro[name] = make(map[string]bool)
+		}
+		p.pathsForMacro[name][path] = true
+
+		m, ok := p.macros[name]
+		if !ok {
+			return "", false, fmt.Errorf("macro %q not found", args[0])
+		}
+		if depth >= maxMacroDepth {
+			return "", false, fmt.Errorf("macro %q exceeds the max nesting depth of %d (does it call itself?)", m.name, maxMacroDepth)
+		}
+		res, err := m.expand(args[1:])
+		if err != nil {
+			return "", false, err
+		}
+		res, err = p.expandMacrosAtDepth(path, res, depth+1)
+		if err != nil {
+			return "", false, err
+		}
+		return res, true, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return sql, nil
+	}
+
+	return t.Root.String(), nil
+}
+
+// expand returns the macro's body with its parameters replaced by args.
+// Parameters passed as arguments to nested macro calls are replaced too, so the nested calls can be expanded.
+func (m *macro) expand(args []string) (string, error) {
+	if len(args) != len(m.params) {
+		return "", fmt.Errorf("macro %q takes %d arguments, but got %d", m.name, len(m.params), len(args))
+	}
+
+	vals := make(map[string]string, len(args))
+	for i, param := range m.params {
+		vals[param] = args[i]
+	}
+
+	tree := m.tree.Copy()
+	err := rewriteTemplateActions(tree.Root, func(n *parse.ActionNode) (string, bool, error) {
+		if _, ok := macroCallArgs(n); ok {
+			args := n.Pipe.Cmds[0].Args
+			for i, arg := range args {
+				f, ok := arg.(*parse.FieldNode)
+				if !ok || len(f.Ident) != 1 {
+					continue
+				}
+				if val, ok := vals[f.Ident[0]]; ok {
+					args[i] = &parse.StringNode{NodeType: parse.NodeString, Pos: f.Pos, Quoted: strconv.Quote(val), Text: val}
+				}
+			}
+			return "", false, nil
+		}
+		if len(n.Pipe.Decl) != 0 || len(n.Pipe.Cmds) != 1 || len(n.Pipe.Cmds[0].Args) != 1 {
+			return "", false, nil
+		}
+		f, ok := n.Pipe.Cmds[0].Args[0].(*parse.FieldNode)
+		if !ok || len(f.Ident) != 1 {
+			return "", false, nil
+		}
+		val, ok := vals[f.Ident[0]]
+		return val, ok, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return tree.Root.String(), nil
+}
+
+// macroCallArgs returns the string arguments of an action of the form {{ macro "name" "arg1" ... }}.
+// It returns false if the action doesn't call "macro". Arguments that are not string literals are returned as their template source.
+func macroCallArgs(n *parse.ActionNode) ([]string, bool) {
+	if len(n.Pipe.Decl) != 0 || len(n.Pipe.Cmds) != 1 {
+		return nil, false
+	}
+	cmd := n.Pipe.Cmds[0]
+	if len(cmd.Args) == 0 {
+		return nil, false
+	}
+	ident, ok := cmd.Args[0].(*parse.IdentifierNode)
+	if !ok || ident.Ident != "macro" {
+		return nil, false
+	}
+
+	var args []string
+	for _, arg := range cmd.Args[1:] {
+		if s, ok := arg.(*parse.StringNode); ok {
+			args = append(args, s.Text)
+		} else {
+			args = append(args, arg.String())
+		}
+	}
+	return args, true
+}
+
+// rewriteTemplateActions walks the lists of a template and replaces actions with text where fn returns true.
+func rewriteTemplateActions(list *parse.ListNode, fn func(n *parse.ActionNode) (string, bool, error)) error {
+	if list == nil {
+		return nil
+	}
+	for i, node := range list.Nodes {
+		var err error
+		switch n := node.(type) {
+		case *parse.ActionNode:
+			text, ok, err := fn(n)
+			if err != nil {
+				return err
+			}
+			if ok {
+				list.Nodes[i] = &parse.TextNode{NodeType: parse.NodeText, Pos: n.Pos, Text: []byte(text)}
+			}
+		case *parse.IfNode:
+			err = rewriteTemplateBranch(&n.BranchNode, fn)
+		case *parse.RangeNode:
+			err = rewriteTemplateBranch(&n.BranchNode, fn)
+		case *parse.WithNode:
+			err = rewriteTemplateBranch(&n.BranchNode, fn)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rewriteTemplateBranch(n *parse.BranchNode, fn func(n *parse.ActionNode) (string, bool, error)) error {
+	err := rewriteTemplateActions(n.List, fn)
+	if err != nil {
+		return err
+	}
+	return rewriteTemplateActions(n.ElseList, fn)
+}
+
+// templateParseFuncs returns a func map with all functions supported in templates.
+// It's used for parsing templates, so the functions are not meant to be called.
+func templateParseFuncs() template.FuncMap {
+	funcMap := newFuncMap()
+	noop := func(parts ...any) string { return "" }
+	funcMap["configure"] = noop
+	funcMap["dependency"] = noop
+	funcMap["ref"] = noop
+	funcMap["lookup"] = noop
+	funcMap["macro"] = noop
+	return funcMap
+}
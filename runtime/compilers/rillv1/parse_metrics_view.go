@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -24,17 +25,23 @@ type MetricsViewYAML struct {
 	Model              string           `yaml:"model"`
 	Table              string           `yaml:"table"`
 	TimeDimension      string           `yaml:"timeseries"`
+	TimeDimensions     []string         `yaml:"time_dimensions"`
 	SmallestTimeGrain  string           `yaml:"smallest_time_grain"`
 	DefaultTimeRange   string           `yaml:"default_time_range"`
 	AvailableTimeZones []string         `yaml:"available_time_zones"`
 	FirstDayOfWeek     uint32           `yaml:"first_day_of_week"`
 	FirstMonthOfYear   uint32           `yaml:"first_month_of_year"`
 	DefaultTheme       string           `yaml:"default_theme"`
-	Dimensions         []*struct {
+	Joins              []*struct {
+		Table string `yaml:"table"`
+		On    string `yaml:"on"`
+	} `yaml:"joins"`
+	Dimensions []*struct {
 		Name        string
 		Label       string
 		Column      string
 		Expression  string
+		Table       string
 		Property    string // For backwards compatibility
 		Description string
 		Ignore      bool `yaml:"ignore"`
@@ -43,12 +50,19 @@ type MetricsViewYAML struct {
 	Measures []*struct {
 		Name                string
 		Label               string
+		Type                string
 		Expression          string
 		Description         string
 		FormatPreset        string `yaml:"format_preset"`
 		FormatD3            string `yaml:"format_d3"`
 		Ignore              bool   `yaml:"ignore"`
 		ValidPercentOfTotal bool   `yaml:"valid_percent_of_total"`
+		Window              *struct {
+			Kind      string `yaml:"kind"`
+			Period    string `yaml:"period"`
+			Size      uint32 `yaml:"size"`
+			Dimension string `yaml:"dimension"`
+		} `yaml:"window"`
 	}
 	Security *struct {
 		Access    string `yaml:"access"`
@@ -61,12 +75,23 @@ type MetricsViewYAML struct {
 			Names     []string
 			Condition string `yaml:"if"`
 		}
+		Mask []*struct {
+			Names     []string
+			Condition string `yaml:"if"`
+			With      string `yaml:"with"`
+		}
 	}
 	DefaultComparison struct {
 		Mode      string `yaml:"mode"`
 		Dimension string `yaml:"dimension"`
 	} `yaml:"default_comparison"`
 	AvailableTimeRanges []AvailableTimeRange `yaml:"available_time_ranges"`
+	Rollups             []*struct {
+		Name       string   `yaml:"name"`
+		TimeGrain  string   `yaml:"time_grain"`
+		Dimensions []string `yaml:"dimensions"`
+		Measures   []string `yaml:"measures"`
+	} `yaml:"rollups"`
 }
 
 type AvailableTimeRange struct {
@@ -159,6 +184,21 @@ var comparisonModesMap = map[string]runtimev1.MetricsViewSpec_ComparisonMode{
 }
 var validComparisonModes = []string{"none", "time", "dimension"}
 
+var measureTypesMap = map[string]runtimev1.MetricsViewSpec_MeasureType{
+	"":        runtimev1.MetricsViewSpec_MEASURE_TYPE_UNSPECIFIED,
+	"simple":  runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE,
+	"derived": runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED,
+	"window":  runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW,
+}
+var validMeasureTypes = []string{"simple", "derived", "window"}
+
+var windowKindsMap = map[string]runtimev1.MetricsViewSpec_WindowKind{
+	"running_sum":      runtimev1.MetricsViewSpec_WINDOW_KIND_RUNNING_SUM,
+	"rolling":          runtimev1.MetricsViewSpec_WINDOW_KIND_ROLLING,
+	"percent_of_total": runtimev1.MetricsViewSpec_WINDOW_KIND_PERCENT_OF_TOTAL,
+}
+var validWindowKinds = []string{"running_sum", "rolling", "percent_of_total"}
+
 // parseMetricsView parses a metrics view (dashboard) definition and adds the resulting resource to p.Resources.
 func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 	// Parse YAML
@@ -206,6 +246,18 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 		}
 	}
 
+	if len(tmp.TimeDimensions) > 0 && tmp.TimeDimension == "" {
+		return fmt.Errorf(`cannot set "time_dimensions" without setting "timeseries"`)
+	}
+	timeDimensions := map[string]bool{strings.ToLower(tmp.TimeDimension): true}
+	for _, td := range tmp.TimeDimensions {
+		lower := strings.ToLower(td)
+		if td == "" || timeDimensions[lower] {
+			return fmt.Errorf("invalid or duplicate time dimension %q", td)
+		}
+		timeDimensions[lower] = true
+	}
+
 	for _, tz := range tmp.AvailableTimeZones {
 		_, err := time.LoadLocation(tz)
 		if err != nil {
@@ -213,6 +265,21 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 		}
 	}
 
+	joins := make(map[string]bool)
+	for _, join := range tmp.Joins {
+		if join == nil || join.Table == "" || join.On == "" {
+			return fmt.Errorf(`each join must have a "table" and an "on" column`)
+		}
+		lower := strings.ToLower(join.Table)
+		if joins[lower] {
+			return fmt.Errorf("found duplicate join for table %q", join.Table)
+		}
+		if strings.EqualFold(join.Table, table) {
+			return fmt.Errorf("cannot join the base table %q with itself", join.Table)
+		}
+		joins[lower] = true
+	}
+
 	names := make(map[string]bool)
 
 	for i, dim := range tmp.Dimensions {
@@ -238,6 +305,15 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 			return fmt.Errorf("exactly one of column or expression should be set for dimension: %q", dim.Name)
 		}
 
+		if dim.Table != "" {
+			if !joins[strings.ToLower(dim.Table)] {
+				return fmt.Errorf("table %q for dimension %q is not declared in joins", dim.Table, dim.Name)
+			}
+			if dim.Column == "" {
+				return fmt.Errorf("dimension %q from joined table %q must set a column", dim.Name, dim.Table)
+			}
+		}
+
 		lower := strings.ToLower(dim.Name)
 		if ok := names[lower]; ok {
 			return fmt.Errorf("found duplicate dimension or measure name %q", dim.Name)
@@ -245,6 +321,11 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 		names[lower] = true
 	}
 
+	dimensionNames := make(map[string]bool, len(names))
+	for name := range names {
+		dimensionNames[name] = true
+	}
+
 	measureCount := 0
 	for i, measure := range tmp.Measures {
 		if measure == nil || measure.Ignore {
@@ -267,6 +348,44 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 		if measure.FormatPreset != "" && measure.FormatD3 != "" {
 			return fmt.Errorf(`cannot set both "format_preset" and "format_d3" for a measure`)
 		}
+
+		measure.Type = strings.ToLower(measure.Type)
+		if _, ok := measureTypesMap[measure.Type]; !ok {
+			return fmt.Errorf("invalid type %q for measure %q. allowed values: %s", measure.Type, measure.Name, strings.Join(validMeasureTypes, ","))
+		}
+		if measure.Window != nil && measure.Type == "" {
+			measure.Type = "window"
+		}
+		if (measure.Type == "derived" || measure.Type == "window") && measure.Expression == "" {
+			return fmt.Errorf("%s measure %q must have an expression", measure.Type, measure.Name)
+		}
+		if measure.Type == "window" {
+			if measure.Window == nil {
+				return fmt.Errorf("window measure %q must have a window", measure.Name)
+			}
+			measure.Window.Kind = strings.ToLower(measure.Window.Kind)
+			switch measure.Window.Kind {
+			case "running_sum":
+				if measure.Window.Period == "" {
+					return fmt.Errorf(`window measure %q: "running_sum" requires a "period"`, measure.Name)
+				}
+				if _, err := parseTimeGrain(measure.Window.Period); err != nil {
+					return fmt.Errorf(`window measure %q: invalid "period": %w`, measure.Name, err)
+				}
+			case "rolling":
+				if measure.Window.Size == 0 {
+					return fmt.Errorf(`window measure %q: "rolling" requires a "size" greater than zero`, measure.Name)
+				}
+			case "percent_of_total":
+				if measure.Window.Dimension != "" && !dimensionNames[strings.ToLower(measure.Window.Dimension)] {
+					return fmt.Errorf("window measure %q: dimension %q doesn't exist", measure.Name, measure.Window.Dimension)
+				}
+			default:
+				return fmt.Errorf("window measure %q: invalid kind %q. allowed values: %s", measure.Name, measure.Window.Kind, strings.Join(validWindowKinds, ","))
+			}
+		} else if measure.Window != nil {
+			return fmt.Errorf("measure %q: window can only be set for window measures", measure.Name)
+		}
 	}
 	if measureCount == 0 {
 		return fmt.Errorf("must define at least one measure")
@@ -305,6 +424,77 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 		}
 	}
 
+	if len(tmp.Rollups) > 0 {
+		dims := make(map[string]bool)
+		for _, dim := range tmp.Dimensions {
+			if dim != nil && !dim.Ignore {
+				dims[strings.ToLower(dim.Name)] = !dim.Unnest
+			}
+		}
+		simpleMeasures := make(map[string]bool)
+		for _, measure := range tmp.Measures {
+			if measure != nil && !measure.Ignore {
+				simpleMeasures[strings.ToLower(measure.Name)] = measure.Type == "" || measure.Type == "simple"
+			}
+		}
+
+		rollupNames := make(map[string]bool)
+		for i, rollup := range tmp.Rollups {
+			if rollup == nil {
+				return fmt.Errorf("rollup %d is empty", i)
+			}
+			if rollup.Name == "" {
+				rollup.Name = fmt.Sprintf("rollup_%d", i)
+			}
+			lower := strings.ToLower(rollup.Name)
+			if rollupNames[lower] {
+				return fmt.Errorf("found duplicate rollup name %q", rollup.Name)
+			}
+			rollupNames[lower] = true
+
+			if rollup.TimeGrain != "" {
+				if tmp.TimeDimension == "" {
+					return fmt.Errorf(`rollup %q: cannot set "time_grain" without setting "timeseries"`, rollup.Name)
+				}
+				if _, err := parseTimeGrain(rollup.TimeGrain); err != nil {
+					return fmt.Errorf(`rollup %q: invalid "time_grain": %w`, rollup.Name, err)
+				}
+			}
+
+			seen := make(map[string]bool)
+			for _, dim := range rollup.Dimensions {
+				lower := strings.ToLower(dim)
+				ok, exists := dims[lower]
+				if !exists {
+					return fmt.Errorf("rollup %q: dimension %q doesn't exist", rollup.Name, dim)
+				}
+				if !ok {
+					return fmt.Errorf("rollup %q: unnested dimension %q is not supported", rollup.Name, dim)
+				}
+				if seen[lower] {
+					return fmt.Errorf("rollup %q: dimension %q is duplicated", rollup.Name, dim)
+				}
+				seen[lower] = true
+			}
+
+			seen = make(map[string]bool)
+			for _, measure := range rollup.Measures {
+				lower := strings.ToLower(measure)
+				simple, exists := simpleMeasures[lower]
+				if !exists {
+					return fmt.Errorf("rollup %q: measure %q doesn't exist", rollup.Name, measure)
+				}
+				if !simple {
+					return fmt.Errorf("rollup %q: measure %q is not a simple measure", rollup.Name, measure)
+				}
+				if seen[lower] {
+					return fmt.Errorf("rollup %q: measure %q is duplicated", rollup.Name, measure)
+				}
+				seen[lower] = true
+			}
+		}
+	}
+
 	if tmp.Security != nil {
 		templateData := TemplateData{User: map[string]interface{}{
 			"name":   "dummy",
@@ -385,9 +575,57 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 				}
 			}
 		}
+		for _, mask := range tmp.Security.Mask {
+			if mask == nil || len(mask.Names) == 0 {
+				return fmt.Errorf("invalid 'security': 'mask' fields must have a 'names' list")
+			}
+			switch mask.With {
+			case "hash", "redact", "partial":
+			default:
+				return fmt.Errorf("invalid 'security': 'mask' for field %q must set 'with' to one of 'hash', 'redact' or 'partial'", mask.Names)
+			}
+			for _, name := range mask.Names {
+				var found bool
+				for _, dim := range tmp.Dimensions {
+					if dim == nil || dim.Ignore || !strings.EqualFold(dim.Name, name) {
+						continue
+					}
+					// Masks are applied to the underlying column, so all queries (including filters and row exports) see the masked values
+					if dim.Column == "" || dim.Unnest {
+						return fmt.Errorf("invalid 'security': 'mask' property %q must be a dimension that references a column and is not unnested", name)
+					}
+					// Measures would aggregate the masked values (which are strings), so the column can't be referenced by a measure
+					if dim.Table == "" {
+						for _, measure := range tmp.Measures {
+							if measure != nil && !measure.Ignore && expressionReferencesColumn(measure.Expression, dim.Column) {
+								return fmt.Errorf("invalid 'security': 'mask' property %q references the column %q, which is used by measure %q", name, dim.Column, measure.Name)
+							}
+						}
+					}
+					found = true
+					break
+				}
+				if !found {
+					return fmt.Errorf("invalid 'security': 'mask' property %q does not exists in dimensions list", name)
+				}
+			}
+			if mask.Condition != "" {
+				cond, err := ResolveTemplate(mask.Condition, templateData)
+				if err != nil {
+					return fmt.Errorf(`invalid 'security': 'if' condition templating for field %q is not valid: %w`, mask.Names, err)
+				}
+				_, err = EvaluateBoolExpression(cond)
+				if err != nil {
+					return fmt.Errorf(`invalid 'security': 'if' condition for field %q not evaluating to a boolean: %w`, mask.Names, err)
+				}
+			}
+		}
 	}
 
 	node.Refs = append(node.Refs, ResourceName{Name: table})
+	for _, join := range tmp.Joins {
+		node.Refs = append(node.Refs, ResourceName{Name: join.Table})
+	}
 	if tmp.DefaultTheme != "" {
 		node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindTheme, Name: tmp.DefaultTheme})
 	}
@@ -404,6 +642,7 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 	spec.Title = tmp.Title
 	spec.Description = tmp.Description
 	spec.TimeDimension = tmp.TimeDimension
+	spec.TimeDimensions = tmp.TimeDimensions
 	spec.SmallestTimeGrain = smallestTimeGrain
 	spec.DefaultTimeRange = tmp.DefaultTimeRange
 	spec.AvailableTimeZones = tmp.AvailableTimeZones
@@ -411,6 +650,13 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 	spec.FirstMonthOfYear = tmp.FirstMonthOfYear
 	spec.DefaultTheme = tmp.DefaultTheme
 
+	for _, join := range tmp.Joins {
+		spec.Joins = append(spec.Joins, &runtimev1.MetricsViewSpec_JoinV2{
+			Table: join.Table,
+			On:    join.On,
+		})
+	}
+
 	for _, dim := range tmp.Dimensions {
 		if dim == nil || dim.Ignore {
 			continue
@@ -420,6 +666,7 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 			Name:        dim.Name,
 			Column:      dim.Column,
 			Expression:  dim.Expression,
+			Table:       dim.Table,
 			Label:       dim.Label,
 			Description: dim.Description,
 			Unnest:      dim.Unnest,
@@ -431,9 +678,22 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 			continue
 		}
 
+		var window *runtimev1.MetricsViewSpec_MeasureWindow
+		if measure.Window != nil {
+			period, _ := parseTimeGrain(measure.Window.Period)
+			window = &runtimev1.MetricsViewSpec_MeasureWindow{
+				Kind:      windowKindsMap[measure.Window.Kind],
+				Period:    period,
+				Size:      measure.Window.Size,
+				Dimension: measure.Window.Dimension,
+			}
+		}
+
 		spec.Measures = append(spec.Measures, &runtimev1.MetricsViewSpec_MeasureV2{
 			Name:                measure.Name,
 			Expression:          measure.Expression,
+			Type:                measureTypesMap[measure.Type],
+			Window:              window,
 			Label:               measure.Label,
 			Description:         measure.Description,
 			FormatPreset:        measure.FormatPreset,
@@ -465,6 +725,16 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 		}
 	}
 
+	for _, rollup := range tmp.Rollups {
+		timeGrain, _ := parseTimeGrain(rollup.TimeGrain)
+		spec.Rollups = append(spec.Rollups, &runtimev1.MetricsViewSpec_RollupV2{
+			Name:       rollup.Name,
+			Dimensions: rollup.Dimensions,
+			TimeGrain:  timeGrain,
+			Measures:   rollup.Measures,
+		})
+	}
+
 	if tmp.Security != nil {
 		if spec.Security == nil {
 			spec.Security = &runtimev1.MetricsViewSpec_SecurityV2{}
@@ -488,6 +758,13 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 				})
 			}
 		}
+		for _, mask := range tmp.Security.Mask {
+			spec.Security.Mask = append(spec.Security.Mask, &runtimev1.MetricsViewSpec_SecurityV2_MaskV2{
+				Condition: mask.Condition,
+				Names:     mask.Names,
+				With:      mask.With,
+			})
+		}
 	}
 
 	return nil
@@ -536,3 +813,10 @@ func parseISO8601(isoDuration string) (duration.Duration, error) {
 
 	return d, nil
 }
+
+// expressionReferencesColumn returns true if the SQL expression contains the column name as an identifier (quoted or not, case-insensitive).
+// It may return false positives, such as for string literals that contain the column name.
+func expressionReferencesColumn(expr, column string) bool {
+	re := regexp.MustCompile(`(?i)(^|[^a-z0-9_])` + regexp.QuoteMeta(column) + `($|[^a-z0-9_])`)
+	return re.MatchString(expr)
+}
@@ -12,9 +12,10 @@ import (
 
 // ModelYAML is the raw structure of a Model resource defined in YAML (does not include common fields)
 type ModelYAML struct {
-	Materialize  *bool         `yaml:"materialize" mapstructure:"materialize"`
-	Timeout      string        `yaml:"timeout" mapstructure:"timeout"`
-	Refresh      *ScheduleYAML `yaml:"refresh" mapstructure:"refresh"`
+	Materialize  *bool              `yaml:"materialize" mapstructure:"materialize"`
+	Timeout      string             `yaml:"timeout" mapstructure:"timeout"`
+	Refresh      *ScheduleYAML      `yaml:"refresh" mapstructure:"refresh"`
+	Security     *TableSecurityYAML `yaml:"security" mapstructure:"security"`
 	ParserConfig struct {
 		DuckDB struct {
 			InferRefs *bool `yaml:"infer_refs" mapstructure:"infer_refs"`
@@ -58,6 +59,12 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 		return err
 	}
 
+	// Parse security policy
+	security, err := parseTableSecurity(tmp.Security)
+	if err != nil {
+		return err
+	}
+
 	// Validate SQL
 	if strings.TrimSpace(node.SQL) == "" {
 		return errors.New("no SQL provided")
@@ -115,6 +122,9 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 	if schedule != nil {
 		r.ModelSpec.RefreshSchedule = schedule
 	}
+	if security != nil {
+		r.ModelSpec.Security = security
+	}
 
 	// parseSource calls parseModel for SQL sources without a connector. Materialize such models.
 	if node.Kind == ResourceKindSource && r.ModelSpec.Materialize == nil {
@@ -27,6 +27,8 @@ type Node struct {
 	SQLPath           string
 	SQLAnnotations    map[string]any
 	SQLUsesTemplating bool
+	DataPath          string
+	DataHash          string
 }
 
 // parseNode multiplexes to the appropriate parse function based on the node kind.
@@ -44,6 +46,8 @@ func (p *Parser) parseNode(ctx context.Context, node *Node) error {
 		return p.parseReport(ctx, node)
 	case ResourceKindTheme:
 		return p.parseTheme(ctx, node)
+	case ResourceKindSeed:
+		return p.parseSeed(ctx, node)
 	default:
 		panic(fmt.Errorf("unexpected resource kind: %s", node.Kind.String()))
 	}
@@ -81,6 +85,21 @@ func (p *Parser) parseStem(ctx context.Context, paths []string, ymlPath, yml, sq
 		if err != nil {
 			return nil, pathError{path: ymlPath, err: newYAMLError(err)}
 		}
+
+		// Apply environment-specific overrides.
+		// If there were any, we re-serialize the YAML since some resource parsers decode YAMLRaw.
+		changed, err := applyEnvironmentOverrides(&node, p.Environment)
+		if err != nil {
+			return nil, pathError{path: ymlPath, err: err}
+		}
+		if changed {
+			out, err := yaml.Marshal(&node)
+			if err != nil {
+				return nil, pathError{path: ymlPath, err: err}
+			}
+			yml = string(out)
+		}
+
 		res.YAML = &node
 		res.YAMLRaw = yml
 		res.YAMLPath = ymlPath
@@ -133,6 +152,13 @@ func (p *Parser) parseStem(ctx context.Context, paths []string, ymlPath, yml, sq
 
 	// Parse SQL templating
 	if templatingEnabled && res.SQL != "" {
+		// Expand macros before analyzing the template, since their bodies may contain other template functions
+		var err error
+		res.SQL, err = p.expandMacros(res.SQLPath, res.SQL)
+		if err != nil {
+			return nil, pathError{path: res.SQLPath, err: err}
+		}
+
 		meta, err := AnalyzeTemplate(res.SQL)
 		if err != nil {
 			if sqlPath != "" {
@@ -207,6 +233,8 @@ func (p *Parser) parseStem(ctx context.Context, paths []string, ymlPath, yml, sq
 			res.Name = fileutil.Stem(ymlPath)
 		} else if sqlPath != "" {
 			res.Name = fileutil.Stem(sqlPath)
+		} else {
+			res.Name = fileutil.Stem(paths[0])
 		}
 	}
 
@@ -220,6 +248,8 @@ func (p *Parser) parseStem(ctx context.Context, paths []string, ymlPath, yml, sq
 			res.Kind = ResourceKindMetricsView
 		} else if strings.HasPrefix(paths[0], "/init.sql") {
 			res.Kind = ResourceKindMigration
+		} else if strings.HasPrefix(paths[0], "/seeds") {
+			res.Kind = ResourceKindSeed
 		} else {
 			path := ymlPath
 			if path == "" {
@@ -62,8 +62,17 @@ func (p *Parser) parseRillYAML(ctx context.Context, path string) error {
 		return fmt.Errorf("error loading %q: %w", path, err)
 	}
 
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(data), &node); err != nil {
+		return newYAMLError(err)
+	}
+
+	if _, err := applyEnvironmentOverrides(&node, p.Environment); err != nil {
+		return err
+	}
+
 	tmp := &rillYAML{}
-	if err := yaml.Unmarshal([]byte(data), tmp); err != nil {
+	if err := node.Decode(tmp); err != nil {
 		return newYAMLError(err)
 	}
 
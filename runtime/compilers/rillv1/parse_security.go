@@ -0,0 +1,95 @@
+package rillv1
+
+import (
+	"errors"
+	"fmt"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+)
+
+// TableSecurityYAML is the raw structure of a security policy for a source or model's table.
+// This does not represent a stand-alone YAML file, just a partial used in other structs.
+type TableSecurityYAML struct {
+	Access    string                 `yaml:"access" mapstructure:"access"`
+	RowFilter string                 `yaml:"row_filter" mapstructure:"row_filter"`
+	Include   []*ColumnConditionYAML `yaml:"include" mapstructure:"include"`
+	Exclude   []*ColumnConditionYAML `yaml:"exclude" mapstructure:"exclude"`
+}
+
+// ColumnConditionYAML is the raw structure of a column level access condition in a table security policy.
+type ColumnConditionYAML struct {
+	Condition string   `yaml:"if" mapstructure:"if"`
+	Names     []string `yaml:"names" mapstructure:"names"`
+}
+
+// parseTableSecurity validates a table security policy and converts it to its runtime representation.
+// It returns nil if raw is nil.
+func parseTableSecurity(raw *TableSecurityYAML) (*runtimev1.TableSecurity, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	templateData := TemplateData{User: map[string]interface{}{
+		"name":   "dummy",
+		"email":  "mock@example.org",
+		"domain": "example.org",
+		"groups": []interface{}{"all"},
+		"admin":  false,
+	}}
+
+	if raw.Access != "" {
+		access, err := ResolveTemplate(raw.Access, templateData)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid 'security': 'access' templating is not valid: %w`, err)
+		}
+		_, err = EvaluateBoolExpression(access)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid 'security': 'access' expression error: %w`, err)
+		}
+	}
+
+	if raw.RowFilter != "" {
+		_, err := ResolveTemplate(raw.RowFilter, templateData)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid 'security': 'row_filter' templating is not valid: %w`, err)
+		}
+	}
+
+	if len(raw.Include) > 0 && len(raw.Exclude) > 0 {
+		return nil, errors.New("invalid 'security': only one of 'include' and 'exclude' can be specified")
+	}
+
+	res := &runtimev1.TableSecurity{
+		Access:    raw.Access,
+		RowFilter: raw.RowFilter,
+	}
+
+	for _, field := range []struct {
+		name       string
+		conditions []*ColumnConditionYAML
+		target     *[]*runtimev1.TableSecurity_ColumnCondition
+	}{
+		{"include", raw.Include, &res.Include},
+		{"exclude", raw.Exclude, &res.Exclude},
+	} {
+		for _, c := range field.conditions {
+			if c == nil || len(c.Names) == 0 || c.Condition == "" {
+				return nil, fmt.Errorf("invalid 'security': %q fields must have a valid 'if' condition and 'names' list", field.name)
+			}
+			cond, err := ResolveTemplate(c.Condition, templateData)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid 'security': 'if' condition templating for columns %q is not valid: %w`, c.Names, err)
+			}
+			_, err = EvaluateBoolExpression(cond)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid 'security': 'if' condition for columns %q not evaluating to a boolean: %w`, c.Names, err)
+			}
+			*field.target = append(*field.target, &runtimev1.TableSecurity_ColumnCondition{
+				Condition: c.Condition,
+				Names:     c.Names,
+			})
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,78 @@
+package rillv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// seedDataExtensions are the file extensions of data files in the seeds/ directory.
+var seedDataExtensions = []string{".csv", ".json", ".ndjson", ".parquet"}
+
+// seedColumnTypeRegex matches valid column types for seeds, e.g. "VARCHAR", "DECIMAL(10, 2)" or "INTEGER[]".
+var seedColumnTypeRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_ ]*(\(\s*\d+\s*(,\s*\d+\s*)?\))?(\[\])?$`)
+
+// SeedYAML is the raw structure of the YAML file accompanying a seed's data file (does not include common fields)
+type SeedYAML struct {
+	commonYAML `yaml:",inline" mapstructure:",squash"` // Only to avoid loading common fields into Columns
+	Columns    map[string]string                       `yaml:"columns"`
+}
+
+// parseSeed parses a seed, which is a data file in the seeds/ directory with an optional YAML file at the same stem.
+func (p *Parser) parseSeed(ctx context.Context, node *Node) error {
+	// Parse YAML
+	tmp := &SeedYAML{}
+	if node.YAML != nil {
+		if err := node.YAML.Decode(tmp); err != nil {
+			return pathError{path: node.YAMLPath, err: newYAMLError(err)}
+		}
+	}
+
+	// Seeds are loaded from a data file, not SQL
+	if node.SQL != "" {
+		return fmt.Errorf("seeds do not support SQL")
+	}
+	if node.DataPath == "" {
+		return errors.New("seed data file not found (expected a CSV, JSON or Parquet file with the same name)")
+	}
+
+	// Validate the column type overrides
+	for col, typ := range tmp.Columns {
+		if !seedColumnTypeRegex.MatchString(strings.TrimSpace(typ)) {
+			return pathError{path: node.YAMLPath, err: fmt.Errorf("invalid type %q for column %q", typ, col)}
+		}
+	}
+
+	var format string
+	switch filepath.Ext(node.DataPath) {
+	case ".csv":
+		format = "csv"
+	case ".json", ".ndjson":
+		format = "json"
+	case ".parquet":
+		format = "parquet"
+	}
+
+	// Insert the seed
+	r, err := p.insertResource(ResourceKindSeed, node.Name, node.Paths, node.Refs...)
+	if err != nil {
+		return err
+	}
+	// NOTE: After calling insertResource, an error must not be returned. Any validation should be done before calling it.
+
+	r.SeedSpec.Connector = node.Connector
+	r.SeedSpec.Path = node.DataPath
+	r.SeedSpec.Format = format
+	r.SeedSpec.ContentHash = node.DataHash
+	if len(tmp.Columns) > 0 {
+		r.SeedSpec.ColumnTypes = make(map[string]string, len(tmp.Columns))
+		for col, typ := range tmp.Columns {
+			r.SeedSpec.ColumnTypes[col] = strings.TrimSpace(typ)
+		}
+	}
+
+	return nil
+}
@@ -22,6 +22,7 @@ type SourceYAML struct {
 	Type       string                                  `yaml:"type"` // Backwards compatibility
 	Timeout    string                                  `yaml:"timeout"`
 	Refresh    *ScheduleYAML                           `yaml:"refresh"`
+	Security   *TableSecurityYAML                      `yaml:"security" mapstructure:"security"`
 	Properties map[string]any                          `yaml:",inline" mapstructure:",remain"`
 }
 
@@ -95,6 +96,11 @@ func (p *Parser) parseSource(ctx context.Context, node *Node) error {
 		return fmt.Errorf("encountered invalid property type: %w", err)
 	}
 
+	security, err := parseTableSecurity(tmp.Security)
+	if err != nil {
+		return err
+	}
+
 	// Track source
 	r, err := p.insertResource(ResourceKindSource, node.Name, node.Paths, node.Refs...)
 	if err != nil {
@@ -113,6 +119,9 @@ func (p *Parser) parseSource(ctx context.Context, node *Node) error {
 	if schedule != nil {
 		r.SourceSpec.RefreshSchedule = schedule
 	}
+	if security != nil {
+		r.SourceSpec.Security = security
+	}
 
 	return nil
 }
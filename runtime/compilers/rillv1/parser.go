@@ -2,6 +2,8 @@ package rillv1
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -16,8 +18,9 @@ import (
 
 // Built-in parser limits
 var (
-	maxFiles    = 10000
-	maxFileSize = 1 << 17 // 128kb
+	maxFiles        = 10000
+	maxFileSize     = 1 << 17 // 128kb
+	maxSeedFileSize = 1 << 23 // 8mb
 )
 
 // Resource parsed from code files.
@@ -36,6 +39,7 @@ type Resource struct {
 	MigrationSpec   *runtimev1.MigrationSpec
 	ReportSpec      *runtimev1.ReportSpec
 	ThemeSpec       *runtimev1.ThemeSpec
+	SeedSpec        *runtimev1.SeedSpec
 }
 
 // ResourceName is a unique identifier for a resource
@@ -66,6 +70,7 @@ const (
 	ResourceKindMigration
 	ResourceKindReport
 	ResourceKindTheme
+	ResourceKindSeed
 )
 
 // ParseResourceKind maps a string to a ResourceKind.
@@ -86,6 +91,8 @@ func ParseResourceKind(kind string) (ResourceKind, error) {
 		return ResourceKindReport, nil
 	case "theme":
 		return ResourceKindTheme, nil
+	case "seed":
+		return ResourceKindSeed, nil
 	default:
 		return ResourceKindUnspecified, fmt.Errorf("invalid resource kind %q", kind)
 	}
@@ -107,6 +114,8 @@ func (k ResourceKind) String() string {
 		return "Report"
 	case ResourceKindTheme:
 		return "Theme"
+	case ResourceKindSeed:
+		return "Seed"
 	default:
 		panic(fmt.Sprintf("unexpected resource kind: %d", k))
 	}
@@ -129,6 +138,7 @@ type Parser struct {
 	// Options
 	Repo             drivers.RepoStore
 	InstanceID       string
+	Environment      string
 	DefaultConnector string
 	DuckDBConnectors []string
 
@@ -139,8 +149,10 @@ type Parser struct {
 	Errors    []*runtimev1.ParseError
 
 	// Internal state
-	resourcesForPath           map[string][]*Resource // Reverse index of Resource.Paths
-	resourcesForUnspecifiedRef map[string][]*Resource // Reverse index of Resource.rawRefs where kind=ResourceKindUnspecified
+	resourcesForPath           map[string][]*Resource     // Reverse index of Resource.Paths
+	resourcesForUnspecifiedRef map[string][]*Resource     // Reverse index of Resource.rawRefs where kind=ResourceKindUnspecified
+	macros                     map[string]*macro          // Macros by lowercase name
+	pathsForMacro              map[string]map[string]bool // Paths that call a macro, by lowercase macro name (may contain stale paths)
 	insertedResources          []*Resource
 	updatedResources           []*Resource
 	deletedResources           []*Resource
@@ -167,13 +179,15 @@ func ParseRillYAML(ctx context.Context, repo drivers.RepoStore, instanceID strin
 }
 
 // Parse creates a new parser and parses the entire project.
+// The environment (e.g. "dev" or "prod") selects the environment-specific overrides to apply. It may be empty.
 //
 // Note on SQL parsing: For DuckDB SQL specifically, the parser can use a SQL parser to extract refs and annotations (instead of relying on templating or YAML).
 // To enable SQL parsing for a connector, pass it in duckDBConnectors. If DuckDB SQL parsing should be used on files where no connector is specified, put the defaultConnector in duckDBConnectors.
-func Parse(ctx context.Context, repo drivers.RepoStore, instanceID, defaultConnector string, duckDBConnectors []string) (*Parser, error) {
+func Parse(ctx context.Context, repo drivers.RepoStore, instanceID, environment, defaultConnector string, duckDBConnectors []string) (*Parser, error) {
 	p := &Parser{
 		Repo:             repo,
 		InstanceID:       instanceID,
+		Environment:      environment,
 		DefaultConnector: defaultConnector,
 		DuckDBConnectors: duckDBConnectors,
 	}
@@ -223,6 +237,8 @@ func (p *Parser) reload(ctx context.Context) error {
 	p.Errors = nil
 	p.resourcesForPath = make(map[string][]*Resource)
 	p.resourcesForUnspecifiedRef = make(map[string][]*Resource)
+	p.macros = make(map[string]*macro)
+	p.pathsForMacro = make(map[string]map[string]bool)
 	p.insertedResources = nil
 	p.updatedResources = nil
 	p.deletedResources = nil
@@ -232,6 +248,11 @@ func (p *Parser) reload(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("could not list project files: %w", err)
 	}
+	seedPaths, err := p.Repo.ListRecursive(ctx, "seeds/**/*.{csv,json,ndjson,parquet}")
+	if err != nil {
+		return fmt.Errorf("could not list seed files: %w", err)
+	}
+	paths = append(paths, seedPaths...)
 
 	// Parse all files
 	err = p.parsePaths(ctx, paths)
@@ -289,11 +310,12 @@ func (p *Parser) reparseExceptRillYAML(ctx context.Context, paths []string) (*Di
 		}
 		seenPaths[path] = true
 
-		// Skip files that aren't SQL or YAML or .env file
+		// Skip files that aren't SQL or YAML or .env or seed data files
 		isSQL := strings.HasSuffix(path, ".sql")
 		isYAML := strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml")
 		isDotEnv := pathIsDotEnv(path)
-		if !isSQL && !isYAML && !isDotEnv {
+		isSeedData := pathIsSeedData(path)
+		if !isSQL && !isYAML && !isDotEnv && !isSeedData {
 			continue
 		}
 
@@ -311,14 +333,32 @@ func (p *Parser) reparseExceptRillYAML(ctx context.Context, paths []string) (*Di
 			p.DotEnv = nil
 		}
 
+		// If path is a macro, clear it and reparse all paths that call it
+		if pathIsMacro(path) {
+			name := strings.ToLower(pathStem(path[strings.LastIndexByte(path, '/')+1:]))
+			if m, ok := p.macros[name]; ok && m.path == path {
+				delete(p.macros, name)
+			}
+			for callerPath := range p.pathsForMacro[name] {
+				checkPaths = append(checkPaths, callerPath)
+			}
+			delete(p.pathsForMacro, name)
+		}
+
 		// Since .sql and .yaml files provide context for each other, if one was modified, we need to reparse both.
 		// For cases where a file was modified or deleted, the transitive check through resourcesForPath will already take of that.
 		// But this ensures the check also happens for cases where a companion file was added.
+		// The same applies to seed data files and their YAML files.
 		stem := pathStem(path)
-		if isSQL {
+		if isSQL || isSeedData {
 			checkPaths = append(checkPaths, stem+".yaml", stem+".yml")
 		} else if isYAML {
 			checkPaths = append(checkPaths, stem+".sql")
+			if pathIsSeedData(stem + ".csv") {
+				for _, ext := range seedDataExtensions {
+					checkPaths = append(checkPaths, stem+ext)
+				}
+			}
 		}
 
 		// Remove all resources derived from this path, and add any related paths to the check list
@@ -429,7 +469,8 @@ func (p *Parser) parsePaths(ctx context.Context, paths []string) error {
 	}
 
 	// Sort paths such that a) we always parse rill.yaml first (to pick up defaults),
-	// and b) we align files with the same name but different extensions next to each other.
+	// b) we parse macros before the files that call them,
+	// and c) we align files with the same name but different extensions next to each other.
 	slices.SortFunc(paths, func(a, b string) int {
 		if pathIsRillYAML(a) {
 			return -1
@@ -437,6 +478,12 @@ func (p *Parser) parsePaths(ctx context.Context, paths []string) error {
 		if pathIsRillYAML(b) {
 			return 1
 		}
+		if pathIsMacro(a) != pathIsMacro(b) {
+			if pathIsMacro(a) {
+				return -1
+			}
+			return 1
+		}
 		return strings.Compare(a, b)
 	})
 
@@ -460,6 +507,16 @@ func (p *Parser) parsePaths(ctx context.Context, paths []string) error {
 			}
 			i++
 			continue
+		} else if pathIsMacro(path) {
+			err := p.parseMacro(ctx, path)
+			if err != nil {
+				p.addParseError(path, err, false)
+			}
+			i++
+			continue
+		} else if pathIsModelTest(path) {
+			i++
+			continue
 		}
 
 		// Identify the range of paths with the same stem as paths[i]
@@ -506,13 +563,35 @@ func (p *Parser) parsePaths(ctx context.Context, paths []string) error {
 		p.replaceResourceWithError(n, fmt.Errorf("model name collides with source %q", s), true)
 	}
 
+	// Seeds are also loaded into tables, so similarly check that they don't collide with sources or models.
+	// NOTE: We always attach the error to the seed.
+	seedsWithNameErrs := make(map[ResourceName]ResourceName)
+	for _, r := range p.insertedResources {
+		switch r.Name.Kind {
+		case ResourceKindSeed:
+			for _, k := range []ResourceKind{ResourceKindSource, ResourceKindModel} {
+				if r2, ok := p.Resources[ResourceName{Kind: k, Name: r.Name.Name}.Normalized()]; ok {
+					seedsWithNameErrs[r.Name.Normalized()] = r2.Name
+				}
+			}
+		case ResourceKindSource, ResourceKindModel:
+			n := ResourceName{Kind: ResourceKindSeed, Name: r.Name.Name}.Normalized()
+			if _, ok := p.Resources[n]; ok {
+				seedsWithNameErrs[n] = r.Name
+			}
+		}
+	}
+	for n, other := range seedsWithNameErrs {
+		p.replaceResourceWithError(n, fmt.Errorf("seed name collides with %s %q", strings.ToLower(other.Kind.String()), other.Name), true)
+	}
+
 	return nil
 }
 
 // parseStem parses a set of paths with the same stem (path without extension).
 func (p *Parser) parseStemPaths(ctx context.Context, paths []string) error {
 	// Load YAML and SQL contents
-	var yaml, yamlPath, sql, sqlPath string
+	var yaml, yamlPath, sql, sqlPath, dataPath, dataHash string
 	var found bool
 	for _, path := range paths {
 		// Load contents
@@ -526,6 +605,29 @@ func (p *Parser) parseStemPaths(ctx context.Context, paths []string) error {
 			return err
 		}
 
+		// Seed data files have a separate size limit and are only hashed (the data is loaded by the seed reconciler)
+		if pathIsSeedData(path) {
+			if len(data) > maxSeedFileSize {
+				p.Errors = append(p.Errors, &runtimev1.ParseError{
+					Message:  fmt.Sprintf("size %d bytes exceeds max size of %d bytes for seeds", len(data), maxSeedFileSize),
+					FilePath: path,
+				})
+				continue
+			}
+			if dataPath != "" {
+				p.Errors = append(p.Errors, &runtimev1.ParseError{
+					Message:  "skipping file because another seed data file has already been parsed for this path stem",
+					FilePath: path,
+				})
+				continue
+			}
+			hash := sha256.Sum256([]byte(data))
+			dataPath = path
+			dataHash = hex.EncodeToString(hash[:])
+			found = true
+			continue
+		}
+
 		// Check size
 		if len(data) > maxFileSize {
 			p.Errors = append(p.Errors, &runtimev1.ParseError{
@@ -567,6 +669,8 @@ func (p *Parser) parseStemPaths(ctx context.Context, paths []string) error {
 	// Parse the SQL/YAML file pair to a Node, then parse the Node to p.Resources.
 	node, err := p.parseStem(ctx, paths, yamlPath, yaml, sqlPath, sql)
 	if err == nil {
+		node.DataPath = dataPath
+		node.DataHash = dataHash
 		err = p.parseNode(ctx, node)
 	}
 
@@ -609,16 +713,21 @@ func (p *Parser) inferUnspecifiedRefs(r *Resource) {
 			continue
 		}
 
-		// Rule 1: If it's a model and there's a source with that name, use it
+		// Rule 1: If it's a model and there's a source or seed with that name, use it
 		if r.Name.Kind == ResourceKindModel {
 			n := ResourceName{Kind: ResourceKindSource, Name: ref.Name}
 			if _, ok := p.Resources[n.Normalized()]; ok {
 				refs = append(refs, n)
 				continue
 			}
+			n = ResourceName{Kind: ResourceKindSeed, Name: ref.Name}
+			if _, ok := p.Resources[n.Normalized()]; ok {
+				refs = append(refs, n)
+				continue
+			}
 		}
 
-		// Rule 2: If it's a metrics view and there's a model or source with that name, use it
+		// Rule 2: If it's a metrics view and there's a model, source or seed with that name, use it
 		if r.Name.Kind == ResourceKindMetricsView {
 			n := ResourceName{Kind: ResourceKindModel, Name: ref.Name}
 			if _, ok := p.Resources[n.Normalized()]; ok {
@@ -630,6 +739,11 @@ func (p *Parser) inferUnspecifiedRefs(r *Resource) {
 				refs = append(refs, n)
 				continue
 			}
+			n = ResourceName{Kind: ResourceKindSeed, Name: ref.Name}
+			if _, ok := p.Resources[n.Normalized()]; ok {
+				refs = append(refs, n)
+				continue
+			}
 		}
 
 		// Rule 3: If it's a model and there's another model with that name, use it
@@ -704,6 +818,8 @@ func (p *Parser) insertResource(kind ResourceKind, name string, paths []string,
 		r.ReportSpec = &runtimev1.ReportSpec{}
 	case ResourceKindTheme:
 		r.ThemeSpec = &runtimev1.ThemeSpec{}
+	case ResourceKindSeed:
+		r.SeedSpec = &runtimev1.SeedSpec{}
 	default:
 		panic(fmt.Errorf("unexpected resource kind: %s", kind.String()))
 	}
@@ -822,6 +938,25 @@ func pathIsRillYAML(path string) bool {
 	return path == "/rill.yaml" || path == "/rill.yml"
 }
 
+// pathIsSeedData returns true if the path is a data file in the seeds/ directory.
+func pathIsSeedData(path string) bool {
+	if !strings.HasPrefix(path, "/seeds/") {
+		return false
+	}
+	for _, ext := range seedDataExtensions {
+		if strings.HasSuffix(path, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathIsModelTest returns true if the path is in the tests/ directory, which contains model tests (see runtime/modeltest).
+// Model tests are not resources, so the parser ignores them.
+func pathIsModelTest(path string) bool {
+	return strings.HasPrefix(path, "/tests/")
+}
+
 // pathIsDotEnv returns true if the path is .env
 func pathIsDotEnv(path string) bool {
 	return path == "/.env"
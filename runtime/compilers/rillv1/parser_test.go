@@ -2,6 +2,8 @@ package rillv1
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"maps"
 	"slices"
@@ -219,7 +221,7 @@ SELECT * FROM {{ ref "m2" }}
 
 	ctx := context.Background()
 	repo := makeRepo(t, files)
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, resources, nil)
 }
@@ -258,7 +260,7 @@ FRO m1
 
 	ctx := context.Background()
 	repo := makeRepo(t, files)
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, nil, errors)
 }
@@ -297,7 +299,7 @@ SELECT 1
 
 	ctx := context.Background()
 	repo := makeRepo(t, files)
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, resources, errors)
 }
@@ -309,7 +311,7 @@ func TestReparse(t *testing.T) {
 
 	// Create empty project
 	repo := makeRepo(t, map[string]string{`rill.yaml`: ``})
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, nil, nil)
 
@@ -454,7 +456,7 @@ SELECT 10
 			Sql: "SELECT 10",
 		},
 	}
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, []*Resource{m1}, nil)
 
@@ -534,7 +536,7 @@ SELECT * FROM m1
 			Sql: "SELECT * FROM m1",
 		},
 	}
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, []*Resource{m1, m2}, []*runtimev1.ParseError{
 		{
@@ -581,7 +583,7 @@ path: hello
 		},
 	}
 
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, []*Resource{src}, []*runtimev1.ParseError{
 		{
@@ -630,7 +632,7 @@ func TestReparseRillYAML(t *testing.T) {
 	}
 
 	// Parse empty project. Expect rill.yaml error.
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	require.Nil(t, p.RillYAML)
 	requireResourcesAndErrors(t, p, nil, []*runtimev1.ParseError{perr})
@@ -688,7 +690,7 @@ func TestRefInferrence(t *testing.T) {
 		// model foo
 		`models/foo.sql`: `SELECT * FROM bar`,
 	})
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, []*Resource{foo}, nil)
 
@@ -763,7 +765,7 @@ materialize: true
 		},
 	}
 	repo := makeRepo(b, files)
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(b, err)
 	requireResourcesAndErrors(b, p, resources, nil)
 
@@ -820,7 +822,7 @@ SELECT * FROM t2
 	}
 
 	repo := makeRepo(t, files)
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, resources, nil)
 }
@@ -905,7 +907,7 @@ security:
 		},
 	}
 
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, resources, nil)
 }
@@ -960,7 +962,7 @@ annotations:
 		},
 	}
 
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, resources, nil)
 }
@@ -998,11 +1000,521 @@ measures:
 		},
 	}
 
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestMetricsViewDerivedAndWindowMeasures(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+model: m1
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: revenue
+    expression: sum(amount)
+  - name: orders
+    type: simple
+    expression: count(*)
+  - name: aov
+    type: derived
+    expression: revenue / orders
+  - name: revenue_mtd
+    expression: revenue
+    window:
+      kind: running_sum
+      period: month
+`,
+		`dashboards/d2.yaml`: `
+model: m1
+measures:
+  - name: revenue
+    type: ratio
+    expression: sum(amount)
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Table: "m1",
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "a", Column: "a"},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "revenue", Expression: "sum(amount)"},
+					{Name: "orders", Expression: "count(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+					{Name: "aov", Expression: "revenue / orders", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED},
+					{
+						Name:       "revenue_mtd",
+						Expression: "revenue",
+						Type:       runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW,
+						Window: &runtimev1.MetricsViewSpec_MeasureWindow{
+							Kind:   runtimev1.MetricsViewSpec_WINDOW_KIND_RUNNING_SUM,
+							Period: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `invalid type "ratio" for measure "revenue"`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestMetricsViewJoins(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+model: orders
+joins:
+  - table: dim_customers
+    on: customer_id
+dimensions:
+  - name: country
+    column: country
+  - name: segment
+    column: segment
+    table: dim_customers
+measures:
+  - name: revenue
+    expression: sum(amount)
+`,
+		`dashboards/d2.yaml`: `
+model: orders
+dimensions:
+  - name: segment
+    column: segment
+    table: dim_customers
+measures:
+  - name: revenue
+    expression: sum(amount)
+`,
+		`dashboards/d3.yaml`: `
+model: orders
+joins:
+  - table: dim_customers
+dimensions:
+  - name: country
+    column: country
+measures:
+  - name: revenue
+    expression: sum(amount)
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Table: "orders",
+				Joins: []*runtimev1.MetricsViewSpec_JoinV2{
+					{Table: "dim_customers", On: "customer_id"},
+				},
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "country", Column: "country"},
+					{Name: "segment", Column: "segment", Table: "dim_customers"},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "revenue", Expression: "sum(amount)"},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `table "dim_customers" for dimension "segment" is not declared in joins`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+		{
+			Message:  `each join must have a "table" and an "on" column`,
+			FilePath: "/dashboards/d3.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestTableSecurity(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`sources/s1.yaml`: `
+connector: s3
+path: hello
+security:
+  row_filter: "domain = '{{ .user.domain }}'"
+`,
+		`models/m1.sql`: `SELECT * FROM s1`,
+		`models/m1.yaml`: `
+security:
+  row_filter: "region = 'emea'"
+`,
+		`models/m2.sql`: `SELECT * FROM s1`,
+		`models/m2.yaml`: `
+security:
+  include:
+    - if: true
+      names: [a]
+  exclude:
+    - if: true
+      names: [b]
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindSource, Name: "s1"},
+			Paths: []string{"/sources/s1.yaml"},
+			SourceSpec: &runtimev1.SourceSpec{
+				SourceConnector: "s3",
+				Properties:      must(structpb.NewStruct(map[string]any{"path": "hello"})),
+				Security: &runtimev1.TableSecurity{
+					RowFilter: "domain = '{{ .user.domain }}'",
+				},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/models/m1.sql", "/models/m1.yaml"},
+			Refs:  []ResourceName{{Name: "s1"}},
+			ModelSpec: &runtimev1.ModelSpec{
+				Sql: "SELECT * FROM s1",
+				Security: &runtimev1.TableSecurity{
+					RowFilter: "region = 'emea'",
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `only one of 'include' and 'exclude' can be specified`,
+			FilePath: "/models/m2.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestMetricsViewSecurityMask(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+model: users
+dimensions:
+  - name: email
+    column: email
+  - name: country
+    column: country
+measures:
+  - name: count
+    expression: count(*)
+security:
+  mask:
+    - names: [email]
+      with: hash
+`,
+		`dashboards/d2.yaml`: `
+model: users
+dimensions:
+  - name: email
+    column: email
+measures:
+  - name: count
+    expression: count(*)
+security:
+  mask:
+    - names: [email]
+      with: scramble
+`,
+		`dashboards/d3.yaml`: `
+model: users
+dimensions:
+  - name: domain
+    expression: split_part(email, '@', 2)
+measures:
+  - name: count
+    expression: count(*)
+security:
+  mask:
+    - names: [domain]
+      with: redact
+`,
+		`dashboards/d4.yaml`: `
+model: users
+dimensions:
+  - name: email
+    column: email
+measures:
+  - name: count
+    expression: count(*)
+security:
+  mask:
+    - names: [count]
+      with: partial
+`,
+		`dashboards/d5.yaml`: `
+model: orders
+dimensions:
+  - name: amount
+    column: amount
+measures:
+  - name: revenue
+    expression: SUM("Amount")
+security:
+  mask:
+    - names: [amount]
+      with: redact
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Table: "users",
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "email", Column: "email"},
+					{Name: "country", Column: "country"},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "count", Expression: "count(*)"},
+				},
+				Security: &runtimev1.MetricsViewSpec_SecurityV2{
+					Mask: []*runtimev1.MetricsViewSpec_SecurityV2_MaskV2{
+						{Names: []string{"email"}, With: "hash"},
+					},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `must set 'with' to one of 'hash', 'redact' or 'partial'`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+		{
+			Message:  `'mask' property "domain" must be a dimension that references a column`,
+			FilePath: "/dashboards/d3.yaml",
+		},
+		{
+			Message:  `'mask' property "count" does not exists in dimensions list`,
+			FilePath: "/dashboards/d4.yaml",
+		},
+		{
+			Message:  `'mask' property "amount" references the column "amount", which is used by measure "revenue"`,
+			FilePath: "/dashboards/d5.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestMetricsViewTimeDimensions(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+model: orders
+timeseries: ordered_at
+time_dimensions:
+  - shipped_at
+  - delivered_at
+measures:
+  - name: orders
+    expression: count(*)
+`,
+		`dashboards/d2.yaml`: `
+model: orders
+time_dimensions:
+  - shipped_at
+measures:
+  - name: orders
+    expression: count(*)
+`,
+		`dashboards/d3.yaml`: `
+model: orders
+timeseries: ordered_at
+time_dimensions:
+  - ordered_at
+measures:
+  - name: orders
+    expression: count(*)
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Table:          "orders",
+				TimeDimension:  "ordered_at",
+				TimeDimensions: []string{"shipped_at", "delivered_at"},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "orders", Expression: "count(*)"},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `cannot set "time_dimensions" without setting "timeseries"`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+		{
+			Message:  `invalid or duplicate time dimension "ordered_at"`,
+			FilePath: "/dashboards/d3.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestMetricsViewRollups(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+model: orders
+timeseries: ordered_at
+dimensions:
+  - column: country
+  - column: tags
+    unnest: true
+measures:
+  - name: revenue
+    expression: sum(amount)
+  - name: orders
+    expression: count(*)
+rollups:
+  - time_grain: day
+    dimensions: [country]
+  - name: monthly
+    time_grain: month
+    measures: [revenue]
+`,
+		`dashboards/d2.yaml`: `
+model: orders
+dimensions:
+  - column: country
+measures:
+  - name: orders
+    expression: count(*)
+rollups:
+  - time_grain: day
+`,
+		`dashboards/d3.yaml`: `
+model: orders
+dimensions:
+  - column: tags
+    unnest: true
+measures:
+  - name: orders
+    expression: count(*)
+rollups:
+  - dimensions: [tags]
+`,
+		`dashboards/d4.yaml`: `
+model: orders
+measures:
+  - name: orders
+    expression: count(*)
+  - name: double_orders
+    expression: orders * 2
+    type: derived
+rollups:
+  - measures: [double_orders]
+`,
+		`dashboards/d5.yaml`: `
+model: orders
+measures:
+  - name: orders
+    expression: count(*)
+rollups:
+  - name: r
+  - name: R
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Table:         "orders",
+				TimeDimension: "ordered_at",
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "country", Column: "country"},
+					{Name: "tags", Column: "tags", Unnest: true},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "revenue", Expression: "sum(amount)"},
+					{Name: "orders", Expression: "count(*)"},
+				},
+				Rollups: []*runtimev1.MetricsViewSpec_RollupV2{
+					{Name: "rollup_0", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY, Dimensions: []string{"country"}},
+					{Name: "monthly", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH, Measures: []string{"revenue"}},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `rollup "rollup_0": cannot set "time_grain" without setting "timeseries"`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+		{
+			Message:  `rollup "rollup_0": unnested dimension "tags" is not supported`,
+			FilePath: "/dashboards/d3.yaml",
+		},
+		{
+			Message:  `rollup "rollup_0": measure "double_orders" is not a simple measure`,
+			FilePath: "/dashboards/d4.yaml",
+		},
+		{
+			Message:  `found duplicate rollup name "R"`,
+			FilePath: "/dashboards/d5.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func TestTheme(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1037,11 +1549,315 @@ colors:
 		},
 	}
 
-	p, err := Parse(ctx, repo, "", "", []string{""})
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
 	require.NoError(t, err)
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestEnvironmentOverrides(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: `
+env:
+  limit: 100
+  foo: bar
+dev:
+  env:
+    limit: 10
+`,
+		`sources/s1.yaml`: `
+connector: s3
+path: s3://bucket/*.parquet
+dev:
+  path: s3://bucket/sample.parquet
+environment_overrides:
+  staging:
+    connector: gcs
+    path: gs://bucket/*.parquet
+`,
+	})
+
+	cases := []struct {
+		env       string
+		connector string
+		path      string
+		limit     string
+	}{
+		{env: "", connector: "s3", path: "s3://bucket/*.parquet", limit: "100"},
+		{env: "prod", connector: "s3", path: "s3://bucket/*.parquet", limit: "100"},
+		{env: "dev", connector: "s3", path: "s3://bucket/sample.parquet", limit: "10"},
+		{env: "staging", connector: "gcs", path: "gs://bucket/*.parquet", limit: "100"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.env, func(t *testing.T) {
+			p, err := Parse(ctx, repo, "", tc.env, "", []string{""})
+			require.NoError(t, err)
+
+			vars := map[string]string{}
+			for _, v := range p.RillYAML.Variables {
+				vars[v.Name] = v.Default
+			}
+			require.Equal(t, map[string]string{"limit": tc.limit, "foo": "bar"}, vars)
+
+			requireResourcesAndErrors(t, p, []*Resource{
+				{
+					Name:  ResourceName{Kind: ResourceKindSource, Name: "s1"},
+					Paths: []string{"/sources/s1.yaml"},
+					SourceSpec: &runtimev1.SourceSpec{
+						SourceConnector: tc.connector,
+						Properties:      must(structpb.NewStruct(map[string]any{"path": tc.path})),
+					},
+				},
+			}, nil)
+		})
+	}
+}
+
+func TestMacros(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`macros/to_usd.sql`: `
+-- @params: amount, currency
+{{ .amount }} * (SELECT rate FROM {{ ref "fx_rates" }} WHERE currency = {{ .currency }})
+`,
+		`sources/s1.sql`: `
+-- @connector: postgres
+SELECT {{ macro "to_usd" "price" "currency" }} AS price_usd FROM orders
+`,
+	})
+
+	s1 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindSource, Name: "s1"},
+		Paths: []string{"/sources/s1.sql"},
+		SourceSpec: &runtimev1.SourceSpec{
+			SourceConnector: "postgres",
+			Properties: must(structpb.NewStruct(map[string]any{
+				"sql": `-- @connector: postgres
+SELECT price * (SELECT rate FROM {{ref "fx_rates"}} WHERE currency = currency) AS price_usd FROM orders`,
+			})),
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{s1}, nil)
+
+	// Editing the macro reparses the source
+	putRepo(t, repo, map[string]string{
+		`macros/to_usd.sql`: `
+-- @params: amount, currency
+{{ .amount }} * usd_rate({{ .currency }})
+`,
+	})
+	s1.SourceSpec.Properties = must(structpb.NewStruct(map[string]any{
+		"sql": `-- @connector: postgres
+SELECT price * usd_rate(currency) AS price_usd FROM orders`,
+	}))
+	diff, err := p.Reparse(ctx, []string{"/macros/to_usd.sql"})
+	require.NoError(t, err)
+	require.Equal(t, &Diff{Modified: []ResourceName{s1.Name}}, diff)
+	requireResourcesAndErrors(t, p, []*Resource{s1}, nil)
+
+	// Deleting the macro fails the source
+	deleteRepo(t, repo, "macros/to_usd.sql")
+	diff, err = p.Reparse(ctx, []string{"/macros/to_usd.sql"})
+	require.NoError(t, err)
+	require.Equal(t, &Diff{Deleted: []ResourceName{s1.Name}}, diff)
+	requireResourcesAndErrors(t, p, nil, []*runtimev1.ParseError{
+		{Message: `macro "to_usd" not found`, FilePath: "/sources/s1.sql"},
+	})
+
+	// Wrong number of arguments
+	putRepo(t, repo, map[string]string{
+		`macros/to_usd.sql`: `
+-- @params: amount
+{{ .amount }} * 2
+`,
+	})
+	diff, err = p.Reparse(ctx, []string{"/macros/to_usd.sql"})
+	require.NoError(t, err)
+	require.Equal(t, &Diff{}, diff)
+	requireResourcesAndErrors(t, p, nil, []*runtimev1.ParseError{
+		{Message: `macro "to_usd" takes 1 arguments, but got 2`, FilePath: "/sources/s1.sql"},
+	})
+
+	// Macros can call other macros with their parameters
+	putRepo(t, repo, map[string]string{
+		`macros/to_usd.sql`: `
+-- @params: amount, currency
+{{ macro "round" .amount }} * usd_rate({{ .currency }})
+`,
+		`macros/round.sql`: `
+-- @params: value
+round({{ .value }}, 2)
+`,
+	})
+	s1.SourceSpec.Properties = must(structpb.NewStruct(map[string]any{
+		"sql": `-- @connector: postgres
+SELECT round(price, 2) * usd_rate(currency) AS price_usd FROM orders`,
+	}))
+	diff, err = p.Reparse(ctx, []string{"/macros/to_usd.sql", "/macros/round.sql"})
+	require.NoError(t, err)
+	require.Equal(t, &Diff{Added: []ResourceName{s1.Name}}, diff)
+	requireResourcesAndErrors(t, p, []*Resource{s1}, nil)
+
+	// Editing a nested macro reparses the source
+	putRepo(t, repo, map[string]string{
+		`macros/round.sql`: `
+-- @params: value
+round({{ .value }}, 4)
+`,
+	})
+	s1.SourceSpec.Properties = must(structpb.NewStruct(map[string]any{
+		"sql": `-- @connector: postgres
+SELECT round(price, 4) * usd_rate(currency) AS price_usd FROM orders`,
+	}))
+	diff, err = p.Reparse(ctx, []string{"/macros/round.sql"})
+	require.NoError(t, err)
+	require.Equal(t, &Diff{Modified: []ResourceName{s1.Name}}, diff)
+	requireResourcesAndErrors(t, p, []*Resource{s1}, nil)
+
+	// Macros that call themselves fail
+	putRepo(t, repo, map[string]string{
+		`macros/round.sql`: `
+-- @params: value
+{{ macro "round" .value }}
+`,
+	})
+	diff, err = p.Reparse(ctx, []string{"/macros/round.sql"})
+	require.NoError(t, err)
+	require.Equal(t, &Diff{Deleted: []ResourceName{s1.Name}}, diff)
+	requireResourcesAndErrors(t, p, nil, []*runtimev1.ParseError{
+		{Message: `macro "round" exceeds the max nesting depth of 10`, FilePath: "/sources/s1.sql"},
+	})
+
+	// Macro calls that can't be expanded fail
+	putRepo(t, repo, map[string]string{
+		`sources/s1.sql`: `
+-- @connector: postgres
+SELECT {{ printf "%s" (macro "to_usd" "price" "currency") }} AS price_usd FROM orders
+`,
+	})
+	diff, err = p.Reparse(ctx, []string{"/sources/s1.sql"})
+	require.NoError(t, err)
+	require.Equal(t, &Diff{}, diff)
+	requireResourcesAndErrors(t, p, nil, []*runtimev1.ParseError{
+		{Message: `"macro" must be called in its own action`, FilePath: "/sources/s1.sql"},
+	})
+}
+
+func TestModelTestsIgnored(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`sources/s1.yaml`: `
+connector: s3
+path: hello
+`,
+		`tests/s1.yaml`: `
+model: m1
+expect:
+  - {id: 1}
+`,
+	})
+
+	s1 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindSource, Name: "s1"},
+		Paths: []string{"/sources/s1.yaml"},
+		SourceSpec: &runtimev1.SourceSpec{
+			SourceConnector: "s3",
+			Properties:      must(structpb.NewStruct(map[string]any{"path": "hello"})),
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{s1}, nil)
+
+	putRepo(t, repo, map[string]string{`tests/s1.yaml`: `invalid: [`})
+	diff, err := p.Reparse(ctx, []string{"/tests/s1.yaml"})
+	require.NoError(t, err)
+	require.Equal(t, &Diff{}, diff)
+	requireResourcesAndErrors(t, p, []*Resource{s1}, nil)
+}
+
+func TestSeeds(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`:           ``,
+		`seeds/countries.csv`: "code,name\n001,Denmark\n",
+		`seeds/countries.yaml`: `
+columns:
+  code: VARCHAR
+`,
+		`seeds/rates.json`: `[{"currency": "DKK", "rate": 0.15}]`,
+	})
+
+	hash := func(s string) string {
+		h := sha256.Sum256([]byte(s))
+		return hex.EncodeToString(h[:])
+	}
+
+	countries := &Resource{
+		Name:  ResourceName{Kind: ResourceKindSeed, Name: "countries"},
+		Paths: []string{"/seeds/countries.csv", "/seeds/countries.yaml"},
+		SeedSpec: &runtimev1.SeedSpec{
+			Connector:   "duckdb",
+			Path:        "/seeds/countries.csv",
+			Format:      "csv",
+			ContentHash: hash("code,name\n001,Denmark\n"),
+			ColumnTypes: map[string]string{"code": "VARCHAR"},
+		},
+	}
+	rates := &Resource{
+		Name:  ResourceName{Kind: ResourceKindSeed, Name: "rates"},
+		Paths: []string{"/seeds/rates.json"},
+		SeedSpec: &runtimev1.SeedSpec{
+			Connector:   "duckdb",
+			Path:        "/seeds/rates.json",
+			Format:      "json",
+			ContentHash: hash(`[{"currency": "DKK", "rate": 0.15}]`),
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{countries, rates}, nil)
+
+	// Editing the data file modifies the seed
+	putRepo(t, repo, map[string]string{`seeds/countries.csv`: "code,name\n001,Denmark\n002,Sweden\n"})
+	countries.SeedSpec.ContentHash = hash("code,name\n001,Denmark\n002,Sweden\n")
+	diff, err := p.Reparse(ctx, []string{"/seeds/countries.csv"})
+	require.NoError(t, err)
+	require.Equal(t, &Diff{Modified: []ResourceName{countries.Name}}, diff)
+	requireResourcesAndErrors(t, p, []*Resource{countries, rates}, nil)
+
+	// Invalid column types are rejected
+	putRepo(t, repo, map[string]string{`seeds/countries.yaml`: `
+columns:
+  code: "VARCHAR; DROP TABLE x"
+`})
+	diff, err = p.Reparse(ctx, []string{"/seeds/countries.yaml"})
+	require.NoError(t, err)
+	require.Equal(t, &Diff{Deleted: []ResourceName{countries.Name}}, diff)
+	requireResourcesAndErrors(t, p, []*Resource{rates}, []*runtimev1.ParseError{
+		{Message: `invalid type "VARCHAR; DROP TABLE x" for column "code"`, FilePath: "/seeds/countries.yaml"},
+		{Message: "skipping file due to error in companion SQL/YAML file", FilePath: "/seeds/countries.csv"},
+	})
+
+	// A seed can't have the same name as a source
+	putRepo(t, repo, map[string]string{`sources/rates.yaml`: `
+connector: s3
+path: hello
+`})
+	_, err = p.Reparse(ctx, []string{"/sources/rates.yaml"})
+	require.NoError(t, err)
+	require.Len(t, p.Errors, 3)
+	require.Equal(t, "/seeds/rates.json", p.Errors[2].FilePath)
+	require.Contains(t, p.Errors[2].Message, "seed name collides with source")
+}
+
 func requireResourcesAndErrors(t testing.TB, p *Parser, wantResources []*Resource, wantErrors []*runtimev1.ParseError) {
 	// Check resources
 	gotResources := maps.Clone(p.Resources)
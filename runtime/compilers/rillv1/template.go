@@ -33,6 +33,7 @@ import (
 //     ref [`kind`] `name`: register a dependency at parse-time, resolve it to a name at resolve time (parse time and resolve time)
 //     lookup [`kind`] `name`: lookup another resource (resolve time)
 //     .env.name: access a variable (resolve time)
+//     .env_name: the name of the current environment, such as "dev" or "prod" (resolve time)
 //     .user.attribute: access an attribute from auth claims (resolve time)
 //     .meta: access the current resource's metadata (resolve time)
 //     .spec: access the current resource's spec (resolve time)
@@ -42,12 +43,13 @@ import (
 
 // TemplateData contains data for resolving a template.
 type TemplateData struct {
-	User       map[string]any
-	Variables  map[string]string
-	ExtraProps map[string]any
-	Self       TemplateResource
-	Resolve    func(ref ResourceName) (string, error)
-	Lookup     func(name ResourceName) (TemplateResource, error)
+	User        map[string]any
+	Variables   map[string]string
+	Environment string
+	ExtraProps  map[string]any
+	Self        TemplateResource
+	Resolve     func(ref ResourceName) (string, error)
+	Lookup      func(name ResourceName) (TemplateResource, error)
 }
 
 // TemplateResource contains data for a resource for injection into a template.
@@ -123,6 +125,11 @@ func AnalyzeTemplate(tmpl string) (*TemplateMetadata, error) {
 		return map[string]any{}, nil
 	}
 
+	funcMap["macro"] = func(parts ...any) (string, error) {
+		// Macros are expanded before the template is analyzed, so remaining calls are in places where they can't be expanded
+		return "", fmt.Errorf(`"macro" must be called in its own action with string arguments, e.g. {{ macro "name" "arg" }}`)
+	}
+
 	// Parse template (error on missing keys)
 	t, err := template.New("").Funcs(funcMap).Option("missingkey=default").Parse(tmpl)
 	if err != nil {
@@ -131,11 +138,12 @@ func AnalyzeTemplate(tmpl string) (*TemplateMetadata, error) {
 
 	// Build template data
 	dataMap := map[string]interface{}{
-		"user":  map[string]any{},
-		"env":   map[string]any{},
-		"meta":  map[string]any{},
-		"spec":  map[string]any{},
-		"state": map[string]any{},
+		"user":     map[string]any{},
+		"env":      map[string]any{},
+		"env_name": "",
+		"meta":     map[string]any{},
+		"spec":     map[string]any{},
+		"state":    map[string]any{},
 	}
 
 	// Resolve template
@@ -220,11 +228,12 @@ func ResolveTemplate(tmpl string, data TemplateData) (string, error) {
 
 	// Build template data
 	dataMap := map[string]interface{}{
-		"user":  data.User,
-		"env":   data.Variables,
-		"meta":  data.Self.Meta,
-		"spec":  data.Self.Spec,
-		"state": data.Self.State,
+		"user":     data.User,
+		"env":      data.Variables,
+		"env_name": data.Environment,
+		"meta":     data.Self.Meta,
+		"spec":     data.Self.Spec,
+		"state":    data.Self.State,
 	}
 
 	// Add extra props
@@ -98,3 +98,41 @@ func TestResolve(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "SELECT partner_id FROM domain_partner_mapping WHERE domain = 'rilldata.com' and groups IN ('admin', 'user')", resolved)
 }
+
+func TestResolveCustomUserAttributes(t *testing.T) {
+	// Groups and custom attributes are decoded from the JWT, so they have JSON types
+	template := "{{ if has \"emea\" .user.groups }}region = '{{ .user.region }}' AND tenant_id = {{ .user.tenant_id }}{{ else }}FALSE{{ end }}"
+	resolved, err := ResolveTemplate(template, TemplateData{
+		User: map[string]any{
+			"groups":    []any{"emea", "sales"},
+			"region":    "emea",
+			"tenant_id": float64(42),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "region = 'emea' AND tenant_id = 42", resolved)
+
+	resolved, err = ResolveTemplate(template, TemplateData{
+		User: map[string]any{
+			"groups": []any{"apac"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "FALSE", resolved)
+}
+
+func TestResolveEnvironment(t *testing.T) {
+	template := `SELECT * FROM events{{ if eq .env_name "dev" }} LIMIT 1000{{ end }}`
+
+	meta, err := AnalyzeTemplate(template)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM events", meta.ResolvedWithPlaceholders)
+
+	resolved, err := ResolveTemplate(template, TemplateData{Environment: "dev"})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM events LIMIT 1000", resolved)
+
+	resolved, err = ResolveTemplate(template, TemplateData{Environment: "prod"})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM events", resolved)
+}
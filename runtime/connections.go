@@ -7,6 +7,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/secrets"
 )
 
 var ErrAdminNotConfigured = fmt.Errorf("an admin store is not configured for this instance")
@@ -101,6 +102,11 @@ func (r *Runtime) OLAP(ctx context.Context, instanceID string) (drivers.OLAPStor
 		return nil, nil, fmt.Errorf("connector %q is not a valid OLAP data store", inst.OLAPConnector)
 	}
 
+	// Record statements executed for queries in the query history or for explaining
+	if queryRecordFromContext(ctx) != nil || queryExplainerFromContext(ctx) != nil {
+		olap = &recordingOLAP{OLAPStore: olap}
+	}
+
 	return olap, release, nil
 }
 
@@ -162,6 +168,10 @@ func (r *Runtime) connectorConfig(ctx context.Context, instanceID, name string)
 		}
 	}
 
+	// Only config set by the instance's administrator may reference external secrets (see below).
+	// Connectors defined in the instance are set by the administrator, while connectors in rill.yaml are not.
+	allowSecrets := connector != nil
+
 	// Search for connector definition in rill.yaml
 	if connector == nil {
 		for _, c := range inst.ProjectConnectors {
@@ -188,12 +198,15 @@ func (r *Runtime) connectorConfig(ctx context.Context, instanceID, name string)
 		return "", nil, fmt.Errorf("unknown connector %q", name)
 	}
 
-	// Build connector config
+	// Build connector config.
+	// We track which keys are set from config set by the administrator, since only those may reference external secrets.
 	cfg := make(map[string]any)
+	trusted := make(map[string]bool)
 
 	// Apply config from definition
 	for key, value := range connector.Config {
 		cfg[strings.ToLower(key)] = value
+		trusted[strings.ToLower(key)] = allowSecrets
 	}
 
 	// Instance variables matching the format "connector.name.var" are applied to the connector config
@@ -202,6 +215,8 @@ func (r *Runtime) connectorConfig(ctx context.Context, instanceID, name string)
 	for k, v := range vars {
 		if after, found := strings.CutPrefix(k, prefix); found {
 			cfg[strings.ToLower(after)] = v
+			_, ok := inst.Variables[k]
+			trusted[strings.ToLower(after)] = ok
 		}
 	}
 
@@ -225,6 +240,30 @@ func (r *Runtime) connectorConfig(ctx context.Context, instanceID, name string)
 		setIfNil(cfg, "token", vars["token"])
 		setIfNil(cfg, "dsn", "")
 	}
+	for k := range cfg {
+		if _, ok := trusted[k]; !ok {
+			// Set from a root-level variable of the same name above
+			_, trusted[k] = inst.Variables[k]
+		}
+	}
+
+	// Resolve references to external secrets.
+	// They are only resolved here so the secrets don't leak into other places where variables are used.
+	// Since the secret stores are shared by all instances, references are only resolved in config set by the instance's administrator.
+	// Otherwise, a project could reference another instance's secrets in its rill.yaml.
+	for k, v := range cfg {
+		s, ok := v.(string)
+		if !ok || !secrets.IsReference(s) {
+			continue
+		}
+		if !trusted[k] {
+			return "", nil, fmt.Errorf("connector %q: secret references are only allowed in variables and connectors set for the deployment, not in project files", name)
+		}
+		cfg[k], err = r.secrets.Resolve(ctx, s)
+		if err != nil {
+			return "", nil, fmt.Errorf("connector %q: %w", name, err)
+		}
+	}
 
 	// Apply built-in connector config
 	cfg["allow_host_access"] = r.opts.AllowHostAccess
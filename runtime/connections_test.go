@@ -2,9 +2,12 @@ package runtime_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	_ "github.com/rilldata/rill/runtime/drivers/s3"
+	"github.com/rilldata/rill/runtime/pkg/secrets"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
 )
@@ -40,3 +43,76 @@ env:
 	require.True(t, config["aws_access_key_id"].(string) == "us-east-1")
 	require.True(t, config["allow_host_access"].(bool))
 }
+
+func TestAcquireHandleSecrets(t *testing.T) {
+	// The secret stores are shared by all instances on the runtime
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "tenant-a"), os.ModePerm))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "tenant-b"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "tenant-a", "s3.json"), []byte(`{"key": "secret-a"}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "tenant-b", "s3.json"), []byte(`{"key": "secret-b"}`), 0o644))
+	stores := map[string]secrets.Store{"vault": secrets.NewFileStore(root)}
+
+	tests := []struct {
+		name      string
+		rillYAML  string
+		variables map[string]string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "variable set for the deployment",
+			variables: map[string]string{"connector.my-s3.aws_secret_access_key": "secret://vault/tenant-a/s3#key"},
+			want:      "secret-a",
+		},
+		{
+			name: "connector in project file",
+			rillYAML: `
+connectors:
+- name: my-s3
+  type: s3
+  defaults:
+    aws_secret_access_key: secret://vault/tenant-b/s3#key
+`,
+			wantErr: true,
+		},
+		{
+			name: "variable in project file",
+			rillYAML: `
+connectors:
+- name: my-s3
+  type: s3
+env:
+  connector.my-s3.aws_secret_access_key: secret://vault/tenant-b/s3#key
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rillYAML := tt.rillYAML
+			if rillYAML == "" {
+				rillYAML = `
+connectors:
+- name: my-s3
+  type: s3
+`
+			}
+			rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+				Files:        map[string]string{"rill.yaml": rillYAML},
+				Variables:    tt.variables,
+				SecretStores: stores,
+			})
+
+			handle, release, err := rt.AcquireHandle(context.Background(), id, "my-s3")
+			if tt.wantErr {
+				require.ErrorContains(t, err, "secret references are only allowed")
+				return
+			}
+			require.NoError(t, err)
+			defer release()
+			require.Equal(t, tt.want, handle.Config()["aws_secret_access_key"])
+		})
+	}
+}
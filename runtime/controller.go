@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
@@ -16,6 +18,7 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/dag"
 	"github.com/rilldata/rill/runtime/pkg/logbuffer"
 	"github.com/rilldata/rill/runtime/pkg/logutil"
+	"github.com/rilldata/rill/runtime/pkg/querylog"
 	"github.com/rilldata/rill/runtime/pkg/schedule"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -74,6 +77,7 @@ type Controller struct {
 	Logger      *slog.Logger
 	Activity    activity.Client
 	Logs        *logbuffer.Buffer
+	QueryLog    *querylog.Buffer // Nil if the query history is disabled
 	mu          sync.RWMutex
 	reconcilers map[string]Reconciler
 	catalog     *catalogCache
@@ -126,6 +130,11 @@ func NewController(ctx context.Context, rt *Runtime, instanceID string, logger *
 	c.Logs = logbuffer.NewBuffer(rt.opts.ControllerLogBufferCapacity, rt.opts.ControllerLogBufferSizeBytes)
 	c.Logger = slog.New(logutil.NewDuplicatingHandler(zapslog.HandlerOptions{LoggerName: "console"}.New(logger.Core()), c.Logs))
 
+	if rt.opts.QueryHistoryCapacity > 0 {
+		c.QueryLog = querylog.NewBuffer(rt.opts.QueryHistoryCapacity, rt.opts.QueryHistorySizeBytes)
+		c.loadQueryHistory()
+	}
+
 	cc, err := newCatalogCache(ctx, c, c.InstanceID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create catalog cache: %w", err)
@@ -350,6 +359,9 @@ func (c *Controller) Run(ctx context.Context) error {
 	}
 	c.mu.Unlock()
 
+	// Persist the query history
+	c.saveQueryHistory()
+
 	if closeErr != nil {
 		c.Logger.Error("controller closed with error", slog.Any("error", closeErr))
 	}
@@ -357,6 +369,56 @@ func (c *Controller) Run(ctx context.Context) error {
 	return closeErr
 }
 
+// loadQueryHistory loads the query history persisted by saveQueryHistory (if any).
+func (c *Controller) loadQueryHistory() {
+	path := c.queryHistoryPath()
+	if path == "" {
+		return
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			c.Logger.Warn("failed to open query history", slog.Any("error", err))
+		}
+		return
+	}
+	defer f.Close()
+
+	err = c.QueryLog.Load(f)
+	if err != nil {
+		c.Logger.Warn("failed to load query history", slog.Any("error", err))
+	}
+}
+
+// saveQueryHistory persists the query history if Options.QueryHistoryDir is set.
+func (c *Controller) saveQueryHistory() {
+	path := c.queryHistoryPath()
+	if path == "" {
+		return
+	}
+
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err == nil {
+		var f *os.File
+		f, err = os.Create(path)
+		if err == nil {
+			err = errors.Join(c.QueryLog.Save(f), f.Close())
+		}
+	}
+	if err != nil {
+		c.Logger.Warn("failed to save query history", slog.Any("error", err))
+	}
+}
+
+// queryHistoryPath returns the path to persist the query history in. It returns an empty string if it should not be persisted.
+func (c *Controller) queryHistoryPath() string {
+	if c.QueryLog == nil || c.Runtime.opts.QueryHistoryDir == "" {
+		return ""
+	}
+	return filepath.Join(c.Runtime.opts.QueryHistoryDir, c.InstanceID+".ndjson")
+}
+
 // WaitUntilIdle returns when the controller is idle (i.e. no reconcilers are pending or running).
 func (c *Controller) WaitUntilIdle(ctx context.Context, ignoreHidden bool) error {
 	if err := c.checkRunning(); err != nil {
@@ -1182,6 +1182,63 @@ colors:
 	testruntime.RequireResource(t, rt, id, metricsRes)
 }
 
+func TestSeed(t *testing.T) {
+	// Create a seed and a model that uses it
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/seeds/countries.csv": `code,name
+DK,Denmark
+SE,Sweden
+`,
+		"/models/bar.sql": `SELECT * FROM countries`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "countries", 2)
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 2)
+
+	// Changing the data file reloads the seed and its dependents
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/seeds/countries.csv": `code,name
+DK,Denmark
+SE,Sweden
+NO,Norway
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "countries", 3)
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 3)
+
+	// Data that doesn't match the column types fails the seed and drops its table
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/seeds/countries.yaml": `
+columns:
+  code: INTEGER
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 2, 0)
+	testruntime.RequireNoOLAPTable(t, rt, id, "countries")
+
+	// Fixing the column types loads the seed again
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/seeds/countries.yaml": `
+columns:
+  code: VARCHAR
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "countries", 3)
+
+	// Deleting the seed drops its table and fails the model
+	testruntime.DeleteFiles(t, rt, id, "/seeds/countries.csv", "/seeds/countries.yaml")
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 1, 0)
+	testruntime.RequireNoOLAPTable(t, rt, id, "countries")
+}
+
 func must[T any](v T, err error) T {
 	if err != nil {
 		panic(err)
@@ -83,6 +83,9 @@ func withPostgres(t *testing.T, fn func(driver string, shared bool, cfg map[stri
 }
 
 func withSQLite(t *testing.T, fn func(driver string, shared bool, cfg map[string]any)) error {
-	fn("sqlite", true, map[string]any{"dsn": ":memory:"})
+	fn("sqlite", true, map[string]any{
+		"dsn":                ":memory:",
+		"encryption_keyring": `[{"key_id":"k1","secret":"MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="}]`,
+	})
 	return nil
 }
@@ -145,6 +145,10 @@ func (c *connection) EstimateSize() (int64, bool) {
 	return 0, false
 }
 
+func (c *connection) Concurrency() (int, bool) {
+	return 0, false
+}
+
 func (c *connection) AcquireLongRunning(ctx context.Context) (func(), error) {
 	return func() {}, nil
 }
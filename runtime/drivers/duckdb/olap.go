@@ -175,6 +175,11 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 	return res, nil
 }
 
+func (c *connection) Concurrency() (int, bool) {
+	// See note in connection struct
+	return max(c.config.PoolSize-1, 1), true
+}
+
 func (c *connection) EstimateSize() (int64, bool) {
 	path := c.config.DBFilePath
 	if path == "" {
@@ -28,6 +28,8 @@ type OLAPStore interface {
 	Execute(ctx context.Context, stmt *Statement) (*Result, error)
 	InformationSchema() InformationSchema
 	EstimateSize() (int64, bool)
+	// Concurrency returns the number of queries the store can execute concurrently. It returns false if it's not limited.
+	Concurrency() (int, bool)
 
 	CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error
 	InsertTableAsSelect(ctx context.Context, name string, byName bool, sql string) error
@@ -29,6 +29,9 @@ type Instance struct {
 	AdminConnector string
 	// Driver name for catalog
 	CatalogConnector string
+	// Environment is the name of the environment the instance runs in, such as "dev" or "prod".
+	// It selects the environment-specific overrides when parsing the project.
+	Environment string
 	// CreatedOn is when the instance was created
 	CreatedOn time.Time `db:"created_on"`
 	// UpdatedOn is when the instance was last updated in the registry
@@ -57,6 +60,12 @@ type Instance struct {
 	ModelMaterializeDelaySeconds uint32 `db:"model_materialize_delay_seconds"`
 	// IgnoreInitialInvalidProjectError indicates whether to ignore an invalid project error when the instance is initially created.
 	IgnoreInitialInvalidProjectError bool `db:"-"`
+	// SourceStubs stubs out source ingestion when not nil, which is used to validate projects offline.
+	// Sources are ingested from the local file mapped to their lowercase name instead of from their connector.
+	// Sources that use the "local_file" connector are ingested as usual, and other sources without a stub fail to ingest.
+	SourceStubs map[string]string `db:"-"`
+	// SourceExtractPolicy is applied to object store sources that don't specify an "extract" policy, for example to only ingest the first rows of each file.
+	SourceExtractPolicy map[string]any `db:"-"`
 }
 
 // ResolveVariables returns the final resolved variables
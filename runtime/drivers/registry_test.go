@@ -34,6 +34,8 @@ func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 				Config: map[string]string{"dsn": "file:rill?mode=memory&cache=shared"},
 			},
 		},
+		Variables:        map[string]string{"connector.s3.aws_secret_access_key": "secret"},
+		ProjectVariables: map[string]string{"foo": "bar"},
 	}
 
 	err := reg.CreateInstance(ctx, inst)
@@ -53,6 +55,8 @@ func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 	require.Equal(t, inst.CatalogConnector, res.CatalogConnector)
 	require.Equal(t, inst.EmbedCatalog, res.EmbedCatalog)
 	require.ElementsMatch(t, inst.Connectors, res.Connectors)
+	require.Equal(t, inst.Variables, res.Variables)
+	require.Equal(t, inst.ProjectVariables, res.ProjectVariables)
 
 	err = reg.CreateInstance(ctx, &drivers.Instance{OLAPConnector: "druid"})
 	require.NoError(t, err)
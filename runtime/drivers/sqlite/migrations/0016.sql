@@ -0,0 +1,3 @@
+-- variables and project_variables are encrypted with the key identified by variables_encryption_key_id.
+-- An empty key ID means they are stored as plain JSON.
+ALTER TABLE instances ADD COLUMN variables_encryption_key_id TEXT NOT NULL DEFAULT '';
@@ -39,12 +39,14 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 			repo_connector,
 			admin_connector,
 			catalog_connector,
+			environment,
 			created_on,
 			updated_on,
 			connectors,
 			project_connectors,
 			variables,
 			project_variables,
+			variables_encryption_key_id,
 			annotations,
 			embed_catalog,
 			watch_repo,
@@ -64,6 +66,7 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 	for rows.Next() {
 		// sqlite doesn't support maps need to read as bytes and convert to map
 		var variables, projectVariables, annotations, connectors, projectConnectors []byte
+		var variablesKeyID string
 		i := &drivers.Instance{}
 		err := rows.Scan(
 			&i.ID,
@@ -71,12 +74,14 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 			&i.RepoConnector,
 			&i.AdminConnector,
 			&i.CatalogConnector,
+			&i.Environment,
 			&i.CreatedOn,
 			&i.UpdatedOn,
 			&connectors,
 			&projectConnectors,
 			&variables,
 			&projectVariables,
+			&variablesKeyID,
 			&annotations,
 			&i.EmbedCatalog,
 			&i.WatchRepo,
@@ -98,12 +103,12 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 			return nil, err
 		}
 
-		i.Variables, err = mapFromJSON(variables)
+		i.Variables, err = c.decryptVariables(variables, variablesKeyID)
 		if err != nil {
 			return nil, err
 		}
 
-		i.ProjectVariables, err = mapFromJSON(projectVariables)
+		i.ProjectVariables, err = c.decryptVariables(projectVariables, variablesKeyID)
 		if err != nil {
 			return nil, err
 		}
@@ -139,12 +144,12 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 		return err
 	}
 
-	variables, err := mapToJSON(inst.Variables)
+	variables, variablesKeyID, err := c.encryptVariables(inst.Variables)
 	if err != nil {
 		return err
 	}
 
-	projectVariables, err := mapToJSON(inst.ProjectVariables)
+	projectVariables, _, err := c.encryptVariables(inst.ProjectVariables)
 	if err != nil {
 		return err
 	}
@@ -164,12 +169,14 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 			repo_connector,
 			admin_connector,
 			catalog_connector,
+			environment,
 			created_on,
 			updated_on,
 			connectors,
 			project_connectors,
 			variables,
 			project_variables,
+			variables_encryption_key_id,
 			annotations,
 			embed_catalog,
 			watch_repo,
@@ -177,19 +184,21 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 			model_default_materialize,
 			model_materialize_delay_seconds
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 		`,
 		inst.ID,
 		inst.OLAPConnector,
 		inst.RepoConnector,
 		inst.AdminConnector,
 		inst.CatalogConnector,
+		inst.Environment,
 		now,
 		now,
 		connectors,
 		projectConnectors,
 		variables,
 		projectVariables,
+		variablesKeyID,
 		annotations,
 		inst.EmbedCatalog,
 		inst.WatchRepo,
@@ -213,12 +222,12 @@ func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) err
 	ctx := context.Background()
 
 	// sqlite doesn't support maps need to convert to json and write as bytes array
-	variables, err := mapToJSON(inst.Variables)
+	variables, variablesKeyID, err := c.encryptVariables(inst.Variables)
 	if err != nil {
 		return err
 	}
 
-	projectVariables, err := mapToJSON(inst.ProjectVariables)
+	projectVariables, _, err := c.encryptVariables(inst.ProjectVariables)
 	if err != nil {
 		return err
 	}
@@ -247,17 +256,19 @@ func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) err
 			repo_connector = $3,
 			admin_connector = $4,
 			catalog_connector = $5,
-			updated_on = $6,
-			connectors = $7,
-			project_connectors = $8,
-			variables = $9,
-			project_variables = $10,
-			annotations = $11,
-			embed_catalog = $12,
-			watch_repo = $13,
-			stage_changes = $14,
-			model_default_materialize = $15,
-			model_materialize_delay_seconds = $16
+			environment = $6,
+			updated_on = $7,
+			connectors = $8,
+			project_connectors = $9,
+			variables = $10,
+			project_variables = $11,
+			variables_encryption_key_id = $12,
+			annotations = $13,
+			embed_catalog = $14,
+			watch_repo = $15,
+			stage_changes = $16,
+			model_default_materialize = $17,
+			model_materialize_delay_seconds = $18
 		WHERE id = $1
 		`,
 		inst.ID,
@@ -265,11 +276,13 @@ func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) err
 		inst.RepoConnector,
 		inst.AdminConnector,
 		inst.CatalogConnector,
+		inst.Environment,
 		now,
 		connectors,
 		projectConnectors,
 		variables,
 		projectVariables,
+		variablesKeyID,
 		annotations,
 		inst.EmbedCatalog,
 		inst.WatchRepo,
@@ -295,6 +308,25 @@ func (c *connection) DeleteInstance(_ context.Context, id string) error {
 	return err
 }
 
+// encryptVariables serializes variables and encrypts them with the current key of the connection's keyring.
+// It returns an empty key ID if the keyring is empty, in which case the variables are not encrypted.
+func (c *connection) encryptVariables(vars map[string]string) ([]byte, string, error) {
+	data, err := mapToJSON(vars)
+	if err != nil {
+		return nil, "", err
+	}
+	return c.encKeyring.Encrypt(data)
+}
+
+// decryptVariables is the inverse of encryptVariables.
+func (c *connection) decryptVariables(data []byte, keyID string) (map[string]string, error) {
+	data, err := c.encKeyring.Decrypt(data, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return mapFromJSON(data)
+}
+
 func mapToJSON(data map[string]string) ([]byte, error) {
 	return json.Marshal(data)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/symmetriccrypto"
 	"go.uber.org/zap"
 
 	// Load sqlite driver
@@ -37,6 +38,13 @@ func (d driver) Open(config map[string]any, shared bool, client activity.Client,
 		}
 	}
 
+	// Parse the optional keyring used to encrypt variables in the registry
+	keyring, _ := config["encryption_keyring"].(string)
+	encKeyring, err := symmetriccrypto.ParseKeyring(keyring)
+	if err != nil {
+		return nil, err
+	}
+
 	// Open DB handle
 	db, err := otelsql.Open("sqlite", dsn)
 	if err != nil {
@@ -45,9 +53,10 @@ func (d driver) Open(config map[string]any, shared bool, client activity.Client,
 	dbx := sqlx.NewDb(db, "sqlite")
 	db.SetMaxOpenConns(1)
 	return &connection{
-		db:     dbx,
-		config: config,
-		shared: shared,
+		db:         dbx,
+		config:     config,
+		shared:     shared,
+		encKeyring: encKeyring,
 	}, nil
 }
 
@@ -89,9 +98,10 @@ func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any
 }
 
 type connection struct {
-	db     *sqlx.DB
-	config map[string]any
-	shared bool
+	db         *sqlx.DB
+	config     map[string]any
+	shared     bool
+	encKeyring *symmetriccrypto.Keyring
 }
 
 var _ drivers.Handle = &connection{}
@@ -0,0 +1,306 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+)
+
+// ResolveMeasureExpression returns the SQL expression for a measure in a metrics view.
+// For derived measures, references to other measures are recursively replaced with the referenced measures' expressions.
+// For window measures, it returns the aggregate expression that the window is computed over.
+func ResolveMeasureExpression(mv *runtimev1.MetricsViewSpec, name string) (string, error) {
+	return resolveMeasureExpression(mv, name, nil)
+}
+
+func resolveMeasureExpression(mv *runtimev1.MetricsViewSpec, name string, path []string) (string, error) {
+	m := lookupMeasure(mv, name)
+	if m == nil {
+		return "", fmt.Errorf("measure %s not found", name)
+	}
+	if len(path) > 0 && m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW {
+		return "", fmt.Errorf("measure %q cannot reference window measure %q", path[len(path)-1], m.Name)
+	}
+	if !hasMeasureReferences(m) {
+		return m.Expression, nil
+	}
+
+	for _, p := range path {
+		if strings.EqualFold(p, m.Name) {
+			return "", fmt.Errorf("measure %q has a circular reference: %s -> %s", path[0], strings.Join(path, " -> "), m.Name)
+		}
+	}
+	path = append(path, m.Name)
+
+	var err error
+	expr := rewriteMeasureReferences(m.Expression, func(ref string, quoted, qualified bool) (string, bool) {
+		if err != nil || qualified || lookupMeasure(mv, ref) == nil {
+			return "", false
+		}
+		var refExpr string
+		refExpr, err = resolveMeasureExpression(mv, ref, path)
+		return fmt.Sprintf("(%s)", refExpr), true
+	})
+	if err != nil {
+		return "", err
+	}
+	return expr, nil
+}
+
+// MeasureReferences returns the names of the measures referenced in a derived or window measure's expression.
+// It returns nil for simple measures.
+func MeasureReferences(mv *runtimev1.MetricsViewSpec, m *runtimev1.MetricsViewSpec_MeasureV2) []string {
+	if !hasMeasureReferences(m) {
+		return nil
+	}
+
+	var refs []string
+	seen := make(map[string]bool)
+	rewriteMeasureReferences(m.Expression, func(ref string, quoted, qualified bool) (string, bool) {
+		if qualified {
+			return "", false
+		}
+		ref2 := lookupMeasure(mv, ref)
+		if ref2 != nil && !seen[ref2.Name] {
+			seen[ref2.Name] = true
+			refs = append(refs, ref2.Name)
+		}
+		return "", false
+	})
+	return refs
+}
+
+// MeasureNonReferences returns the identifiers in a derived or window measure's expression that don't reference another measure.
+// These are usually column names (including qualified column names), but may also be SQL keywords like AS or NULL.
+// It returns nil for simple measures.
+func MeasureNonReferences(mv *runtimev1.MetricsViewSpec, m *runtimev1.MetricsViewSpec_MeasureV2) []string {
+	if !hasMeasureReferences(m) {
+		return nil
+	}
+
+	var idents []string
+	rewriteMeasureReferences(m.Expression, func(ref string, quoted, qualified bool) (string, bool) {
+		if qualified || lookupMeasure(mv, ref) == nil {
+			idents = append(idents, ref)
+		}
+		return "", false
+	})
+	return idents
+}
+
+// ValidateMeasureColumnReferences checks that derived and window measure expressions don't contain ambiguous references,
+// which are unquoted and unqualified identifiers that name both a measure and a column of the metrics view's table.
+// Measure names take precedence in expressions, so the ambiguity must be resolved explicitly:
+// a quoted identifier like "orders" references the measure, and a qualified identifier like t.orders references the column.
+func ValidateMeasureColumnReferences(mv *runtimev1.MetricsViewSpec, columns []string) error {
+	isColumn := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		isColumn[strings.ToLower(c)] = true
+	}
+
+	for _, m := range mv.Measures {
+		if !hasMeasureReferences(m) {
+			continue
+		}
+		var err error
+		rewriteMeasureReferences(m.Expression, func(ref string, quoted, qualified bool) (string, bool) {
+			if err == nil && !quoted && !qualified && isColumn[strings.ToLower(ref)] && lookupMeasure(mv, ref) != nil {
+				err = fmt.Errorf("measure %q references %q, which is both a measure and a column: use %s to reference the measure or %s.%s to reference the column", m.Name, ref, safeSQLName(ref), safeSQLName(mv.Table), safeSQLName(ref))
+			}
+			return "", false
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateMeasureReferences checks that derived and window measures in the metrics view do not contain circular references
+// and that no measure references a window measure.
+func ValidateMeasureReferences(mv *runtimev1.MetricsViewSpec) error {
+	for _, m := range mv.Measures {
+		if !hasMeasureReferences(m) {
+			continue
+		}
+		_, err := ResolveMeasureExpression(mv, m.Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RollupMeasures returns the names of the measures that a rollup pre-aggregates.
+// If the rollup doesn't list any measures, it returns all simple measures that can be re-aggregated.
+func RollupMeasures(mv *runtimev1.MetricsViewSpec, r *runtimev1.MetricsViewSpec_RollupV2) []string {
+	if len(r.Measures) > 0 {
+		return r.Measures
+	}
+
+	var names []string
+	for _, m := range mv.Measures {
+		if hasMeasureReferences(m) {
+			continue
+		}
+		if _, ok := ReaggregationFunction(m.Expression); ok {
+			names = append(names, m.Name)
+		}
+	}
+	return names
+}
+
+// ReaggregationFunction returns the aggregate function that combines pre-aggregated values of a simple measure.
+// For example, a measure defined as COUNT(*) is re-aggregated with SUM.
+// It returns false if the expression is not a single SUM, COUNT, MIN or MAX aggregation without DISTINCT.
+func ReaggregationFunction(expr string) (string, bool) {
+	expr = strings.TrimSpace(expr)
+	open := strings.IndexByte(expr, '(')
+	if open < 0 || !strings.HasSuffix(expr, ")") {
+		return "", false
+	}
+
+	var fn string
+	switch strings.ToLower(strings.TrimSpace(expr[:open])) {
+	case "sum", "count":
+		fn = "SUM"
+	case "min":
+		fn = "MIN"
+	case "max":
+		fn = "MAX"
+	default:
+		return "", false
+	}
+
+	// Check the function's parentheses enclose the rest of the expression, e.g. reject "SUM(a) / SUM(b)"
+	depth := 0
+	inString := false
+	for i := open; i < len(expr); i++ {
+		switch c := expr[i]; {
+		case c == '\'':
+			inString = !inString
+		case inString:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 && i != len(expr)-1 {
+				return "", false
+			}
+		}
+	}
+	if depth != 0 {
+		return "", false
+	}
+
+	args := strings.ToLower(strings.TrimSpace(expr[open+1 : len(expr)-1]))
+	if args == "distinct" || strings.HasPrefix(args, "distinct ") || strings.HasPrefix(args, "distinct(") {
+		return "", false
+	}
+
+	return fn, true
+}
+
+// hasMeasureReferences returns true if the measure's expression may reference other measures.
+func hasMeasureReferences(m *runtimev1.MetricsViewSpec_MeasureV2) bool {
+	return m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED || m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW
+}
+
+func lookupMeasure(mv *runtimev1.MetricsViewSpec, name string) *runtimev1.MetricsViewSpec_MeasureV2 {
+	for _, m := range mv.Measures {
+		if strings.EqualFold(m.Name, name) {
+			return m
+		}
+	}
+	return nil
+}
+
+// rewriteMeasureReferences calls fn for every identifier in a SQL expression that may reference a measure or a column.
+// The quoted and qualified arguments tell if the identifier was quoted (like "col") or qualified (like t.col).
+// If fn returns true, the identifier is replaced with the returned string.
+// Identifiers inside string literals and function names are skipped.
+func rewriteMeasureReferences(expr string, fn func(ref string, quoted, qualified bool) (string, bool)) string {
+	var b strings.Builder
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		switch {
+		case c == '\'':
+			// Skip string literals
+			j := strings.IndexByte(expr[i+1:], '\'')
+			if j < 0 {
+				b.WriteString(expr[i:])
+				return b.String()
+			}
+			b.WriteString(expr[i : i+j+2])
+			i += j + 2
+		case c == '"':
+			// Quoted identifier
+			j := strings.IndexByte(expr[i+1:], '"')
+			if j < 0 {
+				b.WriteString(expr[i:])
+				return b.String()
+			}
+			end := i + j + 2
+			if repl, ok := fn(expr[i+1:end-1], true, isQualified(expr, i, end)); ok {
+				b.WriteString(repl)
+				i = end
+				continue
+			}
+			b.WriteString(expr[i:end])
+			i = end
+		case isIdentStart(c):
+			end := i + 1
+			for end < len(expr) && isIdentChar(expr[end]) {
+				end++
+			}
+			if !isFunctionCall(expr, end) {
+				if repl, ok := fn(expr[i:end], false, isQualified(expr, i, end)); ok {
+					b.WriteString(repl)
+					i = end
+					continue
+				}
+			}
+			b.WriteString(expr[i:end])
+			i = end
+		case c >= '0' && c <= '9':
+			// Skip numeric literals (including ones like 1e10)
+			end := i + 1
+			for end < len(expr) && (isIdentChar(expr[end]) || expr[end] == '.') {
+				end++
+			}
+			b.WriteString(expr[i:end])
+			i = end
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
+
+func isQualified(expr string, start, end int) bool {
+	return (start > 0 && expr[start-1] == '.') || (end < len(expr) && expr[end] == '.')
+}
+
+func isFunctionCall(expr string, end int) bool {
+	rest := strings.TrimLeft(expr[end:], " \t\n\r")
+	return strings.HasPrefix(rest, "(")
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
+
+// safeSQLName returns a quoted SQL identifier.
+func safeSQLName(name string) string {
+	if name == "" {
+		return name
+	}
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
+}
@@ -0,0 +1,161 @@
+package runtime
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveMeasureExpression(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "sum(amount)"},
+			{Name: "orders", Expression: "count(*)"},
+			{Name: "aov", Expression: `revenue / "orders"`, Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED},
+			{Name: "aov_pct", Expression: "AOV * 100 || 'revenue' || t.orders", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED},
+			{Name: "not_derived", Expression: "revenue"},
+			{Name: "with_func", Expression: "orders(amount) + orders", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED},
+		},
+	}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"revenue", "sum(amount)"},
+		{"aov", "(sum(amount)) / (count(*))"},
+		{"aov_pct", "((sum(amount)) / (count(*))) * 100 || 'revenue' || t.orders"},
+		{"not_derived", "revenue"},
+		{"with_func", "orders(amount) + (count(*))"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := ResolveMeasureExpression(mv, tt.name)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, expr)
+		})
+	}
+
+	_, err := ResolveMeasureExpression(mv, "missing")
+	require.ErrorContains(t, err, "not found")
+
+	require.Equal(t, []string{"revenue", "orders"}, MeasureReferences(mv, mv.Measures[2]))
+	require.Equal(t, []string{"aov"}, MeasureReferences(mv, mv.Measures[3]))
+	require.Nil(t, MeasureReferences(mv, mv.Measures[4]))
+	require.NoError(t, ValidateMeasureReferences(mv))
+}
+
+func TestValidateMeasureReferencesCycle(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "a", Expression: "b + 1", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED},
+			{Name: "b", Expression: "c * 2", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED},
+			{Name: "c", Expression: "a", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED},
+		},
+	}
+	err := ValidateMeasureReferences(mv)
+	require.ErrorContains(t, err, `measure "a" has a circular reference: a -> b -> c -> a`)
+
+	mv = &runtimev1.MetricsViewSpec{
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "a", Expression: "a + 1", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED},
+		},
+	}
+	err = ValidateMeasureReferences(mv)
+	require.ErrorContains(t, err, "circular reference: a -> a")
+}
+
+func TestValidateMeasureReferencesWindow(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "sum(amount)"},
+			{Name: "revenue_mtd", Expression: "revenue", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW},
+		},
+	}
+	require.NoError(t, ValidateMeasureReferences(mv))
+	expr, err := ResolveMeasureExpression(mv, "revenue_mtd")
+	require.NoError(t, err)
+	require.Equal(t, "(sum(amount))", expr)
+
+	mv.Measures = append(mv.Measures, &runtimev1.MetricsViewSpec_MeasureV2{Name: "double_mtd", Expression: "revenue_mtd * 2", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED})
+	err = ValidateMeasureReferences(mv)
+	require.ErrorContains(t, err, `measure "double_mtd" cannot reference window measure "revenue_mtd"`)
+}
+
+func TestReaggregationFunction(t *testing.T) {
+	tests := []struct {
+		expr string
+		fn   string
+		ok   bool
+	}{
+		{"sum(amount)", "SUM", true},
+		{" COUNT(*) ", "SUM", true},
+		{"min(price)", "MIN", true},
+		{"MAX(coalesce(price, 0))", "MAX", true},
+		{"sum(case when status = 'a)' then 1 else 0 end)", "SUM", true},
+		{"count(distinct user_id)", "", false},
+		{"avg(price)", "", false},
+		{"sum(a) / sum(b)", "", false},
+		{"sum(a) + 1", "", false},
+		{"revenue", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			fn, ok := ReaggregationFunction(tt.expr)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.fn, fn)
+		})
+	}
+}
+
+func TestRollupMeasures(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "sum(amount)"},
+			{Name: "users", Expression: "count(distinct user_id)"},
+			{Name: "orders", Expression: "count(*)"},
+			{Name: "aov", Expression: "revenue / orders", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED},
+		},
+	}
+	require.Equal(t, []string{"revenue", "orders"}, RollupMeasures(mv, &runtimev1.MetricsViewSpec_RollupV2{}))
+	require.Equal(t, []string{"orders"}, RollupMeasures(mv, &runtimev1.MetricsViewSpec_RollupV2{Measures: []string{"orders"}}))
+	require.Nil(t, MeasureNonReferences(mv, mv.Measures[3]))
+
+	mv.Measures[3].Expression = "revenue / count(*) * discount"
+	require.Equal(t, []string{"discount"}, MeasureNonReferences(mv, mv.Measures[3]))
+}
+
+func TestValidateMeasureColumnReferences(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table: "orders_model",
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "sum(amount)"},
+			{Name: "discount", Expression: "sum(discount)"},
+			{Name: "net", Expression: `revenue - discount`, Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED},
+		},
+	}
+
+	// No columns conflict with measure names
+	require.NoError(t, ValidateMeasureColumnReferences(mv, []string{"amount", "country"}))
+
+	// The "discount" column conflicts with the "discount" measure
+	err := ValidateMeasureColumnReferences(mv, []string{"amount", "Discount"})
+	require.ErrorContains(t, err, `measure "net" references "discount", which is both a measure and a column: use "discount" to reference the measure or "orders_model"."discount" to reference the column`)
+
+	// A quoted identifier references the measure
+	mv.Measures[2].Expression = `revenue - "discount"`
+	require.NoError(t, ValidateMeasureColumnReferences(mv, []string{"amount", "Discount"}))
+	expr, err := ResolveMeasureExpression(mv, "net")
+	require.NoError(t, err)
+	require.Equal(t, `(sum(amount)) - (sum(discount))`, expr)
+
+	// A qualified identifier references the column
+	mv.Measures[2].Expression = `revenue - "orders_model"."discount"`
+	require.NoError(t, ValidateMeasureColumnReferences(mv, []string{"amount", "Discount"}))
+	expr, err = ResolveMeasureExpression(mv, "net")
+	require.NoError(t, err)
+	require.Equal(t, `(sum(amount)) - "orders_model"."discount"`, expr)
+	require.Equal(t, []string{"revenue"}, MeasureReferences(mv, mv.Measures[2]))
+	require.Equal(t, []string{"orders_model", "discount"}, MeasureNonReferences(mv, mv.Measures[2]))
+}
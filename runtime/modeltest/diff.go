@@ -0,0 +1,148 @@
+package modeltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// diffRows compares actual rows against expected rows on the expected columns.
+// It returns the formatted expected rows that were not found in actual and the formatted actual rows that were not expected.
+// If ordered is false, the rows are compared as multisets.
+func diffRows(cols []string, expected, actual []map[string]any, ordered bool) (missing, unexpected []string) {
+	if ordered {
+		n := len(expected)
+		if len(actual) > n {
+			n = len(actual)
+		}
+		for i := 0; i < n; i++ {
+			var e, a string
+			if i < len(expected) {
+				e = formatRow(cols, expected[i])
+			}
+			if i < len(actual) {
+				a = formatRow(cols, actual[i])
+			}
+			if e == a {
+				continue
+			}
+			if e != "" {
+				missing = append(missing, e)
+			}
+			if a != "" {
+				unexpected = append(unexpected, a)
+			}
+		}
+		return missing, unexpected
+	}
+
+	counts := make(map[string]int, len(actual))
+	for _, row := range actual {
+		counts[formatRow(cols, row)]++
+	}
+	for _, row := range expected {
+		s := formatRow(cols, row)
+		if counts[s] > 0 {
+			counts[s]--
+			continue
+		}
+		missing = append(missing, s)
+	}
+	for _, row := range actual {
+		s := formatRow(cols, row)
+		if counts[s] > 0 {
+			counts[s]--
+			unexpected = append(unexpected, s)
+		}
+	}
+	return missing, unexpected
+}
+
+// formatRow formats the given columns of a row as a string, e.g. "{id: 1, name: foo}".
+func formatRow(cols []string, row map[string]any) string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i, col := range cols {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(col)
+		sb.WriteString(": ")
+		sb.WriteString(normalizeValue(row[col]))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// normalizeValue formats a value such that equivalent values from YAML, CSV and DuckDB compare equal.
+// For example, the integer 1, the float 1.0 and the string "1" all become "1".
+func normalizeValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"} {
+			if t, err := time.Parse(layout, v); err == nil {
+				return normalizeTime(t)
+			}
+		}
+		return v
+	case []byte:
+		return normalizeValue(string(v))
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.FormatInt(int64(v), 10)
+	case int8:
+		return strconv.FormatInt(int64(v), 10)
+	case int16:
+		return strconv.FormatInt(int64(v), 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case *big.Int:
+		return v.String()
+	case time.Time:
+		return normalizeTime(v)
+	case interface{ Float64() float64 }:
+		return strconv.FormatFloat(v.Float64(), 'f', -1, 64)
+	case map[string]any, []any:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// normalizeTime formats a time as a date if it's midnight UTC, and otherwise as an RFC3339 timestamp in UTC.
+func normalizeTime(t time.Time) string {
+	t = t.UTC()
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0 {
+		return t.Format(time.DateOnly)
+	}
+	return t.Format(time.RFC3339Nano)
+}
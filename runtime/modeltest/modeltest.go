@@ -0,0 +1,203 @@
+// Package modeltest runs unit tests for models against fixture data.
+//
+// Tests are YAML files in the project's tests/ directory. For example:
+//
+//	model: orders_usd
+//	given:
+//	  orders:
+//	    - {id: 1, amount: 10, currency: EUR}
+//	  fx_rates: fixtures/fx_rates.csv
+//	expect:
+//	  - {id: 1, amount_usd: 11}
+//
+// Each entry in "given" provides the rows for a table referenced by the model, either inline or as a path to a CSV file in the project.
+// "expect" provides the rows the model is expected to output in the same way. Only the columns present in "expect" are compared.
+// Rows are compared without regard to order, unless "ordered: true" is set.
+package modeltest
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"gopkg.in/yaml.v3"
+)
+
+// Environment is the environment that projects are parsed in when running tests.
+// Projects can use it to provide test-specific overrides.
+const Environment = "test"
+
+// Test is a unit test for a model.
+type Test struct {
+	Name    string
+	Path    string
+	Model   string
+	Env     map[string]string
+	Given   map[string]*Fixture
+	Expect  *Fixture
+	Ordered bool
+}
+
+// Fixture is a set of rows, either provided inline or loaded from a CSV file.
+type Fixture struct {
+	// Rows and Columns are set for inline fixtures. Columns are in order of first appearance.
+	Rows    []map[string]any
+	Columns []string
+	// CSVPath is set for fixtures loaded from a CSV file. It is relative to the project root.
+	CSVPath string
+}
+
+// CSV returns the fixture's rows as CSV, loading them from the repo if the fixture is a CSV file.
+// Nil values in inline fixtures become empty fields, which DuckDB reads as NULL.
+func (f *Fixture) CSV(ctx context.Context, repo drivers.RepoStore) (string, error) {
+	if f.CSVPath != "" {
+		return repo.Get(ctx, f.CSVPath)
+	}
+
+	if len(f.Rows) == 0 {
+		return "", errors.New("inline fixtures must contain at least one row")
+	}
+
+	var sb strings.Builder
+	w := csv.NewWriter(&sb)
+	if err := w.Write(f.Columns); err != nil {
+		return "", err
+	}
+	for _, row := range f.Rows {
+		rec := make([]string, len(f.Columns))
+		for i, col := range f.Columns {
+			switch v := row[col].(type) {
+			case nil:
+			case time.Time:
+				rec[i] = v.Format(time.RFC3339Nano)
+			default:
+				rec[i] = fmt.Sprint(v)
+			}
+		}
+		if err := w.Write(rec); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	return sb.String(), w.Error()
+}
+
+// testYAML is the raw structure of a test file.
+type testYAML struct {
+	Model   string               `yaml:"model"`
+	Env     map[string]string    `yaml:"env"`
+	Given   map[string]yaml.Node `yaml:"given"`
+	Expect  yaml.Node            `yaml:"expect"`
+	Ordered bool                 `yaml:"ordered"`
+}
+
+// Load loads all tests in a project.
+func Load(ctx context.Context, repo drivers.RepoStore) ([]*Test, error) {
+	paths, err := repo.ListRecursive(ctx, "tests/**/*.{yaml,yml}")
+	if err != nil {
+		return nil, fmt.Errorf("could not list test files: %w", err)
+	}
+
+	var res []*Test
+	for _, path := range paths {
+		data, err := repo.Get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := Parse(path, data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid test %q: %w", path, err)
+		}
+		res = append(res, t)
+	}
+
+	return res, nil
+}
+
+// Parse parses a test file.
+func Parse(path, data string) (*Test, error) {
+	tmp := &testYAML{}
+	dec := yaml.NewDecoder(strings.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(tmp); err != nil {
+		return nil, err
+	}
+
+	if tmp.Model == "" {
+		return nil, errors.New(`missing "model"`)
+	}
+	if tmp.Expect.IsZero() {
+		return nil, errors.New(`missing "expect"`)
+	}
+
+	t := &Test{
+		Name:    fileutil.Stem(path),
+		Path:    path,
+		Model:   tmp.Model,
+		Env:     tmp.Env,
+		Given:   make(map[string]*Fixture, len(tmp.Given)),
+		Ordered: tmp.Ordered,
+	}
+
+	for name, node := range tmp.Given {
+		node := node
+		f, err := parseFixture(&node)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fixture for %q: %w", name, err)
+		}
+		t.Given[strings.ToLower(name)] = f
+	}
+
+	var err error
+	t.Expect, err = parseFixture(&tmp.Expect)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid "expect": %w`, err)
+	}
+
+	return t, nil
+}
+
+// parseFixture parses a fixture, which is either a list of rows or a path to a CSV file.
+func parseFixture(node *yaml.Node) (*Fixture, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		var path string
+		if err := node.Decode(&path); err != nil {
+			return nil, err
+		}
+		if !strings.HasSuffix(strings.ToLower(path), ".csv") {
+			return nil, fmt.Errorf("expected a path to a CSV file, got %q", path)
+		}
+		return &Fixture{CSVPath: path}, nil
+	case yaml.SequenceNode:
+		f := &Fixture{}
+		seen := make(map[string]bool)
+		for _, row := range node.Content {
+			if row.Kind != yaml.MappingNode {
+				return nil, errors.New("rows must be maps of column names to values")
+			}
+			for i := 0; i+1 < len(row.Content); i += 2 {
+				col := row.Content[i].Value
+				if !seen[col] {
+					seen[col] = true
+					f.Columns = append(f.Columns, col)
+				}
+			}
+
+			var vals map[string]any
+			if err := row.Decode(&vals); err != nil {
+				return nil, err
+			}
+			f.Rows = append(f.Rows, vals)
+		}
+		return f, nil
+	default:
+		return nil, errors.New("must be a list of rows or a path to a CSV file")
+	}
+}
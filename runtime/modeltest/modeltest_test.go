@@ -0,0 +1,205 @@
+package modeltest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestParse(t *testing.T) {
+	test, err := Parse("/tests/orders_usd.yaml", `
+model: orders_usd
+env:
+  currency: USD
+given:
+  Orders:
+    - {id: 1, amount: 10, currency: EUR}
+    - {id: 2, currency: USD, amount: 5, note: hello}
+  fx_rates: fixtures/fx_rates.csv
+expect:
+  - {id: 1, amount_usd: 11}
+ordered: true
+`)
+	require.NoError(t, err)
+	require.Equal(t, "orders_usd", test.Name)
+	require.Equal(t, "orders_usd", test.Model)
+	require.Equal(t, map[string]string{"currency": "USD"}, test.Env)
+	require.True(t, test.Ordered)
+
+	require.Len(t, test.Given, 2)
+	require.Equal(t, []string{"id", "amount", "currency", "note"}, test.Given["orders"].Columns)
+	require.Len(t, test.Given["orders"].Rows, 2)
+	require.Equal(t, "hello", test.Given["orders"].Rows[1]["note"])
+	require.Equal(t, "fixtures/fx_rates.csv", test.Given["fx_rates"].CSVPath)
+
+	require.Equal(t, []string{"id", "amount_usd"}, test.Expect.Columns)
+	require.Len(t, test.Expect.Rows, 1)
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{"missing model", "expect: []", `missing "model"`},
+		{"missing expect", "model: foo", `missing "expect"`},
+		{"unknown field", "model: foo\nexpect: []\nfoo: bar", "field foo not found"},
+		{"invalid fixture", "model: foo\ngiven:\n  bar: 10\nexpect: []", "expected a path to a CSV file"},
+		{"invalid row", "model: foo\ngiven:\n  bar: [1, 2]\nexpect: []", "rows must be maps"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := Parse("/tests/foo.yaml", c.data)
+			require.ErrorContains(t, err, c.err)
+		})
+	}
+}
+
+func TestDiffRows(t *testing.T) {
+	cols := []string{"id", "name"}
+	expected := []map[string]any{
+		{"id": 1, "name": "a"},
+		{"id": 2, "name": "b"},
+		{"id": 2, "name": "b"},
+	}
+
+	// Unordered with extra columns in the output
+	actual := []map[string]any{
+		{"id": int64(2), "name": "b", "extra": true},
+		{"id": int64(1), "name": "a", "extra": false},
+		{"id": int64(2), "name": "b", "extra": true},
+	}
+	missing, unexpected := diffRows(cols, expected, actual, false)
+	require.Empty(t, missing)
+	require.Empty(t, unexpected)
+
+	// Same rows in different order
+	missing, unexpected = diffRows(cols, expected, actual, true)
+	require.Equal(t, []string{"{id: 1, name: a}", "{id: 2, name: b}"}, missing)
+	require.Equal(t, []string{"{id: 2, name: b}", "{id: 1, name: a}"}, unexpected)
+
+	// Duplicates are counted
+	actual = []map[string]any{
+		{"id": 1, "name": "a"},
+		{"id": 2, "name": "b"},
+		{"id": 3, "name": nil},
+	}
+	missing, unexpected = diffRows(cols, expected, actual, false)
+	require.Equal(t, []string{"{id: 2, name: b}"}, missing)
+	require.Equal(t, []string{"{id: 3, name: NULL}"}, unexpected)
+}
+
+func TestNormalizeValue(t *testing.T) {
+	cases := []struct {
+		in  any
+		out string
+	}{
+		{nil, "NULL"},
+		{1, "1"},
+		{int32(1), "1"},
+		{1.0, "1"},
+		{float32(1.5), "1.5"},
+		{"1.50", "1.5"},
+		{"foo", "foo"},
+		{true, "true"},
+		{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), "2024-01-02"},
+		{"2024-01-02", "2024-01-02"},
+		{"2024-01-02T00:00:00Z", "2024-01-02"},
+		{time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "2024-01-02T03:04:05Z"},
+		{"2024-01-02 03:04:05", "2024-01-02T03:04:05Z"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.out, normalizeValue(c.in), "input: %v", c.in)
+	}
+}
+
+func TestRunner(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml":           "",
+			"models/orders.sql":   `SELECT 1 AS id, 10 AS amount, 'EUR' AS currency`,
+			"models/fx_rates.sql": `SELECT 'EUR' AS currency, 1.1 AS rate`,
+			"models/orders_usd.sql": `
+SELECT o.id, round(o.amount * r.rate, 2) AS amount_usd
+FROM orders o JOIN fx_rates r ON o.currency = r.currency
+ORDER BY o.id
+`,
+			"fixtures/fx_rates.csv": "currency,rate\nEUR,1.1\nDKK,0.15\n",
+			"tests/a_pass.yaml": `
+model: orders_usd
+given:
+  orders:
+    - {id: 1, amount: 10, currency: EUR}
+    - {id: 2, amount: 100, currency: DKK}
+  fx_rates: fixtures/fx_rates.csv
+expect:
+  - {id: 2, amount_usd: 15}
+  - {id: 1, amount_usd: 11}
+`,
+			"tests/b_fail.yaml": `
+model: orders_usd
+given:
+  orders:
+    - {id: 1, amount: 10, currency: EUR}
+  fx_rates: fixtures/fx_rates.csv
+expect:
+  - {id: 1, amount_usd: 12}
+`,
+			"tests/c_missing_fixture.yaml": `
+model: orders_usd
+given:
+  orders:
+    - {id: 1, amount: 10, currency: EUR}
+expect:
+  - {id: 1, amount_usd: 11}
+`,
+		},
+	})
+
+	ctx := context.Background()
+	repo, release, err := rt.Repo(ctx, instanceID)
+	require.NoError(t, err)
+	defer release()
+
+	parser, err := rillv1.Parse(ctx, repo, instanceID, Environment, "duckdb", []string{"duckdb"})
+	require.NoError(t, err)
+
+	tests, err := Load(ctx, repo)
+	require.NoError(t, err)
+	require.Len(t, tests, 3)
+
+	runner, err := NewRunner(repo, parser, zap.NewNop())
+	require.NoError(t, err)
+	defer runner.Close()
+
+	// Fixtures replace the model's table references
+	res := runner.Run(ctx, tests[0])
+	require.NoError(t, res.Err)
+	require.True(t, res.Passed(), "missing: %v, unexpected: %v", res.Missing, res.Unexpected)
+
+	// Mismatched rows are reported
+	res = runner.Run(ctx, tests[1])
+	require.NoError(t, res.Err)
+	require.False(t, res.Passed())
+	require.Equal(t, []string{"{id: 1, amount_usd: 12}"}, res.Missing)
+	require.Equal(t, []string{"{id: 1, amount_usd: 11}"}, res.Unexpected)
+
+	// Every referenced table must have a fixture
+	res = runner.Run(ctx, tests[2])
+	require.ErrorContains(t, res.Err, `missing fixture for "fx_rates"`)
+}
+
+func TestRewriteRefs(t *testing.T) {
+	sql, err := rewriteRefs(`SELECT * FROM orders o JOIN "Orders" o2 ON o.id = o2.id JOIN customers c ON o.customer_id = c.id`, map[string]string{"orders": "__rill_fixture_1"})
+	require.NoError(t, err)
+	require.NotContains(t, sql, "orders")
+	require.NotContains(t, sql, "Orders")
+	require.Contains(t, sql, "__rill_fixture_1")
+	require.Contains(t, sql, "customers")
+}
@@ -0,0 +1,292 @@
+package modeltest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
+	"go.uber.org/zap"
+
+	// Load the DuckDB driver used to run tests
+	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+)
+
+// Result is the outcome of running a test.
+type Result struct {
+	Test *Test
+	// Err is set if the test could not be run, e.g. due to a missing fixture or invalid SQL.
+	Err error
+	// Missing contains formatted rows that were expected but not output by the model.
+	Missing []string
+	// Unexpected contains formatted rows that were output by the model but not expected.
+	Unexpected []string
+}
+
+// Passed returns true if the test ran and the model's output matched the expected rows.
+func (r *Result) Passed() bool {
+	return r.Err == nil && len(r.Missing) == 0 && len(r.Unexpected) == 0
+}
+
+// Runner runs tests against an ephemeral in-memory DuckDB database.
+// It doesn't connect to any external services, so it's safe to use in CI.
+type Runner struct {
+	repo    drivers.RepoStore
+	parser  *rillv1.Parser
+	handle  drivers.Handle
+	olap    drivers.OLAPStore
+	tempDir string
+	tables  int
+}
+
+// NewRunner creates a Runner for models in the given project. It must be closed after use.
+func NewRunner(repo drivers.RepoStore, parser *rillv1.Parser, logger *zap.Logger) (*Runner, error) {
+	handle, err := drivers.Open("duckdb", map[string]any{"dsn": ""}, false, activity.NewNoopClient(), logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open duckdb: %w", err)
+	}
+
+	olap, ok := handle.AsOLAP("")
+	if !ok {
+		_ = handle.Close()
+		return nil, errors.New("duckdb is not an OLAP store")
+	}
+
+	tempDir, err := os.MkdirTemp("", "rill-modeltest-*")
+	if err != nil {
+		_ = handle.Close()
+		return nil, err
+	}
+
+	return &Runner{
+		repo:    repo,
+		parser:  parser,
+		handle:  handle,
+		olap:    olap,
+		tempDir: tempDir,
+	}, nil
+}
+
+// Close releases the database and removes temporary files.
+func (r *Runner) Close() error {
+	err := r.handle.Close()
+	return errors.Join(err, os.RemoveAll(r.tempDir))
+}
+
+// Run runs a test and returns its result.
+func (r *Runner) Run(ctx context.Context, t *Test) *Result {
+	res := &Result{Test: t}
+	res.Missing, res.Unexpected, res.Err = r.run(ctx, t)
+	return res
+}
+
+func (r *Runner) run(ctx context.Context, t *Test) ([]string, []string, error) {
+	model, ok := r.parser.Resources[rillv1.ResourceName{Kind: rillv1.ResourceKindModel, Name: t.Model}.Normalized()]
+	if !ok {
+		return nil, nil, fmt.Errorf("model %q not found", t.Model)
+	}
+
+	// Every source or model the model depends on must be provided as a fixture
+	for _, ref := range model.Refs {
+		if ref.Kind != rillv1.ResourceKindSource && ref.Kind != rillv1.ResourceKindModel {
+			continue
+		}
+		if _, ok := t.Given[strings.ToLower(ref.Name)]; !ok {
+			return nil, nil, fmt.Errorf("missing fixture for %q referenced by model %q", ref.Name, t.Model)
+		}
+	}
+
+	// Load the fixtures into tables
+	tables := make(map[string]string, len(t.Given))
+	for name, f := range t.Given {
+		table, err := r.loadFixture(ctx, f)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load fixture for %q: %w", name, err)
+		}
+		tables[name] = table
+	}
+
+	sql, err := r.resolveSQL(model, t)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sql, err = rewriteRefs(sql, tables)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to substitute fixtures in model SQL: %w", err)
+	}
+
+	actualCols, actual, err := r.query(ctx, sql)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to run model: %w", err)
+	}
+
+	expectCols, expect := t.Expect.Columns, t.Expect.Rows
+	if t.Expect.CSVPath != "" {
+		table, err := r.loadFixture(ctx, t.Expect)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load expected rows: %w", err)
+		}
+		expectCols, expect, err = r.query(ctx, fmt.Sprintf("SELECT * FROM %s", table))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load expected rows: %w", err)
+		}
+	}
+
+	for _, col := range expectCols {
+		found := false
+		for _, c := range actualCols {
+			if c == col {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, nil, fmt.Errorf("expected column %q not found in model output (got %s)", col, strings.Join(actualCols, ", "))
+		}
+	}
+
+	missing, unexpected := diffRows(expectCols, expect, actual, t.Ordered)
+	return missing, unexpected, nil
+}
+
+// resolveSQL returns the model's SQL with templating resolved. Refs resolve to the referenced resource's name, which is then substituted by rewriteRefs.
+func (r *Runner) resolveSQL(model *rillv1.Resource, t *Test) (string, error) {
+	if !model.ModelSpec.UsesTemplating {
+		return model.ModelSpec.Sql, nil
+	}
+
+	vars := make(map[string]string)
+	if r.parser.RillYAML != nil {
+		for _, v := range r.parser.RillYAML.Variables {
+			vars[v.Name] = v.Default
+		}
+	}
+	for k, v := range r.parser.DotEnv {
+		vars[k] = v
+	}
+	for k, v := range t.Env {
+		vars[k] = v
+	}
+
+	sql, err := rillv1.ResolveTemplate(model.ModelSpec.Sql, rillv1.TemplateData{
+		User:        map[string]any{},
+		Variables:   vars,
+		Environment: Environment,
+		Self: rillv1.TemplateResource{
+			Spec: model.ModelSpec,
+		},
+		Resolve: func(ref rillv1.ResourceName) (string, error) {
+			return safeSQLName(ref.Name), nil
+		},
+		Lookup: func(name rillv1.ResourceName) (rillv1.TemplateResource, error) {
+			return rillv1.TemplateResource{}, errors.New(`"lookup" is not supported in model tests`)
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve model SQL template: %w", err)
+	}
+	return sql, nil
+}
+
+// loadFixture creates a table containing the fixture's rows and returns its name.
+func (r *Runner) loadFixture(ctx context.Context, f *Fixture) (string, error) {
+	r.tables++
+	table := fmt.Sprintf("__rill_fixture_%d", r.tables)
+	path := filepath.Join(r.tempDir, table+".csv")
+
+	data, err := f.CSV(ctx, r.repo)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.WriteFile(path, []byte(data), 0o600)
+	if err != nil {
+		return "", err
+	}
+
+	err = r.olap.Exec(ctx, &drivers.Statement{
+		Query: fmt.Sprintf("CREATE TABLE %s AS SELECT * FROM read_csv_auto(%s, header=true)", safeSQLName(table), safeSQLString(path)),
+	})
+	if err != nil {
+		return "", err
+	}
+	return table, nil
+}
+
+// query runs a query and returns its columns and rows.
+func (r *Runner) query(ctx context.Context, sql string) ([]string, []map[string]any, error) {
+	res, err := r.olap.Execute(ctx, &drivers.Statement{Query: sql})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Close()
+
+	cols, err := res.Columns()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows []map[string]any
+	for res.Next() {
+		row := make(map[string]any, len(cols))
+		if err := res.MapScan(row); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	if err := res.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return cols, rows, nil
+}
+
+// rewriteRefs replaces references to the tables in fixtures (keyed by lowercase name) with the fixture table names.
+func rewriteRefs(sql string, fixtures map[string]string) (string, error) {
+	// RewriteTableRefs only visits the first reference to each table name, so we repeat until nothing is replaced.
+	for {
+		ast, err := duckdbsql.Parse(sql)
+		if err != nil {
+			return "", err
+		}
+
+		replaced := false
+		err = ast.RewriteTableRefs(func(table *duckdbsql.TableRef) (*duckdbsql.TableRef, bool) {
+			if table.Name == "" || table.LocalAlias {
+				return nil, false
+			}
+			name, ok := fixtures[strings.ToLower(table.Name)]
+			if !ok {
+				return nil, false
+			}
+			replaced = true
+			return &duckdbsql.TableRef{Name: name}, true
+		})
+		if err != nil {
+			return "", err
+		}
+		if !replaced {
+			return sql, nil
+		}
+
+		sql, err = ast.Format()
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+func safeSQLName(name string) string {
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
+}
+
+func safeSQLString(s string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+}
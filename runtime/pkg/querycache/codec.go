@@ -0,0 +1,260 @@
+package querycache
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Encode serializes a query result for storage in caches that don't hold values in memory.
+// It supports proto messages, slices of proto messages, proto enums, int64, float64, string, []any of supported values,
+// and types registered with RegisterType. Other values return ErrUnsupportedValue.
+// The encoding is self-describing, so Decode returns a value of the same type.
+func Encode(v any) ([]byte, error) {
+	e, err := newEnvelope(v)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	err = gob.NewEncoder(&buf).Encode(e)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decode deserializes a value serialized with Encode.
+func Decode(data []byte) (any, error) {
+	e := &envelope{}
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(e)
+	if err != nil {
+		return nil, err
+	}
+	return e.value()
+}
+
+// binaryType is implemented by pointers to types registered with RegisterType.
+type binaryType interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+var (
+	typesMu     sync.RWMutex
+	typesByName = make(map[string]reflect.Type)
+	namesByType = make(map[reflect.Type]string)
+)
+
+// RegisterType registers a type that isn't a proto message, so values of its type can be serialized with Encode.
+// The value must be a pointer to the type. The name identifies the type in serialized values, so it must not change.
+func RegisterType(name string, v binaryType) {
+	typ := reflect.TypeOf(v)
+	if typ.Kind() != reflect.Pointer {
+		panic(fmt.Errorf("querycache: type %q must be registered with a pointer", name))
+	}
+
+	typesMu.Lock()
+	defer typesMu.Unlock()
+	if _, ok := typesByName[name]; ok {
+		panic(fmt.Errorf("querycache: type %q is already registered", name))
+	}
+	typesByName[name] = typ
+	namesByType[typ] = name
+}
+
+// envelopeKind identifies the type of value in an envelope.
+type envelopeKind int
+
+const (
+	kindNil envelopeKind = iota
+	kindProto
+	kindProtoList
+	kindEnum
+	kindInt64
+	kindFloat64
+	kindString
+	kindList
+	kindRegistered
+)
+
+// envelope is the serialized form of a value.
+type envelope struct {
+	Kind envelopeKind
+	// Type is the full name of a proto message or enum, or the name of a registered type
+	Type string
+	// IsNil is true for typed nil pointers and slices
+	IsNil bool
+	Data  []byte
+	// Items holds serialized proto messages for kindProtoList and serialized envelopes for kindList
+	Items  [][]byte
+	Int    int64
+	Float  float64
+	String string
+}
+
+func newEnvelope(v any) (*envelope, error) {
+	switch v := v.(type) {
+	case nil:
+		return &envelope{Kind: kindNil}, nil
+	case proto.Message:
+		e := &envelope{Kind: kindProto, Type: string(v.ProtoReflect().Descriptor().FullName())}
+		if reflect.ValueOf(v).IsNil() {
+			e.IsNil = true
+			return e, nil
+		}
+		data, err := proto.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		e.Data = data
+		return e, nil
+	case protoreflect.Enum:
+		return &envelope{Kind: kindEnum, Type: string(v.Descriptor().FullName()), Int: int64(v.Number())}, nil
+	case int64:
+		return &envelope{Kind: kindInt64, Int: v}, nil
+	case float64:
+		return &envelope{Kind: kindFloat64, Float: v}, nil
+	case string:
+		return &envelope{Kind: kindString, String: v}, nil
+	case []any:
+		e := &envelope{Kind: kindList, Items: make([][]byte, len(v))}
+		for i, item := range v {
+			data, err := Encode(item)
+			if err != nil {
+				return nil, err
+			}
+			e.Items[i] = data
+		}
+		return e, nil
+	}
+
+	rv := reflect.ValueOf(v)
+
+	// Check for registered types
+	typesMu.RLock()
+	name, ok := namesByType[rv.Type()]
+	typesMu.RUnlock()
+	if ok {
+		e := &envelope{Kind: kindRegistered, Type: name}
+		if rv.IsNil() {
+			e.IsNil = true
+			return e, nil
+		}
+		data, err := v.(binaryType).MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		e.Data = data
+		return e, nil
+	}
+
+	// Check for slices of proto messages
+	if rv.Kind() == reflect.Slice {
+		elem, ok := reflect.Zero(rv.Type().Elem()).Interface().(proto.Message)
+		if ok {
+			e := &envelope{Kind: kindProtoList, Type: string(elem.ProtoReflect().Descriptor().FullName())}
+			if rv.IsNil() {
+				e.IsNil = true
+				return e, nil
+			}
+			e.Items = make([][]byte, rv.Len())
+			for i := 0; i < rv.Len(); i++ {
+				data, err := proto.Marshal(rv.Index(i).Interface().(proto.Message))
+				if err != nil {
+					return nil, err
+				}
+				e.Items[i] = data
+			}
+			return e, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unsupported type %T", ErrUnsupportedValue, v)
+}
+
+func (e *envelope) value() (any, error) {
+	switch e.Kind {
+	case kindNil:
+		return nil, nil
+	case kindProto:
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(e.Type))
+		if err != nil {
+			return nil, err
+		}
+		if e.IsNil {
+			return reflect.Zero(reflect.TypeOf(mt.New().Interface())).Interface(), nil
+		}
+		msg := mt.New().Interface()
+		err = proto.Unmarshal(e.Data, msg)
+		if err != nil {
+			return nil, err
+		}
+		return msg, nil
+	case kindProtoList:
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(e.Type))
+		if err != nil {
+			return nil, err
+		}
+		typ := reflect.SliceOf(reflect.TypeOf(mt.New().Interface()))
+		if e.IsNil {
+			return reflect.Zero(typ).Interface(), nil
+		}
+		res := reflect.MakeSlice(typ, len(e.Items), len(e.Items))
+		for i, data := range e.Items {
+			msg := mt.New().Interface()
+			err := proto.Unmarshal(data, msg)
+			if err != nil {
+				return nil, err
+			}
+			res.Index(i).Set(reflect.ValueOf(msg))
+		}
+		return res.Interface(), nil
+	case kindEnum:
+		et, err := protoregistry.GlobalTypes.FindEnumByName(protoreflect.FullName(e.Type))
+		if err != nil {
+			return nil, err
+		}
+		return et.New(protoreflect.EnumNumber(e.Int)), nil
+	case kindInt64:
+		return e.Int, nil
+	case kindFloat64:
+		return e.Float, nil
+	case kindString:
+		return e.String, nil
+	case kindList:
+		res := make([]any, len(e.Items))
+		for i, data := range e.Items {
+			v, err := Decode(data)
+			if err != nil {
+				return nil, err
+			}
+			res[i] = v
+		}
+		return res, nil
+	case kindRegistered:
+		typesMu.RLock()
+		typ, ok := typesByName[e.Type]
+		typesMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("querycache: type %q is not registered", e.Type)
+		}
+		if e.IsNil {
+			return reflect.Zero(typ).Interface(), nil
+		}
+		v := reflect.New(typ.Elem()).Interface()
+		err := v.(binaryType).UnmarshalBinary(e.Data)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("querycache: unknown value kind %d", e.Kind)
+	}
+}
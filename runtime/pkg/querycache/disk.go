@@ -0,0 +1,224 @@
+package querycache
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	// Load sqlite driver
+	_ "modernc.org/sqlite"
+)
+
+// diskEvictFraction is the fraction of entries to evict at a time when the disk cache is full.
+// Evicting more than one entry avoids running eviction for every Set when the cache is full.
+const diskEvictFraction = 0.1
+
+// diskAccessFlushSize is the number of accesses to buffer before writing them to the database.
+// Buffering avoids a write for every Get. Accesses are also written before evicting and when the cache is closed.
+const diskAccessFlushSize = 100
+
+// Disk is a cache that persists serialized values in a SQLite database, so they survive restarts.
+// When the total size of the values exceeds the max size, the least recently used values are evicted.
+type Disk struct {
+	db      *sql.DB
+	maxSize int64
+
+	mu       sync.Mutex
+	size     int64            // Total size of the values in the database
+	count    int64            // Number of values in the database
+	accessed map[string]int64 // Access times not yet written to the database
+}
+
+var _ Cache = (*Disk)(nil)
+
+// NewDisk opens or creates a cache in the SQLite database file at path. It holds at most sizeInBytes bytes of serialized values.
+func NewDisk(path string, sizeInBytes int64) (*Disk, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS query_cache (
+			key TEXT PRIMARY KEY,
+			value BLOB NOT NULL,
+			size INTEGER NOT NULL,
+			accessed_on INTEGER NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS query_cache_accessed_on_idx ON query_cache (accessed_on);
+	`)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	// Load the totals once, then keep them up to date on writes
+	d := &Disk{
+		db:       db,
+		maxSize:  sizeInBytes,
+		accessed: make(map[string]int64),
+	}
+	err = db.QueryRow("SELECT COUNT(*), COALESCE(SUM(size), 0) FROM query_cache").Scan(&d.count, &d.size)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Get implements Cache.
+func (d *Disk) Get(ctx context.Context, key string) (any, bool, error) {
+	var data []byte
+	err := d.db.QueryRowContext(ctx, "SELECT value FROM query_cache WHERE key = ?", key).Scan(&data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	d.mu.Lock()
+	d.accessed[key] = time.Now().UnixNano()
+	if len(d.accessed) >= diskAccessFlushSize {
+		err = d.flushAccessed(ctx)
+	}
+	d.mu.Unlock()
+	if err != nil {
+		return nil, false, err
+	}
+
+	v, err := Decode(data)
+	if err != nil {
+		return nil, false, err
+	}
+	return v, true, nil
+}
+
+// Set implements Cache. The cost is ignored in favor of the size of the serialized value.
+func (d *Disk) Set(ctx context.Context, key string, val any, cost int64) error {
+	data, err := Encode(val)
+	if err != nil {
+		return err
+	}
+	size := int64(len(data))
+	if size > d.maxSize {
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var prevSize int64
+	err = tx.QueryRowContext(ctx, "SELECT size FROM query_cache WHERE key = ?", key).Scan(&prevSize)
+	exists := err == nil
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT OR REPLACE INTO query_cache (key, value, size, accessed_on) VALUES (?, ?, ?, ?)", key, data, size, time.Now().UnixNano())
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	d.size += size - prevSize
+	if !exists {
+		d.count++
+	}
+	delete(d.accessed, key)
+
+	if d.size <= d.maxSize {
+		return nil
+	}
+	return d.evict(ctx)
+}
+
+// evict removes the least recently used values until the total size is below the max size. It must be called while holding mu.
+func (d *Disk) evict(ctx context.Context) error {
+	// Write buffered accesses first, so recently accessed values are not evicted
+	err := d.flushAccessed(ctx)
+	if err != nil {
+		return err
+	}
+
+	for d.size > d.maxSize && d.count > 0 {
+		limit := max(int64(float64(d.count)*diskEvictFraction), 1)
+		rows, err := d.db.QueryContext(ctx, "DELETE FROM query_cache WHERE key IN (SELECT key FROM query_cache ORDER BY accessed_on LIMIT ?) RETURNING size", limit)
+		if err != nil {
+			return err
+		}
+
+		var n int64
+		for rows.Next() {
+			var size int64
+			if err := rows.Scan(&size); err != nil {
+				rows.Close()
+				return err
+			}
+			d.size -= size
+			n++
+		}
+		err = errors.Join(rows.Err(), rows.Close())
+		if err != nil {
+			return err
+		}
+
+		d.count -= n
+		if n == 0 {
+			// The totals are out of sync with the database
+			d.size, d.count = 0, 0
+		}
+	}
+
+	return nil
+}
+
+// flushAccessed writes the buffered access times to the database. It must be called while holding mu.
+func (d *Disk) flushAccessed(ctx context.Context) error {
+	if len(d.accessed) == 0 {
+		return nil
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for key, accessedOn := range d.accessed {
+		_, err := tx.ExecContext(ctx, "UPDATE query_cache SET accessed_on = ? WHERE key = ?", accessedOn, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	clear(d.accessed)
+	return nil
+}
+
+// Close implements Cache.
+func (d *Disk) Close() error {
+	d.mu.Lock()
+	err := d.flushAccessed(context.Background())
+	d.mu.Unlock()
+	return errors.Join(err, d.db.Close())
+}
@@ -0,0 +1,77 @@
+package querycache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	meter                    = otel.Meter("github.com/rilldata/rill/runtime")
+	queryCacheHitsCounter    = observability.Must(meter.Int64ObservableCounter("query_cache.hits"))
+	queryCacheMissesCounter  = observability.Must(meter.Int64ObservableCounter("query_cache.misses"))
+	queryCacheItemCountGauge = observability.Must(meter.Int64ObservableGauge("query_cache.items"))
+	queryCacheSizeBytesGauge = observability.Must(meter.Int64ObservableGauge("query_cache.size", metric.WithUnit("bytes")))
+)
+
+// Memory is an in-process cache. Values are stored as-is, so callers must not mutate them after calling Set.
+type Memory struct {
+	cache   *ristretto.Cache
+	metrics metric.Registration
+}
+
+var _ Cache = (*Memory)(nil)
+
+// NewMemory creates an in-process cache that holds values with a total cost of at most sizeInBytes.
+func NewMemory(sizeInBytes int64) *Memory {
+	if sizeInBytes <= 100 {
+		panic(fmt.Sprintf("invalid cache size should be greater than 100: %v", sizeInBytes))
+	}
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		// Use 5% of cache memory for storing counters. Each counter takes roughly 3 bytes.
+		// Recommended value is 10x the number of items in cache when full.
+		// Tune this again based on metrics.
+		NumCounters: int64(float64(sizeInBytes) * 0.05 / 3),
+		MaxCost:     int64(float64(sizeInBytes) * 0.95),
+		BufferItems: 64,
+		Metrics:     true,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	metrics := observability.Must(meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		observer.ObserveInt64(queryCacheHitsCounter, int64(cache.Metrics.Hits()))
+		observer.ObserveInt64(queryCacheMissesCounter, int64(cache.Metrics.Misses()))
+		observer.ObserveInt64(queryCacheItemCountGauge, int64(cache.Metrics.KeysAdded()-cache.Metrics.KeysEvicted()))
+		observer.ObserveInt64(queryCacheSizeBytesGauge, int64(cache.Metrics.CostAdded()-cache.Metrics.CostEvicted()))
+		return nil
+	}, queryCacheHitsCounter, queryCacheMissesCounter, queryCacheItemCountGauge, queryCacheSizeBytesGauge))
+
+	return &Memory{
+		cache:   cache,
+		metrics: metrics,
+	}
+}
+
+// Get implements Cache.
+func (m *Memory) Get(ctx context.Context, key string) (any, bool, error) {
+	val, ok := m.cache.Get(key)
+	return val, ok, nil
+}
+
+// Set implements Cache.
+func (m *Memory) Set(ctx context.Context, key string, val any, cost int64) error {
+	m.cache.Set(key, val, cost)
+	return nil
+}
+
+// Close implements Cache.
+func (m *Memory) Close() error {
+	m.cache.Close()
+	return m.metrics.Unregister()
+}
@@ -0,0 +1,22 @@
+package querycache
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrUnsupportedValue is returned when a value can't be stored in a cache that serializes values.
+var ErrUnsupportedValue = errors.New("querycache: value can't be serialized")
+
+// Cache is a backend for storing query results.
+// Keys must uniquely identify a query and the state of the data it depends on, so entries never need to be invalidated explicitly.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key. It returns false if the key is not in the cache.
+	Get(ctx context.Context, key string) (any, bool, error)
+	// Set stores a value for key. The cost is the approximate size of the value in bytes.
+	// Backends may drop values at any time, so a successful Set doesn't guarantee that a subsequent Get will find the value.
+	Set(ctx context.Context, key string, val any, cost int64) error
+	// Close releases the cache's resources.
+	Close() error
+}
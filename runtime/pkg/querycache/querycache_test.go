@@ -0,0 +1,225 @@
+package querycache
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/alicebob/miniredis"
+	"github.com/redis/go-redis/v9"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type testResult struct {
+	Name  string
+	Count int64
+}
+
+func (r *testResult) MarshalBinary() ([]byte, error) {
+	return Encode([]any{r.Name, r.Count})
+}
+
+func (r *testResult) UnmarshalBinary(data []byte) error {
+	v, err := Decode(data)
+	if err != nil {
+		return err
+	}
+	vals := v.([]any)
+	r.Name = vals[0].(string)
+	r.Count = vals[1].(int64)
+	return nil
+}
+
+func init() {
+	RegisterType("testResult", &testResult{})
+}
+
+func TestEncode(t *testing.T) {
+	row, err := structpb.NewStruct(map[string]any{"a": 1.0, "b": "x"})
+	require.NoError(t, err)
+
+	vals := []any{
+		nil,
+		&runtimev1.TopK{Entries: []*runtimev1.TopK_Entry{{Count: 3}}},
+		(*runtimev1.TopK)(nil),
+		[]*structpb.Struct{row, row},
+		[]*runtimev1.ProfileColumn(nil),
+		runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		int64(10),
+		float64(1.5),
+		"foo",
+		[]any{int64(1), []*structpb.Struct{row}},
+		&testResult{Name: "foo", Count: 2},
+		(*testResult)(nil),
+	}
+
+	for _, v := range vals {
+		data, err := Encode(v)
+		require.NoError(t, err)
+		res, err := Decode(data)
+		require.NoError(t, err)
+		require.IsType(t, v, res)
+
+		switch v := v.(type) {
+		case proto.Message:
+			require.True(t, proto.Equal(v, res.(proto.Message)))
+		case []*structpb.Struct:
+			require.Len(t, res, len(v))
+			for i := range v {
+				require.True(t, proto.Equal(v[i], res.([]*structpb.Struct)[i]))
+			}
+		case []any:
+			require.Len(t, res, len(v))
+		default:
+			require.Equal(t, v, res)
+		}
+	}
+
+	_, err = Encode(struct{}{})
+	require.True(t, errors.Is(err, ErrUnsupportedValue))
+}
+
+func TestMemory(t *testing.T) {
+	c := NewMemory(1024 * 1024)
+	defer c.Close()
+	testCache(t, c, func() { c.cache.Wait() })
+}
+
+func TestDisk(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.db")
+	c, err := NewDisk(path, 1024*1024)
+	require.NoError(t, err)
+	testCache(t, c, func() {})
+	require.NoError(t, c.Close())
+
+	// Check values are persisted
+	c, err = NewDisk(path, 1024*1024)
+	require.NoError(t, err)
+	defer c.Close()
+	v, ok, err := c.Get(context.Background(), "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(1), v)
+}
+
+func TestDiskEviction(t *testing.T) {
+	c, err := NewDisk(filepath.Join(t.TempDir(), "cache.db"), 1024)
+	require.NoError(t, err)
+	defer c.Close()
+
+	ctx := context.Background()
+	val := string(make([]byte, 300))
+	require.NoError(t, c.Set(ctx, "a", val, 0))
+	require.NoError(t, c.Set(ctx, "b", val, 0))
+
+	// Access "a", so "b" is the least recently used
+	_, ok, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, c.Set(ctx, "c", val, 0))
+	require.NoError(t, c.Set(ctx, "d", val, 0))
+
+	_, ok, err = c.Get(ctx, "b")
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, ok, err = c.Get(ctx, "d")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Values larger than the cache are not stored
+	require.NoError(t, c.Set(ctx, "e", string(make([]byte, 2048)), 0))
+	_, ok, err = c.Get(ctx, "e")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestDiskTotals(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.db")
+	c, err := NewDisk(path, 1024)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	val := string(make([]byte, 300))
+	require.NoError(t, c.Set(ctx, "a", val, 0))
+	require.NoError(t, c.Set(ctx, "b", val, 0))
+	require.NoError(t, c.Set(ctx, "b", val, 0)) // Replacing a value doesn't count it twice
+	require.Equal(t, int64(2), c.count)
+	size := c.size
+
+	// Accesses are buffered until the cache is closed
+	_, ok, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, c.accessed, 1)
+	var accessedOn int64
+	require.NoError(t, c.db.QueryRow("SELECT accessed_on FROM query_cache WHERE key = 'a'").Scan(&accessedOn))
+	require.Less(t, accessedOn, c.accessed["a"])
+	require.NoError(t, c.Close())
+
+	// The totals are loaded on open, and the buffered access was written
+	c, err = NewDisk(path, 1024)
+	require.NoError(t, err)
+	defer c.Close()
+	require.Equal(t, int64(2), c.count)
+	require.Equal(t, size, c.size)
+	var latest string
+	require.NoError(t, c.db.QueryRow("SELECT key FROM query_cache ORDER BY accessed_on DESC LIMIT 1").Scan(&latest))
+	require.Equal(t, "a", latest)
+
+	// Eviction keeps the totals up to date
+	require.NoError(t, c.Set(ctx, "c", val, 0))
+	require.NoError(t, c.Set(ctx, "d", val, 0))
+	var count, total int64
+	require.NoError(t, c.db.QueryRow("SELECT COUNT(*), SUM(size) FROM query_cache").Scan(&count, &total))
+	require.Equal(t, count, c.count)
+	require.Equal(t, total, c.size)
+	require.LessOrEqual(t, c.size, int64(1024))
+}
+
+func TestRedis(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	opts, err := redis.ParseURL("redis://" + mr.Addr())
+	require.NoError(t, err)
+	c := NewRedis(redis.NewClient(opts), 0)
+	defer c.Close()
+	testCache(t, c, func() {})
+
+	// Check values are shared between clients
+	c2 := NewRedis(redis.NewClient(opts), 0)
+	defer c2.Close()
+	v, ok, err := c2.Get(context.Background(), "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(1), v)
+}
+
+func testCache(t *testing.T, c Cache, wait func()) {
+	ctx := context.Background()
+
+	_, ok, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	topk := &runtimev1.TopK{Entries: []*runtimev1.TopK_Entry{{Count: 3}}}
+	require.NoError(t, c.Set(ctx, "a", int64(1), 8))
+	require.NoError(t, c.Set(ctx, "b", topk, 100))
+	wait()
+
+	v, ok, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(1), v)
+
+	v, ok, err = c.Get(ctx, "b")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, proto.Equal(topk, v.(proto.Message)))
+}
@@ -0,0 +1,70 @@
+package querycache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisKeyPrefix is prepended to the keys of cached values in Redis.
+const redisKeyPrefix = "rill:query_cache:"
+
+// Redis is a cache that stores serialized values in Redis, so they can be shared by multiple runtimes.
+// Values expire after the configured TTL. Eviction of values before they expire depends on the Redis server's maxmemory policy.
+type Redis struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+var _ Cache = (*Redis)(nil)
+
+// NewRedis creates a cache backed by the Redis client. If ttl is 0, values don't expire.
+// The cache takes ownership of the client and closes it when the cache is closed.
+func NewRedis(client *redis.Client, ttl time.Duration) *Redis {
+	return &Redis{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// Get implements Cache.
+func (r *Redis) Get(ctx context.Context, key string) (any, bool, error) {
+	data, err := r.client.Get(ctx, redisKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	v, err := Decode(data)
+	if err != nil {
+		return nil, false, err
+	}
+	return v, true, nil
+}
+
+// Set implements Cache.
+func (r *Redis) Set(ctx context.Context, key string, val any, cost int64) error {
+	data, err := Encode(val)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(ctx, redisKey(key), data, r.ttl).Err()
+}
+
+// Close implements Cache.
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
+
+// redisKey returns the Redis key for a cache key.
+// Cache keys can be long since they contain the serialized query, so they are hashed.
+func redisKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return redisKeyPrefix + hex.EncodeToString(h[:])
+}
@@ -0,0 +1,106 @@
+package querylog
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"sync"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/bufferutil"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxLineSize is the max size of an entry when loading a persisted buffer.
+const maxLineSize = 1 << 20
+
+// Buffer is a bounded in-memory log of queries. When it's full, the oldest entries are dropped.
+// It's safe for concurrent use.
+type Buffer struct {
+	mu      sync.RWMutex
+	entries *bufferutil.BoundedCircularBuffer[*runtimev1.QueryHistoryEntry]
+}
+
+// NewBuffer creates a buffer that holds at most maxEntryCount entries with a total size of at most maxBufferSize bytes.
+func NewBuffer(maxEntryCount int, maxBufferSize int64) *Buffer {
+	return &Buffer{
+		entries: bufferutil.NewBoundedCircularBuffer[*runtimev1.QueryHistoryEntry](maxEntryCount, maxBufferSize),
+	}
+}
+
+// Add adds an entry to the buffer.
+func (b *Buffer) Add(e *runtimev1.QueryHistoryEntry) {
+	// The encoded size is a good enough approximation of the in-memory size
+	size := proto.Size(e)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.entries.Push(bufferutil.Item[*runtimev1.QueryHistoryEntry]{
+		Value: e,
+		Size:  size,
+	})
+}
+
+// List returns up to limit entries that took at least minDurationMs, newest first.
+// If subject is not empty, only entries for queries requested by that subject are returned.
+// A negative limit returns all matching entries.
+func (b *Buffer) List(minDurationMs uint32, subject string, limit int) []*runtimev1.QueryHistoryEntry {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if limit < 0 {
+		limit = b.entries.Count()
+	}
+
+	res := make([]*runtimev1.QueryHistoryEntry, 0, min(limit, b.entries.Count()))
+	b.entries.ReverseIterate(func(item bufferutil.Item[*runtimev1.QueryHistoryEntry]) {
+		if len(res) < limit && item.Value.DurationMs >= minDurationMs && (subject == "" || item.Value.Subject == subject) {
+			res = append(res, item.Value)
+		}
+	}, b.entries.Count())
+
+	return res
+}
+
+// Save writes the buffer's entries to w as newline-delimited JSON, oldest first.
+func (b *Buffer) Save(w io.Writer) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	var err error
+	b.entries.Iterate(func(item bufferutil.Item[*runtimev1.QueryHistoryEntry]) {
+		if err != nil {
+			return
+		}
+		var data []byte
+		data, err = protojson.Marshal(item.Value)
+		if err != nil {
+			return
+		}
+		data = append(data, '\n')
+		_, err = w.Write(data)
+	}, b.entries.Count())
+
+	return err
+}
+
+// Load adds the entries written by Save to the buffer.
+func (b *Buffer) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLineSize)
+	for scanner.Scan() {
+		e := &runtimev1.QueryHistoryEntry{}
+		err := protojson.Unmarshal(scanner.Bytes(), e)
+		if err != nil {
+			return err
+		}
+		b.Add(e)
+	}
+
+	err := scanner.Err()
+	if errors.Is(err, bufio.ErrTooLong) {
+		return errors.New("query history entry exceeds max size")
+	}
+	return err
+}
@@ -0,0 +1,63 @@
+package querylog
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBuffer(t *testing.T) {
+	b := NewBuffer(3, 1<<20)
+	for i, d := range []uint32{10, 2000, 30, 4000} {
+		b.Add(&runtimev1.QueryHistoryEntry{
+			QueryType:  "MetricsViewAggregation",
+			DurationMs: d,
+			Priority:   int32(i),
+			Subject:    fmt.Sprintf("u%d", i%2),
+			Statements: []*runtimev1.QueryHistoryStatement{{Sql: "SELECT 1", Args: []string{"a"}}},
+		})
+	}
+
+	// The oldest entry is dropped, and entries are returned newest first
+	all := b.List(0, "", -1)
+	require.Len(t, all, 3)
+	require.Equal(t, []uint32{4000, 30, 2000}, durations(all))
+
+	require.Equal(t, []uint32{4000, 2000}, durations(b.List(1000, "", -1)))
+	require.Equal(t, []uint32{4000}, durations(b.List(1000, "", 1)))
+	require.Empty(t, b.List(5000, "", -1))
+	require.Equal(t, []uint32{4000, 2000}, durations(b.List(0, "u1", -1)))
+	require.Equal(t, []uint32{30}, durations(b.List(0, "u0", -1)))
+
+	// Save and load into a new buffer
+	var buf bytes.Buffer
+	require.NoError(t, b.Save(&buf))
+	b2 := NewBuffer(10, 1<<20)
+	require.NoError(t, b2.Load(&buf))
+	loaded := b2.List(0, "", -1)
+	require.Len(t, loaded, 3)
+	for i := range all {
+		require.True(t, proto.Equal(all[i], loaded[i]))
+	}
+}
+
+func TestBufferMaxSize(t *testing.T) {
+	e := &runtimev1.QueryHistoryEntry{QueryType: "ColumnTopK", DurationMs: 1}
+	b := NewBuffer(100, int64(proto.Size(e)*2))
+	for i := 0; i < 5; i++ {
+		b.Add(e)
+	}
+	require.Len(t, b.List(0, "", -1), 2)
+}
+
+func durations(entries []*runtimev1.QueryHistoryEntry) []uint32 {
+	res := make([]uint32, len(entries))
+	for i, e := range entries {
+		res[i] = e.DurationMs
+	}
+	return res
+}
@@ -0,0 +1,52 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fileStore is a Store backed by JSON files on the local file system.
+// It is a stand-in for an external secrets manager in development and self-hosted deployments.
+type fileStore struct {
+	root string
+}
+
+// NewFileStore creates a Store that reads the secret at path from the JSON file "<root>/<path>.json".
+// Each file must contain a flat JSON object of string values.
+func NewFileStore(root string) Store {
+	return &fileStore{root: root}
+}
+
+// Get implements Store.
+func (s *fileStore) Get(_ context.Context, path, key string) (string, error) {
+	// Guard against paths that escape the root
+	clean := filepath.Clean("/" + path)
+	if clean == "/" || strings.Contains(path, "..") {
+		return "", fmt.Errorf("invalid secret path %q", path)
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.root, clean+".json"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", ErrNotFound
+		}
+		return "", err
+	}
+
+	var secret map[string]string
+	err = json.Unmarshal(data, &secret)
+	if err != nil {
+		return "", fmt.Errorf("invalid secret file for path %q: %w", path, err)
+	}
+
+	val, ok := secret[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return val, nil
+}
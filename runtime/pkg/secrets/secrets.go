@@ -0,0 +1,87 @@
+// Package secrets resolves references to secrets stored outside of Rill.
+// A reference has the format "secret://<store>/<path>#<key>", for example "secret://vault/prod/s3#aws_secret_access_key".
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ReferencePrefix is the prefix of values that reference a secret.
+const ReferencePrefix = "secret://"
+
+// ErrNotFound is returned when a referenced secret does not exist.
+var ErrNotFound = errors.New("secret not found")
+
+// Store is an external store of secrets.
+type Store interface {
+	// Get returns the value of key in the secret at path.
+	Get(ctx context.Context, path, key string) (string, error)
+}
+
+// Reference is a parsed secret reference.
+type Reference struct {
+	Store string
+	Path  string
+	Key   string
+}
+
+// IsReference returns true if s has the format of a secret reference.
+func IsReference(s string) bool {
+	return strings.HasPrefix(s, ReferencePrefix)
+}
+
+// ParseReference parses a reference of the format "secret://<store>/<path>#<key>".
+func ParseReference(s string) (*Reference, error) {
+	rest, ok := strings.CutPrefix(s, ReferencePrefix)
+	if !ok {
+		return nil, fmt.Errorf("invalid secret reference %q: must start with %q", s, ReferencePrefix)
+	}
+
+	rest, key, ok := strings.Cut(rest, "#")
+	if !ok || key == "" {
+		return nil, fmt.Errorf("invalid secret reference %q: missing key", s)
+	}
+
+	store, path, ok := strings.Cut(rest, "/")
+	if !ok || store == "" || path == "" {
+		return nil, fmt.Errorf("invalid secret reference %q: must have the format %s<store>/<path>#<key>", s, ReferencePrefix)
+	}
+
+	return &Reference{Store: store, Path: path, Key: key}, nil
+}
+
+// Resolver resolves secret references against a set of named stores.
+type Resolver struct {
+	stores map[string]Store
+}
+
+// NewResolver creates a resolver for the given stores, keyed by the store name used in references.
+func NewResolver(stores map[string]Store) *Resolver {
+	return &Resolver{stores: stores}
+}
+
+// Resolve returns the secret referenced by s. If s is not a secret reference, it is returned unchanged.
+func (r *Resolver) Resolve(ctx context.Context, s string) (string, error) {
+	if !IsReference(s) {
+		return s, nil
+	}
+
+	ref, err := ParseReference(s)
+	if err != nil {
+		return "", err
+	}
+
+	store, ok := r.stores[ref.Store]
+	if !ok {
+		return "", fmt.Errorf("unknown secret store %q", ref.Store)
+	}
+
+	val, err := store.Get(ctx, ref.Path, ref.Key)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve secret %q: %w", s, err)
+	}
+	return val, nil
+}
@@ -0,0 +1,52 @@
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	ref, err := ParseReference("secret://vault/prod/s3#aws_secret_access_key")
+	require.NoError(t, err)
+	require.Equal(t, &Reference{Store: "vault", Path: "prod/s3", Key: "aws_secret_access_key"}, ref)
+
+	for _, s := range []string{"vault/prod#key", "secret://vault/prod", "secret://vault/prod#", "secret://vault#key", "secret:///prod#key"} {
+		_, err := ParseReference(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestResolver(t *testing.T) {
+	ctx := context.Background()
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "prod"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "prod", "s3.json"), []byte(`{"aws_secret_access_key":"hunter2"}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(filepath.Dir(root), "outside.json"), []byte(`{"key":"leaked"}`), 0o644))
+
+	r := NewResolver(map[string]Store{"vault": NewFileStore(root)})
+
+	val, err := r.Resolve(ctx, "secret://vault/prod/s3#aws_secret_access_key")
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", val)
+
+	val, err = r.Resolve(ctx, "not a reference")
+	require.NoError(t, err)
+	require.Equal(t, "not a reference", val)
+
+	_, err = r.Resolve(ctx, "secret://vault/prod/s3#missing")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = r.Resolve(ctx, "secret://vault/prod/missing#key")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = r.Resolve(ctx, "secret://other/prod/s3#aws_secret_access_key")
+	require.ErrorContains(t, err, "unknown secret store")
+
+	_, err = r.Resolve(ctx, "secret://vault/../outside#key")
+	require.ErrorContains(t, err, "invalid secret path")
+}
@@ -0,0 +1,100 @@
+package symmetriccrypto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Keyring is a set of encryption keys identified by ID.
+// The first key is the current key, which is used for encryption. All keys can be used for decryption.
+// To rotate keys, add a new key at the front of the keyring and re-encrypt existing data.
+// The old key can be removed when no data is encrypted with it anymore.
+type Keyring struct {
+	current  string
+	encoders map[string]Encoder
+}
+
+// KeyringKey is the serialized representation of a key in a keyring.
+type KeyringKey struct {
+	ID     string `json:"key_id"`
+	Secret string `json:"secret"` // Base64 encoded AES key of 16, 24 or 32 bytes
+}
+
+// ParseKeyring parses a keyring from a JSON array of KeyringKey objects.
+// It returns an empty keyring if s is empty. An empty keyring doesn't encrypt data.
+func ParseKeyring(s string) (*Keyring, error) {
+	if s == "" {
+		return NewKeyring(nil)
+	}
+
+	var keys []KeyringKey
+	err := json.Unmarshal([]byte(s), &keys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keyring: %w", err)
+	}
+	return NewKeyring(keys)
+}
+
+// NewKeyring creates a keyring from a list of keys. The first key is the current key.
+func NewKeyring(keys []KeyringKey) (*Keyring, error) {
+	k := &Keyring{encoders: make(map[string]Encoder, len(keys))}
+	for i, key := range keys {
+		if key.ID == "" {
+			return nil, errors.New("invalid keyring: key ID must not be empty")
+		}
+		if _, ok := k.encoders[key.ID]; ok {
+			return nil, fmt.Errorf("invalid keyring: duplicate key ID %q", key.ID)
+		}
+
+		secret, err := base64.StdEncoding.DecodeString(key.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyring: key %q is not base64 encoded: %w", key.ID, err)
+		}
+
+		enc, err := NewEncoder(secret)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyring: key %q: %w", key.ID, err)
+		}
+
+		k.encoders[key.ID] = enc
+		if i == 0 {
+			k.current = key.ID
+		}
+	}
+	return k, nil
+}
+
+// CurrentKeyID returns the ID of the key used for encryption. It returns an empty string if the keyring is empty.
+func (k *Keyring) CurrentKeyID() string {
+	return k.current
+}
+
+// Encrypt encrypts data with the current key and returns the ciphertext and the key's ID.
+// If the keyring is empty, it returns the data unchanged and an empty key ID.
+func (k *Keyring) Encrypt(data []byte) ([]byte, string, error) {
+	if k.current == "" {
+		return data, "", nil
+	}
+
+	res, err := k.encoders[k.current].Encrypt(data)
+	if err != nil {
+		return nil, "", err
+	}
+	return res, k.current, nil
+}
+
+// Decrypt decrypts data with the key identified by keyID.
+// If keyID is empty, the data is assumed to not be encrypted and is returned unchanged.
+func (k *Keyring) Decrypt(data []byte, keyID string) ([]byte, error) {
+	if keyID == "" {
+		return data, nil
+	}
+
+	enc, ok := k.encoders[keyID]
+	if !ok {
+		return nil, fmt.Errorf("encryption key %q not found in keyring", keyID)
+	}
+	return enc.Decrypt(data)
+}
@@ -1,6 +1,8 @@
 package symmetriccrypto
 
 import (
+	"encoding/base64"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -19,3 +21,41 @@ func Test(t *testing.T) {
 
 	require.Equal(t, data, plain)
 }
+
+func TestKeyring(t *testing.T) {
+	key1 := base64.StdEncoding.EncodeToString(Must(GenerateKey(32)))
+	key2 := base64.StdEncoding.EncodeToString(Must(GenerateKey(32)))
+	data := []byte("Hello, World!")
+
+	// An empty keyring doesn't encrypt
+	empty, err := ParseKeyring("")
+	require.NoError(t, err)
+	res, keyID, err := empty.Encrypt(data)
+	require.NoError(t, err)
+	require.Equal(t, "", keyID)
+	require.Equal(t, data, res)
+
+	k1, err := ParseKeyring(fmt.Sprintf(`[{"key_id": "k1", "secret": %q}]`, key1))
+	require.NoError(t, err)
+	cipher1, keyID, err := k1.Encrypt(data)
+	require.NoError(t, err)
+	require.Equal(t, "k1", keyID)
+	require.NotEqual(t, data, cipher1)
+
+	// After rotation, new data is encrypted with the new key and old data can still be decrypted
+	k2, err := ParseKeyring(fmt.Sprintf(`[{"key_id": "k2", "secret": %q}, {"key_id": "k1", "secret": %q}]`, key2, key1))
+	require.NoError(t, err)
+	require.Equal(t, "k2", k2.CurrentKeyID())
+	plain, err := k2.Decrypt(cipher1, "k1")
+	require.NoError(t, err)
+	require.Equal(t, data, plain)
+	cipher2, keyID, err := k2.Encrypt(data)
+	require.NoError(t, err)
+	require.Equal(t, "k2", keyID)
+
+	_, err = k1.Decrypt(cipher2, "k2")
+	require.ErrorContains(t, err, "not found in keyring")
+
+	_, err = ParseKeyring(`[{"key_id": "k1", "secret": "c2hvcnQ="}]`)
+	require.Error(t, err)
+}
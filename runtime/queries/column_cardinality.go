@@ -15,19 +15,21 @@ import (
 type ColumnCardinality struct {
 	TableName  string
 	ColumnName string
+	Security   *runtime.ResolvedTableSecurity
 	Result     float64
 }
 
 var _ runtime.Query = &ColumnCardinality{}
 
 func (q *ColumnCardinality) Key() string {
-	return fmt.Sprintf("ColumnCardinality:%s:%s", q.TableName, q.ColumnName)
+	return fmt.Sprintf("ColumnCardinality:%s:%s:%s", q.TableName, q.ColumnName, tableSecurityKey(q.Security))
 }
 
 func (q *ColumnCardinality) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -58,7 +60,12 @@ func (q *ColumnCardinality) Resolve(ctx context.Context, rt *runtime.Runtime, in
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
-	requestSQL := fmt.Sprintf("SELECT approx_count_distinct(%s) as count from %s", safeName(q.ColumnName), safeName(q.TableName))
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
+	requestSQL := fmt.Sprintf("SELECT approx_count_distinct(%s) as count from %s", safeName(q.ColumnName), from)
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            requestSQL,
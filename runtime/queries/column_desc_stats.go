@@ -14,19 +14,21 @@ import (
 type ColumnDescriptiveStatistics struct {
 	TableName  string
 	ColumnName string
+	Security   *runtime.ResolvedTableSecurity
 	Result     *runtimev1.NumericStatistics
 }
 
 var _ runtime.Query = &ColumnDescriptiveStatistics{}
 
 func (q *ColumnDescriptiveStatistics) Key() string {
-	return fmt.Sprintf("ColumnDescriptiveStatistics:%s:%s", q.TableName, q.ColumnName)
+	return fmt.Sprintf("ColumnDescriptiveStatistics:%s:%s:%s", q.TableName, q.ColumnName, tableSecurityKey(q.Security))
 }
 
 func (q *ColumnDescriptiveStatistics) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -57,6 +59,11 @@ func (q *ColumnDescriptiveStatistics) Resolve(ctx context.Context, rt *runtime.R
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
 	sanitizedColumnName := safeName(q.ColumnName)
 	descriptiveStatisticsSQL := fmt.Sprintf("SELECT "+
 		"min(%s)::DOUBLE as min, "+
@@ -74,7 +81,7 @@ func (q *ColumnDescriptiveStatistics) Resolve(ctx context.Context, rt *runtime.R
 		sanitizedColumnName,
 		sanitizedColumnName,
 		sanitizedColumnName,
-		safeName(q.TableName))
+		from)
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            descriptiveStatisticsSQL,
@@ -14,19 +14,21 @@ import (
 type ColumnNullCount struct {
 	TableName  string
 	ColumnName string
+	Security   *runtime.ResolvedTableSecurity
 	Result     float64
 }
 
 var _ runtime.Query = &ColumnNullCount{}
 
 func (q *ColumnNullCount) Key() string {
-	return fmt.Sprintf("ColumnNullCount:%s:%s", q.TableName, q.ColumnName)
+	return fmt.Sprintf("ColumnNullCount:%s:%s:%s", q.TableName, q.ColumnName, tableSecurityKey(q.Security))
 }
 
 func (q *ColumnNullCount) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -57,8 +59,13 @@ func (q *ColumnNullCount) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
 	nullCountSQL := fmt.Sprintf("SELECT count(*) as count from %s WHERE %s IS NULL",
-		safeName(q.TableName),
+		from,
 		safeName(q.ColumnName),
 	)
 
@@ -17,19 +17,21 @@ type ColumnNumericHistogram struct {
 	ColumnName string
 	Method     runtimev1.HistogramMethod
 	Threshold  int
+	Security   *runtime.ResolvedTableSecurity
 	Result     []*runtimev1.NumericHistogramBins_Bin
 }
 
 var _ runtime.Query = &ColumnNumericHistogram{}
 
 func (q *ColumnNumericHistogram) Key() string {
-	return fmt.Sprintf("ColumnNumericHistogram:%s:%s:%s:%d", q.TableName, q.ColumnName, q.Method.String(), q.Threshold)
+	return fmt.Sprintf("ColumnNumericHistogram:%s:%s:%s:%d:%s", q.TableName, q.ColumnName, q.Method.String(), q.Threshold, tableSecurityKey(q.Security))
 }
 
 func (q *ColumnNumericHistogram) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -75,7 +77,7 @@ func (q *ColumnNumericHistogram) Export(ctx context.Context, rt *runtime.Runtime
 	return ErrExportNotSupported
 }
 
-func (q *ColumnNumericHistogram) calculateBucketSize(ctx context.Context, olap drivers.OLAPStore, instanceID string, priority int) (float64, error) {
+func (q *ColumnNumericHistogram) calculateBucketSize(ctx context.Context, olap drivers.OLAPStore, from string, priority int) (float64, error) {
 	sanitizedColumnName := safeName(q.ColumnName)
 	querySQL := fmt.Sprintf(
 		"SELECT (approx_quantile(%s, 0.75)-approx_quantile(%s, 0.25))::DOUBLE AS iqr, approx_count_distinct(%s) AS count, (max(%s) - min(%s))::DOUBLE AS range FROM %s",
@@ -84,7 +86,7 @@ func (q *ColumnNumericHistogram) calculateBucketSize(ctx context.Context, olap d
 		sanitizedColumnName,
 		sanitizedColumnName,
 		sanitizedColumnName,
-		safeName(q.TableName),
+		from,
 	)
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
@@ -139,7 +141,12 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
-	min, max, rng, err := getMinMaxRange(ctx, olap, q.ColumnName, q.TableName, priority)
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
+	min, max, rng, err := getMinMaxRange(ctx, olap, q.ColumnName, from, priority)
 	if err != nil {
 		return err
 	}
@@ -148,7 +155,7 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
 	}
 
 	sanitizedColumnName := safeName(q.ColumnName)
-	bucketSize, err := q.calculateBucketSize(ctx, olap, instanceID, priority)
+	bucketSize, err := q.calculateBucketSize(ctx, olap, from, priority)
 	if err != nil {
 		return err
 	}
@@ -208,7 +215,7 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
 	      `,
 		selectColumn,
 		sanitizedColumnName,
-		safeName(q.TableName),
+		from,
 		bucketSize,
 		min.Float64,
 		max.Float64,
@@ -257,7 +264,12 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
-	min, max, rng, err := getMinMaxRange(ctx, olap, q.ColumnName, q.TableName, priority)
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
+	min, max, rng, err := getMinMaxRange(ctx, olap, q.ColumnName, from, priority)
 	if err != nil {
 		return err
 	}
@@ -337,7 +349,7 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 		`,
 		selectColumn,
 		sanitizedColumnName,
-		safeName(q.TableName),
+		from,
 		bucketCount,
 		startTick,
 		endTick,
@@ -377,7 +389,7 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 }
 
 // getMinMaxRange get min, max and range of values for a given column. This is needed since nesting it in query is throwing error in 0.9.x
-func getMinMaxRange(ctx context.Context, olap drivers.OLAPStore, columnName, tableName string, priority int) (sql.NullFloat64, sql.NullFloat64, sql.NullFloat64, error) {
+func getMinMaxRange(ctx context.Context, olap drivers.OLAPStore, columnName, from string, priority int) (sql.NullFloat64, sql.NullFloat64, sql.NullFloat64, error) {
 	sanitizedColumnName := safeName(columnName)
 	selectColumn := fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
 	minMaxSQL := fmt.Sprintf(
@@ -389,7 +401,7 @@ func getMinMaxRange(ctx context.Context, olap drivers.OLAPStore, columnName, tab
 			FROM %[1]s
 			WHERE %[2]s IS NOT NULL
 		`,
-		safeName(tableName),
+		from,
 		selectColumn,
 	)
 
@@ -13,19 +13,21 @@ import (
 type ColumnRugHistogram struct {
 	TableName  string
 	ColumnName string
+	Security   *runtime.ResolvedTableSecurity
 	Result     []*runtimev1.NumericOutliers_Outlier
 }
 
 var _ runtime.Query = &ColumnRugHistogram{}
 
 func (q *ColumnRugHistogram) Key() string {
-	return fmt.Sprintf("ColumnRugHistogram:%s:%s", q.TableName, q.ColumnName)
+	return fmt.Sprintf("ColumnRugHistogram:%s:%s:%s", q.TableName, q.ColumnName, tableSecurityKey(q.Security))
 }
 
 func (q *ColumnRugHistogram) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -61,7 +63,12 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
-	min, max, rng, err := getMinMaxRange(ctx, olap, q.ColumnName, q.TableName, priority)
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
+	min, max, rng, err := getMinMaxRange(ctx, olap, q.ColumnName, from, priority)
 	if err != nil {
 		return err
 	}
@@ -131,7 +138,7 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 `,
 		selectColumn,
 		sanitizedColumnName,
-		safeName(q.TableName),
+		from,
 		outlierPseudoBucketSize,
 		min.Float64,
 		max.Float64,
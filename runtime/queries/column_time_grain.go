@@ -15,19 +15,21 @@ import (
 type ColumnTimeGrain struct {
 	TableName  string
 	ColumnName string
+	Security   *runtime.ResolvedTableSecurity
 	Result     runtimev1.TimeGrain
 }
 
 var _ runtime.Query = &ColumnTimeGrain{}
 
 func (q *ColumnTimeGrain) Key() string {
-	return fmt.Sprintf("ColumnTimeGrain:%s:%s", q.TableName, q.ColumnName)
+	return fmt.Sprintf("ColumnTimeGrain:%s:%s:%s", q.TableName, q.ColumnName, tableSecurityKey(q.Security))
 }
 
 func (q *ColumnTimeGrain) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -51,6 +53,7 @@ func (q *ColumnTimeGrain) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 	sampleSize := int64(500000)
 	cq := &TableCardinality{
 		TableName: q.TableName,
+		Security:  q.Security,
 	}
 	err := rt.Query(ctx, instanceID, cq, priority)
 	if err != nil {
@@ -63,6 +66,21 @@ func (q *ColumnTimeGrain) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 		useSample = fmt.Sprintf("USING SAMPLE %d ROWS", sampleSize)
 	}
 
+	olap, release, err := rt.OLAP(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if olap.Dialect() != drivers.DialectDuckDB {
+		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
+
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
 	estimateSQL := fmt.Sprintf(`
       WITH cleaned_column AS (
           SELECT %s as cd
@@ -101,20 +119,10 @@ func (q *ColumnTimeGrain) Resolve(ctx context.Context, rt *runtime.Runtime, inst
       FROM time_grains
       `,
 		safeName(q.ColumnName),
-		safeName(q.TableName),
+		from,
 		useSample,
 	)
 
-	olap, release, err := rt.OLAP(ctx, instanceID)
-	if err != nil {
-		return err
-	}
-	defer release()
-
-	if olap.Dialect() != drivers.DialectDuckDB {
-		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
-	}
-
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            estimateSQL,
 		Priority:         priority,
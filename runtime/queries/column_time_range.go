@@ -18,19 +18,21 @@ import (
 type ColumnTimeRange struct {
 	TableName  string
 	ColumnName string
+	Security   *runtime.ResolvedTableSecurity
 	Result     *runtimev1.TimeRangeSummary
 }
 
 var _ runtime.Query = &ColumnTimeRange{}
 
 func (q *ColumnTimeRange) Key() string {
-	return fmt.Sprintf("ColumnTimeRange:%s:%s", q.TableName, q.ColumnName)
+	return fmt.Sprintf("ColumnTimeRange:%s:%s:%s", q.TableName, q.ColumnName, tableSecurityKey(q.Security))
 }
 
 func (q *ColumnTimeRange) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -57,21 +59,26 @@ func (q *ColumnTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 	}
 	defer release()
 
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		return q.resolveDuckDB(ctx, olap, priority)
+		return q.resolveDuckDB(ctx, olap, from, priority)
 	case drivers.DialectDruid:
-		return q.resolveDruid(ctx, olap, priority)
+		return q.resolveDruid(ctx, olap, from, priority)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 }
 
-func (q *ColumnTimeRange) resolveDuckDB(ctx context.Context, olap drivers.OLAPStore, priority int) error {
+func (q *ColumnTimeRange) resolveDuckDB(ctx context.Context, olap drivers.OLAPStore, from string, priority int) error {
 	rangeSQL := fmt.Sprintf(
 		"SELECT min(%[1]s) as \"min\", max(%[1]s) as \"max\", max(%[1]s) - min(%[1]s) as \"interval\" FROM %[2]s",
 		safeName(q.ColumnName),
-		safeName(q.TableName),
+		from,
 	)
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
@@ -132,7 +139,7 @@ func handleDuckDBInterval(interval any) (*runtimev1.TimeRangeSummary_Interval, e
 	return nil, fmt.Errorf("cannot handle interval type %T", interval)
 }
 
-func (q *ColumnTimeRange) resolveDruid(ctx context.Context, olap drivers.OLAPStore, priority int) error {
+func (q *ColumnTimeRange) resolveDruid(ctx context.Context, olap drivers.OLAPStore, from string, priority int) error {
 	var minTime, maxTime time.Time
 	group, ctx := errgroup.WithContext(ctx)
 
@@ -140,7 +147,7 @@ func (q *ColumnTimeRange) resolveDruid(ctx context.Context, olap drivers.OLAPSto
 		minSQL := fmt.Sprintf(
 			"SELECT min(%[1]s) as \"min\" FROM %[2]s",
 			safeName(q.ColumnName),
-			safeName(q.TableName),
+			from,
 		)
 
 		rows, err := olap.Execute(ctx, &drivers.Statement{
@@ -173,7 +180,7 @@ func (q *ColumnTimeRange) resolveDruid(ctx context.Context, olap drivers.OLAPSto
 		maxSQL := fmt.Sprintf(
 			"SELECT max(%[1]s) as \"max\" FROM %[2]s",
 			safeName(q.ColumnName),
-			safeName(q.TableName),
+			from,
 		)
 
 		rows, err := olap.Execute(ctx, &drivers.Statement{
@@ -15,6 +15,7 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"github.com/rilldata/rill/runtime/pkg/querycache"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -32,6 +33,33 @@ type ColumnTimeseriesResult struct {
 	SampleSize int32
 }
 
+func init() {
+	// Enables storing the result in query caches that serialize values
+	querycache.RegisterType("ColumnTimeseriesResult", &ColumnTimeseriesResult{})
+}
+
+func (r *ColumnTimeseriesResult) MarshalBinary() ([]byte, error) {
+	return querycache.Encode([]any{r.Meta, r.Results, r.Spark, r.TimeRange, int64(r.SampleSize)})
+}
+
+func (r *ColumnTimeseriesResult) UnmarshalBinary(data []byte) error {
+	v, err := querycache.Decode(data)
+	if err != nil {
+		return err
+	}
+	vals, ok := v.([]any)
+	if !ok || len(vals) != 5 {
+		return fmt.Errorf("ColumnTimeseriesResult: mismatched unmarshal input")
+	}
+	r.Meta, _ = vals[0].([]*runtimev1.MetricsViewColumn)
+	r.Results, _ = vals[1].([]*runtimev1.TimeSeriesValue)
+	r.Spark, _ = vals[2].([]*runtimev1.TimeSeriesValue)
+	r.TimeRange, _ = vals[3].(*runtimev1.TimeSeriesTimeRange)
+	sampleSize, _ := vals[4].(int64)
+	r.SampleSize = int32(sampleSize)
+	return nil
+}
+
 type ColumnTimeseries struct {
 	TableName           string                                            `json:"table_name"`
 	Measures            []*runtimev1.ColumnTimeSeriesRequest_BasicMeasure `json:"measures"`
@@ -40,6 +68,7 @@ type ColumnTimeseries struct {
 	Pixels              int32                                             `json:"pixels"`
 	SampleSize          int32                                             `json:"sample_size"`
 	TimeZone            string                                            `json:"time_zone,omitempty"`
+	Security            *runtime.ResolvedTableSecurity                    `json:"table_security,omitempty"`
 	Result              *ColumnTimeseriesResult                           `json:"-"`
 	FirstDayOfWeek      uint32
 	FirstMonthOfYear    uint32
@@ -64,6 +93,7 @@ func (q *ColumnTimeseries) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -94,6 +124,11 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
 	timeRange, err := q.ResolveNormaliseTimeRange(ctx, rt, instanceID, priority)
 	if err != nil {
 		return err
@@ -161,7 +196,7 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 			series AS (
 			SELECT
 				date_trunc('` + dateTruncSpecifier + `', timezone(?, ` + safeName(q.TimestampColumnName) + `::TIMESTAMPTZ) ` + timeOffsetClause1 + `) ` + timeOffsetClause2 + ` as ` + tsAlias + `,` + getExpressionColumnsFromMeasures(measures) + `
-			FROM ` + safeName(q.TableName) + ` ` + filter + `
+			FROM ` + from + ` ` + filter + `
 			GROUP BY ` + tsAlias + ` ORDER BY ` + tsAlias + `
 			)
 			-- an additional grouping is required for time zone DST (see unit tests for examples)
@@ -256,7 +291,7 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 
 		var sparkValues []*runtimev1.TimeSeriesValue
 		if q.Pixels != 0 {
-			sparkValues, err = q.CreateTimestampRollupReduction(ctx, rt, olap, instanceID, priority, temporaryTableName, tsAlias, "count")
+			sparkValues, err = q.createTimestampRollupReduction(ctx, olap, priority, safeName(temporaryTableName), tsAlias, "count")
 			if err != nil {
 				return err
 			}
@@ -286,6 +321,7 @@ func (q *ColumnTimeseries) ResolveNormaliseTimeRange(ctx context.Context, rt *ru
 		q := &RollupInterval{
 			TableName:  q.TableName,
 			ColumnName: q.TimestampColumnName,
+			Security:   q.Security,
 		}
 		err := rt.Query(ctx, instanceID, q, priority)
 		if err != nil {
@@ -306,6 +342,7 @@ func (q *ColumnTimeseries) ResolveNormaliseTimeRange(ctx context.Context, rt *ru
 		q := &ColumnTimeRange{
 			TableName:  q.TableName,
 			ColumnName: q.TimestampColumnName,
+			Security:   q.Security,
 		}
 		err := rt.Query(ctx, instanceID, q, priority)
 		if err != nil {
@@ -360,16 +397,26 @@ func (q *ColumnTimeseries) CreateTimestampRollupReduction(
 	timestampColumnName string,
 	valueColumn string,
 ) ([]*runtimev1.TimeSeriesValue, error) {
+	from, err := tableFrom(tableName, q.Security, olap.Dialect())
+	if err != nil {
+		return nil, err
+	}
+	return q.createTimestampRollupReduction(ctx, olap, priority, from, timestampColumnName, valueColumn)
+}
+
+// createTimestampRollupReduction implements CreateTimestampRollupReduction for the given FROM clause source.
+// It doesn't apply the security policy, so from must already be a secured source (such as a temporary table derived from one).
+func (q *ColumnTimeseries) createTimestampRollupReduction(ctx context.Context, olap drivers.OLAPStore, priority int, from, timestampColumnName, valueColumn string) ([]*runtimev1.TimeSeriesValue, error) {
 	safeTimestampColumnName := safeName(timestampColumnName)
 
-	rowCount, err := q.resolveRowCount(ctx, tableName, olap, priority)
+	rowCount, err := q.resolveRowCount(ctx, from, olap, priority)
 	if err != nil {
 		return nil, err
 	}
 
 	if rowCount < int64(q.Pixels*4) {
 		rows, err := olap.Execute(ctx, &drivers.Statement{
-			Query:            `SELECT ` + safeTimestampColumnName + ` as ts, "` + valueColumn + `"::DOUBLE as count FROM "` + tableName + `"`,
+			Query:            `SELECT ` + safeTimestampColumnName + ` as ts, "` + valueColumn + `"::DOUBLE as count FROM ` + from,
 			Priority:         priority,
 			ExecutionTimeout: defaultExecutionTimeout,
 		})
@@ -409,7 +456,7 @@ func (q *ColumnTimeseries) CreateTimestampRollupReduction(
 
 	querySQL := ` -- extract unix time
       WITH Q as (
-        SELECT extract('epoch' from ` + safeTimestampColumnName + `)::BIGINT as t, "` + valueColumn + `"::DOUBLE as v FROM "` + tableName + `"
+        SELECT extract('epoch' from ` + safeTimestampColumnName + `)::BIGINT as t, "` + valueColumn + `"::DOUBLE as v FROM ` + from + `
       ),
       -- generate bounds
       M as (
@@ -496,9 +543,9 @@ func (q *ColumnTimeseries) CreateTimestampRollupReduction(
 	return results, nil
 }
 
-func (q *ColumnTimeseries) resolveRowCount(ctx context.Context, tableName string, olap drivers.OLAPStore, priority int) (int64, error) {
+func (q *ColumnTimeseries) resolveRowCount(ctx context.Context, from string, olap drivers.OLAPStore, priority int) (int64, error) {
 	rows, err := olap.Execute(ctx, &drivers.Statement{
-		Query:    fmt.Sprintf("SELECT count(*) AS count FROM %s", safeName(tableName)),
+		Query:    fmt.Sprintf("SELECT count(*) AS count FROM %s", from),
 		Priority: priority,
 	})
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/querycache"
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -351,3 +352,27 @@ func parseTimeB(tst *testing.B, t string) *timestamppb.Timestamp {
 	require.NoError(tst, err)
 	return timestamppb.New(ts)
 }
+
+func TestColumnTimeseriesResult_Encode(t *testing.T) {
+	res := &queries.ColumnTimeseriesResult{
+		Meta:       []*runtimev1.MetricsViewColumn{{Name: "count", Type: "INTEGER"}},
+		Results:    []*runtimev1.TimeSeriesValue{{Ts: timestamppb.New(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)), Bin: 1}},
+		TimeRange:  &runtimev1.TimeSeriesTimeRange{Interval: runtimev1.TimeGrain_TIME_GRAIN_DAY},
+		SampleSize: 10,
+	}
+
+	data, err := querycache.Encode(res)
+	require.NoError(t, err)
+	v, err := querycache.Decode(data)
+	require.NoError(t, err)
+
+	dec, ok := v.(*queries.ColumnTimeseriesResult)
+	require.True(t, ok)
+	require.Len(t, dec.Meta, 1)
+	require.Equal(t, "count", dec.Meta[0].Name)
+	require.Len(t, dec.Results, 1)
+	require.Equal(t, res.Results[0].Ts.AsTime(), dec.Results[0].Ts.AsTime())
+	require.Nil(t, dec.Spark)
+	require.Equal(t, runtimev1.TimeGrain_TIME_GRAIN_DAY, dec.TimeRange.Interval)
+	require.Equal(t, int32(10), dec.SampleSize)
+}
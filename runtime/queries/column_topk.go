@@ -16,19 +16,21 @@ type ColumnTopK struct {
 	ColumnName string
 	Agg        string
 	K          int
+	Security   *runtime.ResolvedTableSecurity
 	Result     *runtimev1.TopK
 }
 
 var _ runtime.Query = &ColumnTopK{}
 
 func (q *ColumnTopK) Key() string {
-	return fmt.Sprintf("ColumnTopK:%s:%s:%s:%d", q.TableName, q.ColumnName, q.Agg, q.K)
+	return fmt.Sprintf("ColumnTopK:%s:%s:%s:%d:%s", q.TableName, q.ColumnName, q.Agg, q.K, tableSecurityKey(q.Security))
 }
 
 func (q *ColumnTopK) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -61,11 +63,16 @@ func (q *ColumnTopK) Resolve(ctx context.Context, rt *runtime.Runtime, instanceI
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
 	// Build SQL
 	qry := fmt.Sprintf("SELECT %s AS value, %s AS count FROM %s GROUP BY %s ORDER BY count DESC, value ASC LIMIT %d",
 		safeName(q.ColumnName),
 		q.Agg,
-		safeName(q.TableName),
+		from,
 		safeName(q.ColumnName),
 		q.K,
 	)
@@ -23,6 +23,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -54,6 +55,16 @@ func resolveMeasures(mv *runtimev1.MetricsViewSpec, inlines []*runtimev1.InlineM
 				break
 			}
 		}
+		// Expand references to other measures
+		if found && (ms[i].Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED || ms[i].Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW) {
+			expr, err := runtime.ResolveMeasureExpression(mv, n)
+			if err != nil {
+				return nil, err
+			}
+			m := proto.Clone(ms[i]).(*runtimev1.MetricsViewSpec_MeasureV2)
+			m.Expression = expr
+			ms[i] = m
+		}
 		if !found {
 			return nil, fmt.Errorf("measure does not exist: '%s'", n)
 		}
@@ -194,6 +205,9 @@ func dimensionSelect(mv *runtimev1.MetricsViewSpec, dim *runtimev1.MetricsViewSp
 
 	unnestColName := safeName(tempName(fmt.Sprintf("%s_%s_", "unnested", dim.Name)))
 	sel := fmt.Sprintf(`%s as %s`, unnestColName, colName)
+	if dim.Table != "" {
+		return sel, fmt.Sprintf(`, LATERAL UNNEST(%s) tbl(%s)`, joinedDimensionColumn(dim), unnestColName)
+	}
 	if dim.Expression == "" {
 		// select "unnested_colName" as "colName" ... FROM "mv_table", LATERAL UNNEST("mv_table"."colName") tbl("unnested_colName") ...
 		return sel, fmt.Sprintf(`, LATERAL UNNEST(%s.%s) tbl(%s)`, safeName(mv.Table), colName, unnestColName)
@@ -532,7 +546,38 @@ func metricsViewDimension(mv *runtimev1.MetricsViewSpec, dimName string) (*runti
 	return nil, fmt.Errorf("dimension %s not found", dimName)
 }
 
+// metricsViewTimeDimension returns the name of the time dimension that a query's time range and time grain apply to.
+// If name is empty, it returns the metrics view's primary time dimension, which may also be empty.
+func metricsViewTimeDimension(mv *runtimev1.MetricsViewSpec, name string) (string, error) {
+	if name == "" || strings.EqualFold(name, mv.TimeDimension) {
+		return mv.TimeDimension, nil
+	}
+	for _, td := range mv.TimeDimensions {
+		if strings.EqualFold(td, name) {
+			return td, nil
+		}
+	}
+	return "", fmt.Errorf("time dimension %q not found", name)
+}
+
+// isTimeDimension returns true if the column is the metrics view's primary time dimension or one of its additional time dimensions.
+func isTimeDimension(mv *runtimev1.MetricsViewSpec, name string) bool {
+	if name == mv.TimeDimension {
+		return true
+	}
+	for _, td := range mv.TimeDimensions {
+		if td == name {
+			return true
+		}
+	}
+	return false
+}
+
 func metricsViewDimensionExpression(dimension *runtimev1.MetricsViewSpec_DimensionV2) string {
+	if dimension.Table != "" {
+		// The column is selected from a joined table, see metricsViewFrom
+		return joinedDimensionColumn(dimension)
+	}
 	if dimension.Expression != "" {
 		return dimension.Expression
 	}
@@ -546,11 +591,11 @@ func metricsViewDimensionExpression(dimension *runtimev1.MetricsViewSpec_Dimensi
 
 func metricsViewMeasureExpression(mv *runtimev1.MetricsViewSpec, measureName string) (string, error) {
 	for _, measure := range mv.Measures {
-		if strings.EqualFold(measure.Name, measureName) {
-			return measure.Expression, nil
+		if strings.EqualFold(measure.Name, measureName) && measure.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW {
+			return "", fmt.Errorf("window measure '%s' is not supported in this query", measure.Name)
 		}
 	}
-	return "", fmt.Errorf("measure %s not found", measureName)
+	return runtime.ResolveMeasureExpression(mv, measureName)
 }
 
 func writeCSV(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer) error {
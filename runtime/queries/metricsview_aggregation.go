@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
 )
 
 type MetricsViewAggregation struct {
@@ -19,6 +21,7 @@ type MetricsViewAggregation struct {
 	Measures           []*runtimev1.MetricsViewAggregationMeasure   `json:"measures,omitempty"`
 	Sort               []*runtimev1.MetricsViewAggregationSort      `json:"sort,omitempty"`
 	TimeRange          *runtimev1.TimeRange                         `json:"time_range,omitempty"`
+	TimeDimension      string                                       `json:"time_dimension,omitempty"`
 	Where              *runtimev1.Expression                        `json:"where,omitempty"`
 	Having             *runtimev1.Expression                        `json:"having,omitempty"`
 	Priority           int32                                        `json:"priority,omitempty"`
@@ -76,8 +79,12 @@ func (q *MetricsViewAggregation) Resolve(ctx context.Context, rt *runtime.Runtim
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
-	if q.MetricsView.TimeDimension == "" && !isTimeRangeNil(q.TimeRange) {
-		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsView)
+	timeDim, err := metricsViewTimeDimension(q.MetricsView, q.TimeDimension)
+	if err != nil {
+		return err
+	}
+	if timeDim == "" && !isTimeRangeNil(q.TimeRange) {
+		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
 	// backwards compatibility
@@ -88,8 +95,22 @@ func (q *MetricsViewAggregation) Resolve(ctx context.Context, rt *runtime.Runtim
 		q.Where = convertFilterToExpression(q.Filter)
 	}
 
+	// Read from a rollup if one can answer the query
+	mv := q.MetricsView
+	rollupReq, err := q.rollupRequest(mv)
+	if err != nil {
+		return err
+	}
+	rollupMV, err := resolveRollup(ctx, rt, instanceID, q.MetricsViewName, mv, q.ResolvedMVSecurity, rollupReq)
+	if err != nil {
+		return err
+	}
+	if rollupMV != nil {
+		mv = rollupMV
+	}
+
 	// Build query
-	sql, args, err := q.buildMetricsAggregationSQL(q.MetricsView, olap.Dialect(), q.ResolvedMVSecurity)
+	sql, args, err := q.buildMetricsAggregationSQL(mv, olap.Dialect(), q.ResolvedMVSecurity)
 	if err != nil {
 		return fmt.Errorf("error building query: %w", err)
 	}
@@ -147,9 +168,15 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		return "", nil, errors.New("no dimensions or measures specified")
 	}
 
+	timeDim, err := metricsViewTimeDimension(mv, q.TimeDimension)
+	if err != nil {
+		return "", nil, err
+	}
+
 	selectCols := make([]string, 0, len(q.Dimensions)+len(q.Measures))
 	groupCols := make([]string, 0, len(q.Dimensions))
 	unnestClauses := make([]string, 0)
+	dims := make([]*runtimev1.MetricsViewSpec_DimensionV2, 0, len(q.Dimensions))
 	args := []any{}
 
 	for _, d := range q.Dimensions {
@@ -159,6 +186,7 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 			if err != nil {
 				return "", nil, err
 			}
+			dims = append(dims, dim)
 			dimSel, unnestClause := dimensionSelect(mv, dim, dialect)
 			selectCols = append(selectCols, dimSel)
 			if unnestClause != "" {
@@ -178,9 +206,20 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		args = append(args, exprArgs...)
 	}
 
+	hasWindows := false
 	for _, m := range q.Measures {
 		switch m.BuiltinMeasure {
 		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED:
+			if wm := metricsViewWindowMeasure(mv, m.Name); wm != nil {
+				// Select the aggregated value here, the window is applied in an outer query
+				expr, err := runtime.ResolveMeasureExpression(mv, wm.Name)
+				if err != nil {
+					return "", nil, err
+				}
+				selectCols = append(selectCols, fmt.Sprintf("%s as %s", expr, safeName(m.Name)))
+				hasWindows = true
+				continue
+			}
 			expr, err := metricsViewMeasureExpression(mv, m.Name)
 			if err != nil {
 				return "", nil, err
@@ -208,9 +247,16 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 	}
 
 	whereClause := ""
-	if mv.TimeDimension != "" {
-		timeCol := safeName(mv.TimeDimension)
-		clause, err := timeRangeClause(q.TimeRange, mv, dialect, timeCol, &args)
+	outerWhereClause := ""
+	var outerArgs []any
+	if timeDim != "" {
+		var clause string
+		var err error
+		if hasWindows {
+			clause, outerWhereClause, outerArgs, err = q.windowTimeRangeClauses(mv, timeDim, &args)
+		} else {
+			clause, err = timeRangeClause(q.TimeRange, mv, dialect, safeName(timeDim), &args)
+		}
 		if err != nil {
 			return "", nil, err
 		}
@@ -264,9 +310,36 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		limitClause = fmt.Sprintf("LIMIT %d", *q.Limit)
 	}
 
+	from, err := metricsViewFrom(mv, policy, dims, q.Where, dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if hasWindows {
+		// Window measures are computed over the aggregated rows, so sorting and limits must be applied in an outer query
+		outerCols, err := q.windowSelectCols(mv, timeDim, dialect)
+		if err != nil {
+			return "", nil, err
+		}
+		sql := fmt.Sprintf("SELECT %s FROM (SELECT %s FROM %s %s %s %s %s) %s %s %s OFFSET %d",
+			strings.Join(outerCols, ", "),
+			strings.Join(selectCols, ", "),
+			from,
+			strings.Join(unnestClauses, ""),
+			whereClause,
+			groupClause,
+			havingClause,
+			outerWhereClause,
+			orderClause,
+			limitClause,
+			q.Offset,
+		)
+		return sql, append(args, outerArgs...), nil
+	}
+
 	sql := fmt.Sprintf("SELECT %s FROM %s %s %s %s %s %s %s OFFSET %d",
 		strings.Join(selectCols, ", "),
-		safeName(mv.Table),
+		from,
 		strings.Join(unnestClauses, ""),
 		whereClause,
 		groupClause,
@@ -279,9 +352,146 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 	return sql, args, nil
 }
 
+// rollupRequest describes the query for selecting a rollup that can answer it.
+func (q *MetricsViewAggregation) rollupRequest(mv *runtimev1.MetricsViewSpec) (*rollupRequest, error) {
+	req := &rollupRequest{
+		where:         q.Where,
+		timeDimension: q.TimeDimension,
+	}
+
+	for _, d := range q.Dimensions {
+		if d.TimeGrain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+			req.dimensions = append(req.dimensions, d.Name)
+			continue
+		}
+		if d.Name != mv.TimeDimension || req.timeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+			req.unsupported = true
+			continue
+		}
+		req.timeGrain = d.TimeGrain
+		req.timeZone = d.TimeZone
+	}
+
+	for _, m := range q.Measures {
+		if m.BuiltinMeasure != runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED {
+			req.unsupported = true
+			continue
+		}
+		req.measures = append(req.measures, m.Name)
+	}
+
+	if !isTimeRangeNil(q.TimeRange) {
+		if q.TimeRange.TimeZone != "" && q.TimeRange.TimeZone != "UTC" {
+			req.unsupported = true
+			return req, nil
+		}
+		start, end, err := ResolveTimeRange(q.TimeRange, mv)
+		if err != nil {
+			return nil, err
+		}
+		req.start = start
+		req.end = end
+	}
+
+	return req, nil
+}
+
+// windowSelectCols builds the select columns for an outer query that computes window measures over the aggregated rows.
+func (q *MetricsViewAggregation) windowSelectCols(mv *runtimev1.MetricsViewSpec, timeDim string, dialect drivers.Dialect) ([]string, error) {
+	wc := &windowContext{dialect: dialect}
+	for _, d := range q.Dimensions {
+		if wc.timeCol == "" && d.Name == timeDim && d.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+			wc.timeCol = safeName(d.Name)
+			wc.timeGrain = d.TimeGrain
+			wc.timeZone = d.TimeZone
+			continue
+		}
+		wc.dims = append(wc.dims, d.Name)
+		wc.dimCols = append(wc.dimCols, safeName(d.Name))
+	}
+
+	cols := make([]string, 0, len(q.Dimensions)+len(q.Measures))
+	for _, d := range q.Dimensions {
+		cols = append(cols, safeName(d.Name))
+	}
+	for _, m := range q.Measures {
+		wm := metricsViewWindowMeasure(mv, m.Name)
+		if wm == nil || m.BuiltinMeasure != runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED {
+			cols = append(cols, safeName(m.Name))
+			continue
+		}
+		expr, err := windowMeasureExpression(wm, wc)
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, fmt.Sprintf("%s as %s", expr, safeName(m.Name)))
+	}
+	return cols, nil
+}
+
+// windowTimeRangeClauses is like timeRangeClause, but for queries with window measures.
+// The inner clause includes the rows before the time range that the window measures need,
+// and the outer clause filters the time buckets that are before the time range from the results.
+// The outer clause's args are returned separately since they must follow all the args of the inner query.
+func (q *MetricsViewAggregation) windowTimeRangeClauses(mv *runtimev1.MetricsViewSpec, timeDim string, args *[]any) (string, string, []any, error) {
+	if isTimeRangeNil(q.TimeRange) {
+		return "", "", nil, nil
+	}
+
+	start, end, err := ResolveTimeRange(q.TimeRange, mv)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	var dim *runtimev1.MetricsViewAggregationDimension
+	for _, d := range q.Dimensions {
+		if d.Name == timeDim && d.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+			dim = d
+			break
+		}
+	}
+
+	timeCol := safeName(timeDim)
+	var inner, outer string
+	var outerArgs []any
+	if !start.IsZero() && dim != nil {
+		tz := time.UTC
+		if dim.TimeZone != "" {
+			tz, err = time.LoadLocation(dim.TimeZone)
+			if err != nil {
+				return "", "", nil, err
+			}
+		}
+
+		var ms []*runtimev1.MetricsViewSpec_MeasureV2
+		for _, m := range q.Measures {
+			if wm := metricsViewWindowMeasure(mv, m.Name); wm != nil && m.BuiltinMeasure == runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED {
+				ms = append(ms, wm)
+			}
+		}
+
+		bucketStart := timeutil.TruncateTime(start.In(tz), convTimeGrain(dim.TimeGrain), tz, 1, 1)
+		lookback := windowLookback(ms, bucketStart, dim.TimeGrain, tz)
+		inner = windowTimeStartClause(timeCol, start, lookback, bucketStart, args)
+
+		outer = fmt.Sprintf("WHERE %s >= ?", safeName(dim.Name))
+		outerArgs = append(outerArgs, bucketStart)
+	} else if !start.IsZero() {
+		inner = fmt.Sprintf(" AND %s >= ?", timeCol)
+		*args = append(*args, start)
+	}
+
+	if !end.IsZero() {
+		inner += fmt.Sprintf(" AND %s < ?", timeCol)
+		*args = append(*args, end)
+	}
+
+	return inner, outer, outerArgs, nil
+}
+
 func (q *MetricsViewAggregation) buildTimestampExpr(dim *runtimev1.MetricsViewAggregationDimension, dialect drivers.Dialect) (string, []any, error) {
 	var col string
-	if dim.Name == q.MetricsView.TimeDimension {
+	if isTimeDimension(q.MetricsView, dim.Name) {
 		col = safeName(dim.Name)
 	} else {
 		d, err := metricsViewDimension(q.MetricsView, dim.Name)
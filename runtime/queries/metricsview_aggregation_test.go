@@ -3,12 +3,14 @@ package queries_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -85,3 +87,131 @@ func TestMetricsViewAggregation_measure_filters(t *testing.T) {
 	require.NotEmpty(t, "news.google.com", q.Result.Data[1].AsMap()["dom"])
 	require.NotEmpty(t, "instagram.com", q.Result.Data[2].AsMap()["dom"])
 }
+
+func TestMetricsViewAggregation_derived_measure(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	ctrl, err := rt.Controller(context.Background(), instanceID)
+	require.NoError(t, err)
+	r, err := ctrl.Get(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "ad_bids_metrics"}, false)
+	require.NoError(t, err)
+	mv := proto.Clone(r.GetMetricsView().Spec).(*runtimev1.MetricsViewSpec)
+	mv.Measures = append(mv.Measures, &runtimev1.MetricsViewSpec_MeasureV2{
+		Name:       "bid_total",
+		Expression: "measure_0 * measure_1",
+		Type:       runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED,
+	})
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{
+				Name: "dom",
+			},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{
+				Name: "bid_total",
+			},
+		},
+		MetricsView: mv,
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "dom",
+			},
+		},
+	}
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result.Data)
+
+	tq := &queries.MetricsViewTotals{
+		MetricsViewName: "ad_bids_metrics",
+		MeasureNames:    []string{"measure_0", "measure_1", "bid_total"},
+		MetricsView:     mv,
+	}
+	err = tq.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	totals := tq.Result.Data.AsMap()
+	require.InDelta(t, totals["measure_0"].(float64)*totals["measure_1"].(float64), totals["bid_total"].(float64), 0.0001)
+}
+
+func TestMetricsViewAggregation_window_measure(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	ctrl, err := rt.Controller(context.Background(), instanceID)
+	require.NoError(t, err)
+	r, err := ctrl.Get(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "ad_bids_metrics"}, false)
+	require.NoError(t, err)
+	mv := proto.Clone(r.GetMetricsView().Spec).(*runtimev1.MetricsViewSpec)
+	mv.Measures = append(mv.Measures, &runtimev1.MetricsViewSpec_MeasureV2{
+		Name:       "bids_mtd",
+		Expression: "measure_0",
+		Type:       runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW,
+		Window: &runtimev1.MetricsViewSpec_MeasureWindow{
+			Kind:   runtimev1.MetricsViewSpec_WINDOW_KIND_RUNNING_SUM,
+			Period: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+		},
+	})
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{
+				Name:      "timestamp",
+				TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+			},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{
+				Name: "measure_0",
+			},
+			{
+				Name: "bids_mtd",
+			},
+		},
+		MetricsView: mv,
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "timestamp",
+			},
+		},
+	}
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result.Data)
+
+	var sum float64
+	var month time.Month
+	for _, row := range q.Result.Data {
+		m := row.AsMap()
+		ts, err := time.Parse(time.RFC3339, m["timestamp"].(string))
+		require.NoError(t, err)
+		if ts.Month() != month {
+			month = ts.Month()
+			sum = 0
+		}
+		sum += m["measure_0"].(float64)
+		require.Equal(t, sum, m["bids_mtd"].(float64))
+	}
+
+	// A time range that starts in the middle of a month must not restart the running sum
+	mtd := make(map[string]float64)
+	for _, row := range q.Result.Data {
+		m := row.AsMap()
+		mtd[m["timestamp"].(string)] = m["bids_mtd"].(float64)
+	}
+	mid := q.Result.Data[len(q.Result.Data)/2].AsMap()
+	start, err := time.Parse(time.RFC3339, mid["timestamp"].(string))
+	require.NoError(t, err)
+	q.TimeRange = &runtimev1.TimeRange{Start: timestamppb.New(start)}
+	q.Result = nil
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result.Data)
+	require.Equal(t, mid["timestamp"], q.Result.Data[0].AsMap()["timestamp"])
+	for _, row := range q.Result.Data {
+		m := row.AsMap()
+		require.Equal(t, mtd[m["timestamp"].(string)], m["bids_mtd"].(float64))
+	}
+}
@@ -23,6 +23,7 @@ type MetricsViewComparison struct {
 	Measures            []*runtimev1.MetricsViewAggregationMeasure     `json:"measures,omitempty"`
 	TimeRange           *runtimev1.TimeRange                           `json:"base_time_range,omitempty"`
 	ComparisonTimeRange *runtimev1.TimeRange                           `json:"comparison_time_range,omitempty"`
+	TimeDimension       string                                         `json:"time_dimension,omitempty"`
 	Limit               int64                                          `json:"limit,omitempty"`
 	Offset              int64                                          `json:"offset,omitempty"`
 	Sort                []*runtimev1.MetricsViewComparisonSort         `json:"sort,omitempty"`
@@ -82,7 +83,11 @@ func (q *MetricsViewComparison) Resolve(ctx context.Context, rt *runtime.Runtime
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
-	if q.MetricsView.TimeDimension == "" && (!isTimeRangeNil(q.TimeRange) || !isTimeRangeNil(q.ComparisonTimeRange)) {
+	timeDim, err := metricsViewTimeDimension(q.MetricsView, q.TimeDimension)
+	if err != nil {
+		return err
+	}
+	if timeDim == "" && (!isTimeRangeNil(q.TimeRange) || !isTimeRangeNil(q.ComparisonTimeRange)) {
 		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
@@ -237,6 +242,10 @@ func (q *MetricsViewComparison) buildMetricsTopListSQL(mv *runtimev1.MetricsView
 	if err != nil {
 		return "", nil, err
 	}
+	from, err := metricsViewFrom(mv, policy, []*runtimev1.MetricsViewSpec_DimensionV2{dim}, q.Where, dialect)
+	if err != nil {
+		return "", nil, err
+	}
 	colName := safeName(dim.Name)
 
 	labelMap := make(map[string]string, len(mv.Measures))
@@ -295,7 +304,11 @@ func (q *MetricsViewComparison) buildMetricsTopListSQL(mv *runtimev1.MetricsView
 	baseWhereClause := "1=1"
 
 	args := []any{}
-	td := safeName(mv.TimeDimension)
+	timeDim, err := metricsViewTimeDimension(mv, q.TimeDimension)
+	if err != nil {
+		return "", nil, err
+	}
+	td := safeName(timeDim)
 
 	trc, err := timeRangeClause(q.TimeRange, mv, dialect, td, &args)
 	if err != nil {
@@ -362,27 +375,27 @@ func (q *MetricsViewComparison) buildMetricsTopListSQL(mv *runtimev1.MetricsView
 		labelSelectClause := strings.Join(labelCols, ", ")
 		sql = fmt.Sprintf(
 			`SELECT %[8]s FROM (SELECT %[1]s FROM %[2]s %[7]s WHERE %[3]s GROUP BY 1 %[9]s %[4]s %[5]s OFFSET %[6]d)`,
-			selectClause,       // 1
-			safeName(mv.Table), // 2
-			baseWhereClause,    // 3
-			orderByClause,      // 4
-			limitClause,        // 5
-			q.Offset,           // 6
-			unnestClause,       // 7
-			labelSelectClause,  // 8
-			havingClause,       // 9
+			selectClause,      // 1
+			from,              // 2
+			baseWhereClause,   // 3
+			orderByClause,     // 4
+			limitClause,       // 5
+			q.Offset,          // 6
+			unnestClause,      // 7
+			labelSelectClause, // 8
+			havingClause,      // 9
 		)
 	} else {
 		sql = fmt.Sprintf(
 			`SELECT %[1]s FROM %[2]s %[7]s WHERE %[3]s GROUP BY 1 %[8]s %[4]s %[5]s OFFSET %[6]d`,
-			selectClause,       // 1
-			safeName(mv.Table), // 2
-			baseWhereClause,    // 3
-			orderByClause,      // 4
-			limitClause,        // 5
-			q.Offset,           // 6
-			unnestClause,       // 7
-			havingClause,       // 8
+			selectClause,    // 1
+			from,            // 2
+			baseWhereClause, // 3
+			orderByClause,   // 4
+			limitClause,     // 5
+			q.Offset,        // 6
+			unnestClause,    // 7
+			havingClause,    // 8
 		)
 	}
 
@@ -394,6 +407,10 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 	if err != nil {
 		return "", nil, err
 	}
+	from, err := metricsViewFrom(mv, policy, []*runtimev1.MetricsViewSpec_DimensionV2{dim}, q.Where, dialect)
+	if err != nil {
+		return "", nil, err
+	}
 
 	colName := safeName(dim.Name)
 
@@ -498,11 +515,15 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 	comparisonWhereClause := "1=1"
 
 	args := []any{}
-	if mv.TimeDimension == "" {
+	timeDim, err := metricsViewTimeDimension(mv, q.TimeDimension)
+	if err != nil {
+		return "", nil, err
+	}
+	if timeDim == "" {
 		return "", nil, fmt.Errorf("metrics view '%s' doesn't have time dimension", q.MetricsViewName)
 	}
 
-	td := safeName(mv.TimeDimension)
+	td := safeName(timeDim)
 
 	trc, err := timeRangeClause(q.TimeRange, mv, dialect, td, &args)
 	if err != nil {
@@ -698,7 +719,7 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 		`,
 				subSelectClause,       // 1
 				colName,               // 2
-				safeName(mv.Table),    // 3
+				from,                  // 3
 				baseWhereClause,       // 4
 				comparisonWhereClause, // 5
 				orderByClause,         // 6
@@ -731,7 +752,7 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 		`,
 				subSelectClause,       // 1
 				colName,               // 2
-				safeName(mv.Table),    // 3
+				from,                  // 3
 				baseWhereClause,       // 4
 				comparisonWhereClause, // 5
 				orderByClause,         // 6
@@ -811,7 +832,7 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 			`,
 			subSelectClause,       // 1
 			colName,               // 2
-			safeName(mv.Table),    // 3
+			from,                  // 3
 			leftWhereClause,       // 4
 			rightWhereClause,      // 5
 			orderByClause,         // 6
@@ -0,0 +1,191 @@
+package queries
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// metricsViewFrom returns the source to use in the FROM clause of a metrics view query.
+// It joins the lookup tables needed by the given dimensions and filter expression, and applies the security policy's row filter and masks to the base table.
+// The source is aliased to the base table's name, so expressions that reference columns of the base table keep working.
+// If neither joins, a row filter nor masks are needed, it returns the base table's name.
+func metricsViewFrom(mv *runtimev1.MetricsViewSpec, policy *runtime.ResolvedMetricsViewSecurity, dims []*runtimev1.MetricsViewSpec_DimensionV2, where *runtimev1.Expression, dialect drivers.Dialect) (string, error) {
+	base := safeName(mv.Table)
+
+	rowFilter := ""
+	if policy != nil && policy.RowFilter != "" {
+		rowFilter = policy.RowFilter
+	}
+
+	masks, err := maskedColumns(mv, policy, dialect)
+	if err != nil {
+		return "", err
+	}
+
+	// Masks are applied to the base table's columns, so the masked values are used for selects, filters, grouping and sorting alike.
+	// The row filter is evaluated against the unmasked values.
+	src := base
+	if rowFilter != "" || len(masks[""]) > 0 {
+		sel := "*"
+		if replace := masks[""]; len(replace) > 0 {
+			cols := make([]string, 0, len(replace))
+			for col := range replace {
+				cols = append(cols, col)
+			}
+			sort.Strings(cols)
+			exprs := make([]string, len(cols))
+			for i, col := range cols {
+				exprs[i] = fmt.Sprintf("%s AS %s", replace[col], safeName(col))
+			}
+			sel = fmt.Sprintf("* REPLACE (%s)", strings.Join(exprs, ", "))
+		}
+		src = fmt.Sprintf("(SELECT %s FROM %s", sel, base)
+		if rowFilter != "" {
+			src += " WHERE " + rowFilter
+		}
+		src += ") AS " + base
+	}
+
+	joins := requiredJoins(mv, dims, where)
+	if len(joins) == 0 {
+		return src, nil
+	}
+
+	var b strings.Builder
+	b.WriteString(src)
+	for _, j := range joins {
+		alias := safeName(joinAlias(j))
+		key := safeName(joinAlias(j) + "_key")
+
+		// Select one value per key from the lookup table so the join can't fan out the base table's rows
+		cols := []string{fmt.Sprintf("%s AS %s", safeName(j.On), key)}
+		for _, dim := range mv.Dimensions {
+			if strings.EqualFold(dim.Table, j.Table) {
+				expr := safeName(dim.Column)
+				if m, ok := masks[strings.ToLower(dim.Table)][dim.Column]; ok {
+					expr = m
+				}
+				cols = append(cols, fmt.Sprintf("ANY_VALUE(%s) AS %s", expr, joinedDimensionColumn(dim)))
+			}
+		}
+		fmt.Fprintf(&b, " LEFT JOIN (SELECT %s FROM %s GROUP BY %s) AS %s ON %s.%s = %s.%s",
+			strings.Join(cols, ", "),
+			safeName(j.Table),
+			safeName(j.On),
+			alias,
+			base,
+			safeName(j.On),
+			alias,
+			key,
+		)
+	}
+	return b.String(), nil
+}
+
+// maskedColumns returns the masking expressions for the columns of the dimensions masked by the security policy.
+// The result is keyed by the lower-cased name of the dimension's joined table ("" for the base table) and then by column name.
+// If several masked dimensions reference the same column, the strictest mask is used.
+func maskedColumns(mv *runtimev1.MetricsViewSpec, policy *runtime.ResolvedMetricsViewSecurity, dialect drivers.Dialect) (map[string]map[string]string, error) {
+	if policy == nil || len(policy.Mask) == 0 {
+		return nil, nil
+	}
+	if dialect != drivers.DialectDuckDB {
+		return nil, fmt.Errorf("masking dimensions is not supported for dialect %q", dialect.String())
+	}
+
+	res := make(map[string]map[string]string)
+	// Apply masks from least to most strict, so stricter masks overwrite weaker ones on shared columns
+	for _, with := range []string{"partial", "hash", "redact"} {
+		for _, dim := range mv.Dimensions {
+			if policy.Mask[dim.Name] != with {
+				continue
+			}
+			if dim.Column == "" {
+				return nil, fmt.Errorf("cannot mask dimension %q because it does not reference a column", dim.Name)
+			}
+			table := strings.ToLower(dim.Table)
+			if res[table] == nil {
+				res[table] = make(map[string]string)
+			}
+			res[table][dim.Column] = maskExpression(safeName(dim.Column), with)
+		}
+	}
+	return res, nil
+}
+
+// maskExpression returns an expression that masks the values of the given column expression.
+// NULL values are kept as NULL for all masking functions.
+func maskExpression(expr, with string) string {
+	switch with {
+	case "hash":
+		return fmt.Sprintf("md5(CAST(%s AS VARCHAR))", expr)
+	case "partial":
+		// Keeps the first character and the domain of email addresses, e.g. "jane@example.com" becomes "j***@example.com"
+		return fmt.Sprintf(`regexp_replace(CAST(%s AS VARCHAR), '^(.)[^@]*', '\1***')`, expr)
+	default:
+		return fmt.Sprintf("CASE WHEN %s IS NULL THEN NULL ELSE '***' END", expr)
+	}
+}
+
+// requiredJoins returns the joins needed to resolve the given dimensions and the dimensions referenced in the filter expression.
+func requiredJoins(mv *runtimev1.MetricsViewSpec, dims []*runtimev1.MetricsViewSpec_DimensionV2, where *runtimev1.Expression) []*runtimev1.MetricsViewSpec_JoinV2 {
+	if len(mv.Joins) == 0 {
+		return nil
+	}
+
+	tables := make(map[string]bool)
+	for _, dim := range dims {
+		if dim != nil && dim.Table != "" {
+			tables[strings.ToLower(dim.Table)] = true
+		}
+	}
+	for _, ident := range expressionIdentifiers(where) {
+		for _, dim := range mv.Dimensions {
+			if strings.EqualFold(dim.Name, ident) && dim.Table != "" {
+				tables[strings.ToLower(dim.Table)] = true
+			}
+		}
+	}
+
+	var joins []*runtimev1.MetricsViewSpec_JoinV2
+	for _, j := range mv.Joins {
+		if tables[strings.ToLower(j.Table)] {
+			joins = append(joins, j)
+		}
+	}
+	return joins
+}
+
+// expressionIdentifiers returns the identifiers referenced in an expression.
+func expressionIdentifiers(expr *runtimev1.Expression) []string {
+	if expr == nil {
+		return nil
+	}
+	switch e := expr.Expression.(type) {
+	case *runtimev1.Expression_Ident:
+		return []string{e.Ident}
+	case *runtimev1.Expression_Cond:
+		var res []string
+		for _, sub := range e.Cond.Exprs {
+			res = append(res, expressionIdentifiers(sub)...)
+		}
+		return res
+	}
+	return nil
+}
+
+// joinAlias returns the alias of a joined table in a metrics view query.
+func joinAlias(j *runtimev1.MetricsViewSpec_JoinV2) string {
+	return "__rill_join_" + j.Table
+}
+
+// joinedDimensionColumn returns the quoted name of the column that holds a joined dimension's value in a metrics view query.
+// The name is prefixed to prevent collisions with columns of the base table.
+func joinedDimensionColumn(dim *runtimev1.MetricsViewSpec_DimensionV2) string {
+	return safeName("__rill_dim_" + dim.Name)
+}
@@ -0,0 +1,156 @@
+package queries
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/expressionpb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_metricsViewFrom(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table: "orders",
+		Joins: []*runtimev1.MetricsViewSpec_JoinV2{
+			{Table: "dim_customers", On: "customer_id"},
+			{Table: "dim_products", On: "product_id"},
+		},
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country"},
+			{Name: "segment", Column: "segment", Table: "dim_customers"},
+			{Name: "category", Column: "category", Table: "dim_products"},
+		},
+	}
+	policy := &runtime.ResolvedMetricsViewSecurity{RowFilter: "country = 'DK'"}
+
+	// No joins needed
+	from, err := metricsViewFrom(mv, nil, mv.Dimensions[:1], nil, drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Equal(t, `"orders"`, from)
+	from, err = metricsViewFrom(mv, policy, mv.Dimensions[:1], nil, drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Equal(t, `(SELECT * FROM "orders" WHERE country = 'DK') AS "orders"`, from)
+
+	// Join needed by a dimension
+	from, err = metricsViewFrom(mv, policy, mv.Dimensions[:2], nil, drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`(SELECT * FROM "orders" WHERE country = 'DK') AS "orders" LEFT JOIN (SELECT "customer_id" AS "__rill_join_dim_customers_key", ANY_VALUE("segment") AS "__rill_dim_segment" FROM "dim_customers" GROUP BY "customer_id") AS "__rill_join_dim_customers" ON "orders"."customer_id" = "__rill_join_dim_customers"."__rill_join_dim_customers_key"`,
+		from,
+	)
+
+	// Join needed by a filter
+	where := eqExpression("category", "books")
+	from, err = metricsViewFrom(mv, nil, nil, where, drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Contains(t, from, `LEFT JOIN (SELECT "product_id" AS "__rill_join_dim_products_key", ANY_VALUE("category") AS "__rill_dim_category" FROM "dim_products" GROUP BY "product_id")`)
+	require.NotContains(t, from, "dim_customers")
+
+	// Dimension names in filters are matched case-insensitively
+	where = eqExpression("Category", "books")
+	from, err = metricsViewFrom(mv, nil, nil, where, drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Contains(t, from, `LEFT JOIN (SELECT "product_id" AS "__rill_join_dim_products_key"`)
+}
+
+func Test_metricsViewFrom_mask(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table: "orders",
+		Joins: []*runtimev1.MetricsViewSpec_JoinV2{
+			{Table: "dim_customers", On: "customer_id"},
+		},
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country"},
+			{Name: "email", Column: "email"},
+			{Name: "email_domain", Expression: "split_part(email, '@', 2)"},
+			{Name: "customer_name", Column: "name", Table: "dim_customers"},
+		},
+	}
+
+	// Masks replace the base table's columns and apply after the row filter
+	policy := &runtime.ResolvedMetricsViewSecurity{
+		RowFilter: "country = 'DK'",
+		Mask:      map[string]string{"email": "hash", "country": "partial"},
+	}
+	from, err := metricsViewFrom(mv, policy, nil, nil, drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Equal(t, `(SELECT * REPLACE (regexp_replace(CAST("country" AS VARCHAR), '^(.)[^@]*', '\1***') AS "country", md5(CAST("email" AS VARCHAR)) AS "email") FROM "orders" WHERE country = 'DK') AS "orders"`, from)
+
+	// Masks on joined dimensions are applied in the join
+	policy = &runtime.ResolvedMetricsViewSecurity{Mask: map[string]string{"customer_name": "redact"}}
+	from, err = metricsViewFrom(mv, policy, mv.Dimensions[3:], nil, drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Equal(t, `"orders" LEFT JOIN (SELECT "customer_id" AS "__rill_join_dim_customers_key", ANY_VALUE(CASE WHEN "name" IS NULL THEN NULL ELSE '***' END) AS "__rill_dim_customer_name" FROM "dim_customers" GROUP BY "customer_id") AS "__rill_join_dim_customers" ON "orders"."customer_id" = "__rill_join_dim_customers"."__rill_join_dim_customers_key"`, from)
+
+	// Masks are not supported on Druid
+	_, err = metricsViewFrom(mv, policy, nil, nil, drivers.DialectDruid)
+	require.Error(t, err)
+}
+
+func Test_buildMetricsRowsSQL_mask(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table: "users",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "email", Column: "email"},
+		},
+	}
+	policy := &runtime.ResolvedMetricsViewSecurity{Access: true, Mask: map[string]string{"email": "redact"}}
+
+	q := &MetricsViewRows{MetricsViewName: "users_metrics", Where: eqExpression("email", "jane@example.com")}
+	sql, args, err := q.buildMetricsRowsSQL(mv, drivers.DialectDuckDB, "", policy)
+	require.NoError(t, err)
+	// Filters are evaluated against the masked values, so they can't be used to probe the raw values
+	require.Contains(t, sql, `SELECT * FROM (SELECT * REPLACE (CASE WHEN "email" IS NULL THEN NULL ELSE '***' END AS "email") FROM "users") AS "users" WHERE 1=1 AND ("email") = (?)`)
+	require.Equal(t, []any{"jane@example.com"}, args)
+}
+
+func Test_buildMetricsAggregationSQL_joins(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table: "orders",
+		Joins: []*runtimev1.MetricsViewSpec_JoinV2{
+			{Table: "dim_customers", On: "customer_id"},
+		},
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country"},
+			{Name: "segment", Column: "segment", Table: "dim_customers"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "sum(amount)"},
+		},
+	}
+
+	q := &MetricsViewAggregation{
+		MetricsViewName: "orders_metrics",
+		Dimensions:      []*runtimev1.MetricsViewAggregationDimension{{Name: "country"}},
+		Measures:        []*runtimev1.MetricsViewAggregationMeasure{{Name: "revenue"}},
+		Where:           eqExpression("segment", "enterprise"),
+		MetricsView:     mv,
+	}
+
+	sql, args, err := q.buildMetricsAggregationSQL(mv, drivers.DialectDuckDB, nil)
+	require.NoError(t, err)
+	require.Contains(t, sql, `SELECT ("country") as "country", sum(amount) as "revenue" FROM "orders" LEFT JOIN (SELECT "customer_id" AS "__rill_join_dim_customers_key"`)
+	require.Contains(t, sql, `WHERE 1=1 AND ("__rill_dim_segment") = (?)`)
+	require.Equal(t, []any{"enterprise"}, args)
+
+	q.Dimensions = []*runtimev1.MetricsViewAggregationDimension{{Name: "segment"}}
+	q.Where = nil
+	sql, _, err = q.buildMetricsAggregationSQL(mv, drivers.DialectDuckDB, nil)
+	require.NoError(t, err)
+	require.Contains(t, sql, `SELECT ("__rill_dim_segment") as "segment", sum(amount) as "revenue" FROM "orders" LEFT JOIN`)
+}
+
+func eqExpression(col, val string) *runtimev1.Expression {
+	return &runtimev1.Expression{
+		Expression: &runtimev1.Expression_Cond{
+			Cond: &runtimev1.Condition{
+				Op:    runtimev1.Operation_OPERATION_EQ,
+				Exprs: []*runtimev1.Expression{expressionpb.Identifier(col), expressionpb.Value(structpb.NewStringValue(val))},
+			},
+		},
+	}
+}
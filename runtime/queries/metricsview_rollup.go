@@ -0,0 +1,316 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BuildMetricsViewRollupSQL returns the SQL that materializes a rollup of a metrics view.
+// The result has a column for each of the rollup's dimensions and measures, named after them.
+// If the rollup has a time grain, it also has a column for the primary time dimension truncated to that grain.
+func BuildMetricsViewRollupSQL(mv *runtimev1.MetricsViewSpec, rollup *runtimev1.MetricsViewSpec_RollupV2, dialect drivers.Dialect) (string, error) {
+	q := &MetricsViewAggregation{MetricsView: mv}
+
+	for _, name := range rollup.Dimensions {
+		dim, err := metricsViewDimension(mv, name)
+		if err != nil {
+			return "", err
+		}
+		if dim.Unnest {
+			return "", fmt.Errorf("unnested dimension %q is not supported in rollups", dim.Name)
+		}
+		q.Dimensions = append(q.Dimensions, &runtimev1.MetricsViewAggregationDimension{Name: dim.Name})
+	}
+
+	if rollup.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+		if mv.TimeDimension == "" {
+			return "", fmt.Errorf("metrics view does not have a time dimension")
+		}
+		q.Dimensions = append(q.Dimensions, &runtimev1.MetricsViewAggregationDimension{Name: mv.TimeDimension, TimeGrain: rollup.TimeGrain})
+	}
+
+	names := runtime.RollupMeasures(mv, rollup)
+	if len(names) == 0 {
+		return "", fmt.Errorf("no measures can be re-aggregated")
+	}
+	for _, name := range names {
+		m := metricsViewSimpleMeasure(mv, name)
+		if m == nil {
+			return "", fmt.Errorf("measure %q is not a simple measure", name)
+		}
+		if _, ok := runtime.ReaggregationFunction(m.Expression); !ok {
+			return "", fmt.Errorf("measure %q cannot be re-aggregated, only SUM, COUNT, MIN and MAX aggregations are supported", m.Name)
+		}
+		q.Measures = append(q.Measures, &runtimev1.MetricsViewAggregationMeasure{Name: m.Name})
+	}
+
+	sql, args, err := q.buildMetricsAggregationSQL(mv, dialect, nil)
+	if err != nil {
+		return "", err
+	}
+	if len(args) > 0 {
+		return "", fmt.Errorf("unexpected arguments in rollup query")
+	}
+	return sql, nil
+}
+
+// rollupRequest describes the parts of a metrics view query that determine whether a rollup can answer it.
+type rollupRequest struct {
+	dimensions    []string
+	measures      []string
+	where         *runtimev1.Expression
+	timeDimension string
+	timeGrain     runtimev1.TimeGrain
+	timeZone      string
+	start         time.Time
+	end           time.Time
+	// unsupported is set if the query uses features that rollups can't answer, like inline measures.
+	unsupported bool
+}
+
+// resolveRollup returns a metrics view spec that reads from the smallest materialized rollup that can answer the request.
+// It returns nil if no rollup can answer the request, in which case the query should read from the metrics view's table.
+func resolveRollup(ctx context.Context, rt *runtime.Runtime, instanceID, name string, mv *runtimev1.MetricsViewSpec, policy *runtime.ResolvedMetricsViewSecurity, req *rollupRequest) (*runtimev1.MetricsViewSpec, error) {
+	if len(mv.Rollups) == 0 || req.unsupported {
+		return nil, nil
+	}
+
+	// Rollups are materialized without the row filter and masks applied
+	if policy != nil && (policy.RowFilter != "" || len(policy.Mask) > 0) {
+		return nil, nil
+	}
+
+	ctrl, err := rt.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: name}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	state := r.GetMetricsView().State
+	if state == nil || state.ValidSpec == nil {
+		return nil, nil
+	}
+
+	refreshedOn := runtime.LatestRefreshedOn(ctx, ctrl, r.Meta.Refs)
+	rollup, table := selectRollup(state.ValidSpec, state.Rollups, refreshedOn, req)
+	if rollup == nil {
+		return nil, nil
+	}
+	return rollupMetricsView(state.ValidSpec, rollup, table), nil
+}
+
+// selectRollup returns the rollup with the fewest rows that can answer the request and the name of its table.
+// Rollups that were materialized before the latest refresh of the metrics view's sources and models (refreshedOn) are skipped,
+// since they are stale until the metrics view is reconciled again.
+// It returns nil if no materialized rollup can answer the request.
+func selectRollup(mv *runtimev1.MetricsViewSpec, states []*runtimev1.MetricsViewRollupState, refreshedOn *timestamppb.Timestamp, req *rollupRequest) (*runtimev1.MetricsViewSpec_RollupV2, string) {
+	var res *runtimev1.MetricsViewSpec_RollupV2
+	var resState *runtimev1.MetricsViewRollupState
+	for _, s := range states {
+		if !proto.Equal(s.RefsRefreshedOn, refreshedOn) {
+			continue
+		}
+		var rollup *runtimev1.MetricsViewSpec_RollupV2
+		for _, r := range mv.Rollups {
+			if r.Name == s.Name {
+				rollup = r
+				break
+			}
+		}
+		if rollup == nil || !rollupCanAnswer(mv, rollup, req) {
+			continue
+		}
+		if resState == nil || s.RowCount < resState.RowCount {
+			res = rollup
+			resState = s
+		}
+	}
+	if res == nil {
+		return nil, ""
+	}
+	return res, resState.Table
+}
+
+// rollupCanAnswer returns true if the rollup has the dimensions, measures and time granularity needed to answer the request.
+func rollupCanAnswer(mv *runtimev1.MetricsViewSpec, rollup *runtimev1.MetricsViewSpec_RollupV2, req *rollupRequest) bool {
+	if req.unsupported {
+		return false
+	}
+	if req.timeDimension != "" && !strings.EqualFold(req.timeDimension, mv.TimeDimension) {
+		return false
+	}
+	if req.timeZone != "" && req.timeZone != "UTC" {
+		return false
+	}
+
+	dims := make(map[string]bool, len(rollup.Dimensions))
+	for _, d := range rollup.Dimensions {
+		dims[strings.ToLower(d)] = true
+	}
+	for _, d := range req.dimensions {
+		if !dims[strings.ToLower(d)] {
+			return false
+		}
+	}
+	for _, ident := range expressionIdentifiers(req.where) {
+		if !dims[strings.ToLower(ident)] {
+			return false
+		}
+	}
+
+	measures := make(map[string]bool)
+	for _, m := range runtime.RollupMeasures(mv, rollup) {
+		measures[strings.ToLower(m)] = true
+	}
+	for _, m := range req.measures {
+		if !rollupHasMeasure(mv, measures, m) {
+			return false
+		}
+	}
+
+	if rollup.TimeGrain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+		return req.timeGrain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED && req.start.IsZero() && req.end.IsZero()
+	}
+	if req.timeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED && !timeGrainDivides(rollup.TimeGrain, req.timeGrain, mv) {
+		return false
+	}
+	return isTimeAligned(req.start, rollup.TimeGrain) && isTimeAligned(req.end, rollup.TimeGrain)
+}
+
+// rollupHasMeasure returns true if a measure can be computed from the pre-aggregated measures of a rollup.
+// Derived and window measures can be computed if they only reference measures that can be computed from the rollup.
+func rollupHasMeasure(mv *runtimev1.MetricsViewSpec, measures map[string]bool, name string) bool {
+	if measures[strings.ToLower(name)] {
+		return true
+	}
+
+	var m *runtimev1.MetricsViewSpec_MeasureV2
+	for _, candidate := range mv.Measures {
+		if strings.EqualFold(candidate.Name, name) {
+			m = candidate
+			break
+		}
+	}
+	if m == nil || m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE || m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_UNSPECIFIED {
+		return false
+	}
+
+	// Column references can't be resolved against the rollup's table
+	if len(runtime.MeasureNonReferences(mv, m)) > 0 {
+		return false
+	}
+	for _, ref := range runtime.MeasureReferences(mv, m) {
+		if !rollupHasMeasure(mv, measures, ref) {
+			return false
+		}
+	}
+	return true
+}
+
+// timeGrainDivides returns true if values truncated to the rollup grain can be truncated further to the query grain.
+// Rollups are truncated without the metrics view's first day of week and first month of year,
+// so week, quarter and year rollups can only answer queries that don't shift their buckets.
+func timeGrainDivides(rollupGrain, queryGrain runtimev1.TimeGrain, mv *runtimev1.MetricsViewSpec) bool {
+	if queryGrain < rollupGrain {
+		return false
+	}
+	switch rollupGrain {
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		return queryGrain == runtimev1.TimeGrain_TIME_GRAIN_WEEK && mv.FirstDayOfWeek <= 1
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return queryGrain != runtimev1.TimeGrain_TIME_GRAIN_WEEK
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		return queryGrain == runtimev1.TimeGrain_TIME_GRAIN_QUARTER || mv.FirstMonthOfYear <= 1
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return mv.FirstMonthOfYear <= 1
+	default:
+		// Day and finer grains divide all coarser grains
+		return true
+	}
+}
+
+// isTimeAligned returns true if t is zero or falls on the boundary of a time grain in UTC.
+func isTimeAligned(t time.Time, grain runtimev1.TimeGrain) bool {
+	if t.IsZero() {
+		return true
+	}
+	t = t.In(time.UTC)
+	return t.Equal(timeutil.TruncateTime(t, convTimeGrain(grain), time.UTC, 1, 1))
+}
+
+// rollupMetricsView returns a metrics view spec that reads from a rollup's table.
+// Dimensions map to the rollup's columns, and simple measures re-aggregate the rollup's pre-aggregated columns.
+// Derived and window measures are kept as-is since they only reference other measures.
+func rollupMetricsView(mv *runtimev1.MetricsViewSpec, rollup *runtimev1.MetricsViewSpec_RollupV2, table string) *runtimev1.MetricsViewSpec {
+	res := &runtimev1.MetricsViewSpec{
+		Connector:        mv.Connector,
+		Table:            table,
+		FirstDayOfWeek:   mv.FirstDayOfWeek,
+		FirstMonthOfYear: mv.FirstMonthOfYear,
+	}
+	if rollup.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+		res.TimeDimension = mv.TimeDimension
+	}
+
+	for _, name := range rollup.Dimensions {
+		dim, err := metricsViewDimension(mv, name)
+		if err != nil {
+			continue
+		}
+		res.Dimensions = append(res.Dimensions, &runtimev1.MetricsViewSpec_DimensionV2{
+			Name:   dim.Name,
+			Column: dim.Name,
+		})
+	}
+
+	measures := make(map[string]bool)
+	for _, m := range runtime.RollupMeasures(mv, rollup) {
+		measures[strings.ToLower(m)] = true
+	}
+	for _, m := range mv.Measures {
+		if m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED || m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW {
+			res.Measures = append(res.Measures, m)
+			continue
+		}
+		if !measures[strings.ToLower(m.Name)] {
+			continue
+		}
+		fn, ok := runtime.ReaggregationFunction(m.Expression)
+		if !ok {
+			continue
+		}
+		res.Measures = append(res.Measures, &runtimev1.MetricsViewSpec_MeasureV2{
+			Name:       m.Name,
+			Expression: fmt.Sprintf("%s(%s)", fn, safeName(m.Name)),
+			Type:       m.Type,
+		})
+	}
+
+	return res
+}
+
+// metricsViewSimpleMeasure returns the simple measure with the given name, or nil if it doesn't exist.
+func metricsViewSimpleMeasure(mv *runtimev1.MetricsViewSpec, name string) *runtimev1.MetricsViewSpec_MeasureV2 {
+	for _, m := range mv.Measures {
+		if strings.EqualFold(m.Name, name) {
+			if m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED || m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW {
+				return nil
+			}
+			return m
+		}
+	}
+	return nil
+}
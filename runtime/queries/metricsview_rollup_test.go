@@ -0,0 +1,127 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func rollupTestMetricsView() *runtimev1.MetricsViewSpec {
+	return &runtimev1.MetricsViewSpec{
+		Table:         "orders",
+		TimeDimension: "ordered_at",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country"},
+			{Name: "city", Column: "city"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "sum(amount)"},
+			{Name: "orders", Expression: "count(*)"},
+			{Name: "users", Expression: "count(distinct user_id)"},
+			{Name: "aov", Expression: "revenue / orders", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED},
+		},
+		Rollups: []*runtimev1.MetricsViewSpec_RollupV2{
+			{Name: "daily_country", Dimensions: []string{"country"}, TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY},
+			{Name: "monthly", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH, Measures: []string{"revenue"}},
+			{Name: "city", Dimensions: []string{"country", "city"}},
+		},
+	}
+}
+
+func TestBuildMetricsViewRollupSQL(t *testing.T) {
+	mv := rollupTestMetricsView()
+
+	sql, err := BuildMetricsViewRollupSQL(mv, mv.Rollups[0], drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT ("country") as "country", date_trunc('DAY', "ordered_at") as "ordered_at", sum(amount) as "revenue", count(*) as "orders" FROM "orders"   GROUP BY "country", date_trunc('DAY', "ordered_at")    OFFSET 0`, sql)
+
+	_, err = BuildMetricsViewRollupSQL(mv, &runtimev1.MetricsViewSpec_RollupV2{Name: "r", Measures: []string{"users"}}, drivers.DialectDuckDB)
+	require.ErrorContains(t, err, `measure "users" cannot be re-aggregated`)
+
+	_, err = BuildMetricsViewRollupSQL(mv, &runtimev1.MetricsViewSpec_RollupV2{Name: "r", Measures: []string{"aov"}}, drivers.DialectDuckDB)
+	require.ErrorContains(t, err, `measure "aov" is not a simple measure`)
+}
+
+func TestSelectRollup(t *testing.T) {
+	mv := rollupTestMetricsView()
+	refreshedOn := timestamppb.New(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	states := []*runtimev1.MetricsViewRollupState{
+		{Name: "daily_country", Table: "rollup_daily_country", RowCount: 1000, RefsRefreshedOn: refreshedOn},
+		{Name: "monthly", Table: "rollup_monthly", RowCount: 10, RefsRefreshedOn: refreshedOn},
+		{Name: "city", Table: "rollup_city", RowCount: 500, RefsRefreshedOn: refreshedOn},
+	}
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	month := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		req   *rollupRequest
+		table string
+	}{
+		{"smallest", &rollupRequest{measures: []string{"revenue"}, timeGrain: runtimev1.TimeGrain_TIME_GRAIN_YEAR}, "rollup_monthly"},
+		{"derived measure", &rollupRequest{measures: []string{"aov"}, timeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH}, "rollup_daily_country"},
+		{"dimension", &rollupRequest{dimensions: []string{"country"}, measures: []string{"revenue"}}, "rollup_city"},
+		{"filter", &rollupRequest{measures: []string{"revenue"}, where: eqExpression("country", "DK"), timeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH}, "rollup_daily_country"},
+		{"aligned range", &rollupRequest{measures: []string{"revenue"}, start: month, end: month.AddDate(0, 1, 0)}, "rollup_monthly"},
+		{"unaligned range", &rollupRequest{measures: []string{"revenue"}, start: day, end: month}, "rollup_daily_country"},
+		{"finer grain", &rollupRequest{measures: []string{"revenue"}, timeGrain: runtimev1.TimeGrain_TIME_GRAIN_HOUR}, ""},
+		{"missing dimension", &rollupRequest{dimensions: []string{"city"}, measures: []string{"revenue"}, timeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY}, ""},
+		{"missing measure", &rollupRequest{measures: []string{"users"}}, ""},
+		{"time zone", &rollupRequest{measures: []string{"revenue"}, timeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY, timeZone: "Asia/Kolkata"}, ""},
+		{"other time dimension", &rollupRequest{measures: []string{"revenue"}, timeDimension: "shipped_at"}, ""},
+		{"unsupported", &rollupRequest{measures: []string{"revenue"}, unsupported: true}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, table := selectRollup(mv, states, refreshedOn, tt.req)
+			require.Equal(t, tt.table, table)
+		})
+	}
+
+	// Rollups materialized before the model was last refreshed are stale
+	states[1].RefsRefreshedOn = timestamppb.New(refreshedOn.AsTime().Add(-time.Hour))
+	_, table := selectRollup(mv, states, refreshedOn, &rollupRequest{measures: []string{"revenue"}, timeGrain: runtimev1.TimeGrain_TIME_GRAIN_YEAR})
+	require.Equal(t, "rollup_daily_country", table)
+}
+
+func TestTimeGrainDivides(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{}
+	require.True(t, timeGrainDivides(runtimev1.TimeGrain_TIME_GRAIN_DAY, runtimev1.TimeGrain_TIME_GRAIN_WEEK, mv))
+	require.True(t, timeGrainDivides(runtimev1.TimeGrain_TIME_GRAIN_MONTH, runtimev1.TimeGrain_TIME_GRAIN_QUARTER, mv))
+	require.False(t, timeGrainDivides(runtimev1.TimeGrain_TIME_GRAIN_MONTH, runtimev1.TimeGrain_TIME_GRAIN_WEEK, mv))
+	require.False(t, timeGrainDivides(runtimev1.TimeGrain_TIME_GRAIN_WEEK, runtimev1.TimeGrain_TIME_GRAIN_MONTH, mv))
+	require.True(t, timeGrainDivides(runtimev1.TimeGrain_TIME_GRAIN_WEEK, runtimev1.TimeGrain_TIME_GRAIN_WEEK, mv))
+
+	mv.FirstDayOfWeek = 7
+	mv.FirstMonthOfYear = 4
+	require.False(t, timeGrainDivides(runtimev1.TimeGrain_TIME_GRAIN_WEEK, runtimev1.TimeGrain_TIME_GRAIN_WEEK, mv))
+	require.True(t, timeGrainDivides(runtimev1.TimeGrain_TIME_GRAIN_DAY, runtimev1.TimeGrain_TIME_GRAIN_WEEK, mv))
+	require.True(t, timeGrainDivides(runtimev1.TimeGrain_TIME_GRAIN_MONTH, runtimev1.TimeGrain_TIME_GRAIN_YEAR, mv))
+	require.False(t, timeGrainDivides(runtimev1.TimeGrain_TIME_GRAIN_QUARTER, runtimev1.TimeGrain_TIME_GRAIN_YEAR, mv))
+}
+
+func Test_buildMetricsAggregationSQL_rollup(t *testing.T) {
+	mv := rollupTestMetricsView()
+	rollupMV := rollupMetricsView(mv, mv.Rollups[0], "rollup_daily_country")
+
+	q := &MetricsViewAggregation{
+		MetricsViewName: "orders",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{Name: "country"},
+			{Name: "ordered_at", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{Name: "orders"},
+			{Name: "aov"},
+		},
+		MetricsView: mv,
+	}
+	sql, args, err := q.buildMetricsAggregationSQL(rollupMV, drivers.DialectDuckDB, nil)
+	require.NoError(t, err)
+	require.Empty(t, args)
+	require.Equal(t, `SELECT ("country") as "country", date_trunc('MONTH', "ordered_at") as "ordered_at", SUM("orders") as "orders", (SUM("revenue")) / (SUM("orders")) as "aov" FROM "rollup_daily_country"   GROUP BY "country", date_trunc('MONTH', "ordered_at")    OFFSET 0`, sql)
+}
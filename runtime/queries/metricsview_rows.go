@@ -273,6 +273,10 @@ func (q *MetricsViewRows) buildMetricsRowsSQL(mv *runtimev1.MetricsViewSpec, dia
 	}
 
 	selectColumns := []string{"*"}
+	if len(requiredJoins(mv, nil, q.Where)) > 0 {
+		// Don't return the columns of joined tables
+		selectColumns = []string{safeName(mv.Table) + ".*"}
+	}
 
 	if timeRollupColumnName != "" {
 		if mv.TimeDimension == "" || q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
@@ -290,9 +294,14 @@ func (q *MetricsViewRows) buildMetricsRowsSQL(mv *runtimev1.MetricsViewSpec, dia
 		selectColumns = append([]string{rollup}, selectColumns...)
 	}
 
+	from, err := metricsViewFrom(mv, policy, nil, q.Where, dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
 	sql := fmt.Sprintf("SELECT %s FROM %s WHERE %s %s %s OFFSET %d",
 		strings.Join(selectColumns, ","),
-		safeName(mv.Table),
+		from,
 		whereClause,
 		orderClause,
 		limitClause,
@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/stretchr/testify/require"
 	"github.com/xuri/excelize/v2"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_writeCSV_emptystring(t *testing.T) {
@@ -454,3 +457,36 @@ func Test_writeXLSX_quotes(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "a\"", v)
 }
+
+func Test_metricsViewTimeDimension(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table:          "orders",
+		TimeDimension:  "ordered_at",
+		TimeDimensions: []string{"shipped_at"},
+	}
+
+	td, err := metricsViewTimeDimension(mv, "")
+	require.NoError(t, err)
+	require.Equal(t, "ordered_at", td)
+
+	td, err = metricsViewTimeDimension(mv, "Shipped_At")
+	require.NoError(t, err)
+	require.Equal(t, "shipped_at", td)
+
+	_, err = metricsViewTimeDimension(mv, "delivered_at")
+	require.ErrorContains(t, err, `time dimension "delivered_at" not found`)
+
+	q := &MetricsViewAggregation{
+		MetricsViewName: "orders_metrics",
+		Dimensions:      []*runtimev1.MetricsViewAggregationDimension{{Name: "shipped_at", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY}},
+		Measures:        []*runtimev1.MetricsViewAggregationMeasure{{Name: "count", BuiltinMeasure: runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT}},
+		TimeRange:       &runtimev1.TimeRange{IsoDuration: "P1D", End: timestamppb.New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))},
+		TimeDimension:   "shipped_at",
+		MetricsView:     mv,
+	}
+	sql, _, err := q.buildMetricsAggregationSQL(mv, drivers.DialectDuckDB, nil)
+	require.NoError(t, err)
+	require.Contains(t, sql, `date_trunc('DAY', "shipped_at") as "shipped_at"`)
+	require.Contains(t, sql, `WHERE 1=1 AND "shipped_at" >= ? AND "shipped_at" < ?`)
+	require.NotContains(t, sql, "ordered_at")
+}
@@ -17,6 +17,7 @@ import (
 
 type MetricsViewTimeRange struct {
 	MetricsViewName    string                               `json:"name"`
+	TimeDimension      string                               `json:"time_dimension,omitempty"`
 	MetricsView        *runtimev1.MetricsViewSpec           `json:"-"`
 	ResolvedMVSecurity *runtime.ResolvedMetricsViewSecurity `json:"security"`
 
@@ -61,7 +62,11 @@ func (q *MetricsViewTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime,
 		policyFilter = q.ResolvedMVSecurity.RowFilter
 	}
 
-	if q.MetricsView.TimeDimension == "" {
+	timeDim, err := metricsViewTimeDimension(q.MetricsView, q.TimeDimension)
+	if err != nil {
+		return err
+	}
+	if timeDim == "" {
 		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
@@ -73,9 +78,9 @@ func (q *MetricsViewTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime,
 
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		return q.resolveDuckDB(ctx, olap, q.MetricsView.TimeDimension, q.MetricsView.Table, policyFilter, priority)
+		return q.resolveDuckDB(ctx, olap, timeDim, q.MetricsView.Table, policyFilter, priority)
 	case drivers.DialectDruid:
-		return q.resolveDruid(ctx, olap, q.MetricsView.TimeDimension, q.MetricsView.Table, policyFilter, priority)
+		return q.resolveDruid(ctx, olap, timeDim, q.MetricsView.Table, policyFilter, priority)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
@@ -32,6 +32,7 @@ type MetricsViewTimeSeries struct {
 	Having             *runtimev1.Expression                `json:"having,omitempty"`
 	TimeGranularity    runtimev1.TimeGrain                  `json:"time_granularity,omitempty"`
 	TimeZone           string                               `json:"time_zone,omitempty"`
+	TimeDimension      string                               `json:"time_dimension,omitempty"`
 	MetricsView        *runtimev1.MetricsViewSpec           `json:"-"`
 	ResolvedMVSecurity *runtime.ResolvedMetricsViewSecurity `json:"security"`
 
@@ -74,7 +75,11 @@ func (q *MetricsViewTimeSeries) UnmarshalResult(v any) error {
 }
 
 func (q *MetricsViewTimeSeries) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	if q.MetricsView.TimeDimension == "" {
+	timeDim, err := metricsViewTimeDimension(q.MetricsView, q.TimeDimension)
+	if err != nil {
+		return err
+	}
+	if timeDim == "" {
 		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
@@ -103,7 +108,18 @@ func (q *MetricsViewTimeSeries) Resolve(ctx context.Context, rt *runtime.Runtime
 	}
 
 	mv := r.GetMetricsView().Spec
-	sql, tsAlias, args, err := q.buildMetricsTimeseriesSQL(olap, mv, q.ResolvedMVSecurity)
+
+	// Read from a rollup if one can answer the query
+	queryMV := mv
+	rollupMV, err := resolveRollup(ctx, rt, instanceID, q.MetricsViewName, mv, q.ResolvedMVSecurity, q.rollupRequest())
+	if err != nil {
+		return err
+	}
+	if rollupMV != nil {
+		queryMV = rollupMV
+	}
+
+	sql, tsAlias, args, err := q.buildMetricsTimeseriesSQL(olap, queryMV, q.ResolvedMVSecurity)
 	if err != nil {
 		return fmt.Errorf("error building query: %w", err)
 	}
@@ -227,6 +243,10 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 	}
 
 	mv := r.GetMetricsView().Spec
+	timeDim, err := metricsViewTimeDimension(mv, q.TimeDimension)
+	if err != nil {
+		return err
+	}
 
 	if opts.PreWriteHook != nil {
 		err = opts.PreWriteHook(q.generateFilename(mv))
@@ -237,10 +257,10 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 
 	tmp := make([]*structpb.Struct, 0, len(q.Result.Data))
 	meta := append([]*runtimev1.MetricsViewColumn{{
-		Name: mv.TimeDimension,
+		Name: timeDim,
 	}}, q.Result.Meta...)
 	for _, dt := range q.Result.Data {
-		dt.Records.Fields[mv.TimeDimension] = structpb.NewStringValue(dt.Ts.AsTime().Format(time.RFC3339Nano))
+		dt.Records.Fields[timeDim] = structpb.NewStringValue(dt.Ts.AsTime().Format(time.RFC3339Nano))
 		tmp = append(tmp, dt.Records)
 	}
 
@@ -266,12 +286,37 @@ func (q *MetricsViewTimeSeries) generateFilename(mv *runtimev1.MetricsViewSpec)
 	return filename
 }
 
+// rollupRequest describes the query for selecting a rollup that can answer it.
+func (q *MetricsViewTimeSeries) rollupRequest() *rollupRequest {
+	req := &rollupRequest{
+		measures:      q.MeasureNames,
+		where:         q.Where,
+		timeDimension: q.TimeDimension,
+		timeGrain:     q.TimeGranularity,
+		timeZone:      q.TimeZone,
+		unsupported:   len(q.InlineMeasures) > 0 || q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED,
+	}
+	if q.TimeStart != nil {
+		req.start = q.TimeStart.AsTime()
+	}
+	if q.TimeEnd != nil {
+		req.end = q.TimeEnd.AsTime()
+	}
+	return req
+}
+
 func (q *MetricsViewTimeSeries) buildMetricsTimeseriesSQL(olap drivers.OLAPStore, mv *runtimev1.MetricsViewSpec, policy *runtime.ResolvedMetricsViewSecurity) (string, string, []any, error) {
 	ms, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames)
 	if err != nil {
 		return "", "", nil, err
 	}
 
+	timeDim, err := metricsViewTimeDimension(mv, q.TimeDimension)
+	if err != nil {
+		return "", "", nil, err
+	}
+	timeCol := safeName(timeDim)
+
 	selectCols := []string{}
 	for _, m := range ms {
 		expr := fmt.Sprintf(`%s as "%s"`, m.Expression, m.Name)
@@ -280,12 +325,36 @@ func (q *MetricsViewTimeSeries) buildMetricsTimeseriesSQL(olap drivers.OLAPStore
 
 	whereClause := "1=1"
 	args := []any{}
-	if q.TimeStart != nil {
-		whereClause += fmt.Sprintf(" AND %s >= ?", safeName(mv.TimeDimension))
+	var outerArgs []any
+	if q.TimeStart != nil && hasWindowMeasures(ms) {
+		// Window measures need the rows before the time range, so the time range's start is applied to the time buckets in the outer query
+		tz := time.UTC
+		if q.TimeZone != "" {
+			tz, err = time.LoadLocation(q.TimeZone)
+			if err != nil {
+				return "", "", nil, fmt.Errorf("invalid timezone '%s': %w", q.TimeZone, err)
+			}
+		}
+		fdow := mv.FirstDayOfWeek
+		if mv.FirstDayOfWeek > 7 || mv.FirstDayOfWeek <= 0 {
+			fdow = 1
+		}
+		fmoy := mv.FirstMonthOfYear
+		if mv.FirstMonthOfYear > 12 || mv.FirstMonthOfYear <= 0 {
+			fmoy = 1
+		}
+
+		start := q.TimeStart.AsTime()
+		bucketStart := timeutil.TruncateTime(start.In(tz), convTimeGrain(q.TimeGranularity), tz, int(fdow), int(fmoy))
+		lookback := windowLookback(ms, bucketStart, q.TimeGranularity, tz)
+		whereClause += windowTimeStartClause(timeCol, start, lookback, bucketStart, &args)
+		outerArgs = append(outerArgs, bucketStart)
+	} else if q.TimeStart != nil {
+		whereClause += fmt.Sprintf(" AND %s >= ?", timeCol)
 		args = append(args, q.TimeStart.AsTime())
 	}
 	if q.TimeEnd != nil {
-		whereClause += fmt.Sprintf(" AND %s < ?", safeName(mv.TimeDimension))
+		whereClause += fmt.Sprintf(" AND %s < ?", timeCol)
 		args = append(args, q.TimeEnd.AsTime())
 	}
 
@@ -314,30 +383,63 @@ func (q *MetricsViewTimeSeries) buildMetricsTimeseriesSQL(olap drivers.OLAPStore
 		timezone = q.TimeZone
 	}
 
+	from, err := metricsViewFrom(mv, policy, nil, q.Where, olap.Dialect())
+	if err != nil {
+		return "", "", nil, err
+	}
+
 	var sql string
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		sql = q.buildDuckDBSQL(mv, tsAlias, selectCols, whereClause, havingClause, timezone)
+		sql = q.buildDuckDBSQL(mv, from, timeCol, tsAlias, selectCols, whereClause, havingClause, timezone)
 	case drivers.DialectDruid:
 		args = append([]any{timezone}, args...)
-		sql = q.buildDruidSQL(args, mv, tsAlias, selectCols, whereClause, havingClause)
+		sql = q.buildDruidSQL(args, mv, from, timeCol, tsAlias, selectCols, whereClause, havingClause)
 	default:
 		return "", "", nil, fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
+	if hasWindowMeasures(ms) {
+		// Window measures are computed in an outer query over the aggregated time buckets
+		wc := &windowContext{
+			timeCol:   tsAlias,
+			timeGrain: q.TimeGranularity,
+			timeZone:  timezone,
+			dialect:   olap.Dialect(),
+		}
+		outerCols := []string{tsAlias}
+		for _, m := range ms {
+			if m.Type != runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW {
+				outerCols = append(outerCols, safeName(m.Name))
+				continue
+			}
+			expr, err := windowMeasureExpression(m, wc)
+			if err != nil {
+				return "", "", nil, err
+			}
+			outerCols = append(outerCols, fmt.Sprintf("%s as %s", expr, safeName(m.Name)))
+		}
+		outerWhereClause := ""
+		if len(outerArgs) > 0 {
+			outerWhereClause = fmt.Sprintf("WHERE %s >= ?", tsAlias)
+			args = append(args, outerArgs...)
+		}
+		sql = fmt.Sprintf("SELECT %s FROM (%s) %s ORDER BY 1", strings.Join(outerCols, ", "), sql, outerWhereClause)
+	}
+
 	return sql, tsAlias, args, nil
 }
 
-func (q *MetricsViewTimeSeries) buildDruidSQL(args []any, mv *runtimev1.MetricsViewSpec, tsAlias string, selectCols []string, whereClause, havingClause string) string {
+func (q *MetricsViewTimeSeries) buildDruidSQL(args []any, mv *runtimev1.MetricsViewSpec, from, timeCol, tsAlias string, selectCols []string, whereClause, havingClause string) string {
 	tsSpecifier := convertToDruidTimeFloorSpecifier(q.TimeGranularity)
 
-	timeClause := fmt.Sprintf("time_floor(%s, '%s', null, CAST(? AS VARCHAR))", safeName(mv.TimeDimension), tsSpecifier)
+	timeClause := fmt.Sprintf("time_floor(%s, '%s', null, CAST(? AS VARCHAR))", timeCol, tsSpecifier)
 	if q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_WEEK && mv.FirstDayOfWeek > 1 {
 		dayOffset := 8 - mv.FirstDayOfWeek
-		timeClause = fmt.Sprintf("time_shift(time_floor(time_shift(%[1]s, 'P1D', %[3]d), '%[2]s', null, CAST(? AS VARCHAR)), 'P1D', -%[3]d)", safeName(mv.TimeDimension), tsSpecifier, dayOffset)
+		timeClause = fmt.Sprintf("time_shift(time_floor(time_shift(%[1]s, 'P1D', %[3]d), '%[2]s', null, CAST(? AS VARCHAR)), 'P1D', -%[3]d)", timeCol, tsSpecifier, dayOffset)
 	} else if q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_YEAR && mv.FirstMonthOfYear > 1 {
 		monthOffset := 13 - mv.FirstMonthOfYear
-		timeClause = fmt.Sprintf("time_shift(time_floor(time_shift(%[1]s, 'P1M', %[3]d), '%[2]s', null, CAST(? AS VARCHAR)), 'P1M', -%[3]d)", safeName(mv.TimeDimension), tsSpecifier, monthOffset)
+		timeClause = fmt.Sprintf("time_shift(time_floor(time_shift(%[1]s, 'P1M', %[3]d), '%[2]s', null, CAST(? AS VARCHAR)), 'P1M', -%[3]d)", timeCol, tsSpecifier, monthOffset)
 	}
 
 	sql := fmt.Sprintf(
@@ -345,7 +447,7 @@ func (q *MetricsViewTimeSeries) buildDruidSQL(args []any, mv *runtimev1.MetricsV
 		timeClause,
 		tsAlias,
 		strings.Join(selectCols, ", "),
-		safeName(mv.Table),
+		from,
 		whereClause,
 		havingClause,
 	)
@@ -353,7 +455,7 @@ func (q *MetricsViewTimeSeries) buildDruidSQL(args []any, mv *runtimev1.MetricsV
 	return sql
 }
 
-func (q *MetricsViewTimeSeries) buildDuckDBSQL(mv *runtimev1.MetricsViewSpec, tsAlias string, selectCols []string, whereClause, havingClause, timezone string) string {
+func (q *MetricsViewTimeSeries) buildDuckDBSQL(mv *runtimev1.MetricsViewSpec, from, timeCol, tsAlias string, selectCols []string, whereClause, havingClause, timezone string) string {
 	dateTruncSpecifier := convertToDateTruncSpecifier(q.TimeGranularity)
 
 	shift := "" // shift to accommodate FirstDayOfWeek or FirstMonthOfYear
@@ -381,10 +483,10 @@ func (q *MetricsViewTimeSeries) buildDuckDBSQL(mv *runtimev1.MetricsViewSpec, ts
 					%[8]s
 					ORDER BY 1`,
 				dateTruncSpecifier,             // 1
-				safeName(mv.TimeDimension),     // 2
+				timeCol,                        // 2
 				tsAlias,                        // 3
 				strings.Join(selectCols, ", "), // 4
-				safeName(mv.Table),             // 5
+				from,                           // 5
 				whereClause,                    // 6
 				timezone,                       // 7
 				havingClause,                   // 8
@@ -401,10 +503,10 @@ func (q *MetricsViewTimeSeries) buildDuckDBSQL(mv *runtimev1.MetricsViewSpec, ts
 					%[8]s
 					ORDER BY 1`,
 				dateTruncSpecifier,             // 1
-				safeName(mv.TimeDimension),     // 2
+				timeCol,                        // 2
 				tsAlias,                        // 3
 				strings.Join(selectCols, ", "), // 4
-				safeName(mv.Table),             // 5
+				from,                           // 5
 				whereClause,                    // 6
 				timezone,                       // 7
 				havingClause,                   // 8
@@ -422,10 +524,10 @@ func (q *MetricsViewTimeSeries) buildDuckDBSQL(mv *runtimev1.MetricsViewSpec, ts
 				%[9]s
 				ORDER BY 1`,
 			dateTruncSpecifier,             // 1
-			safeName(mv.TimeDimension),     // 2
+			timeCol,                        // 2
 			tsAlias,                        // 3
 			strings.Join(selectCols, ", "), // 4
-			safeName(mv.Table),             // 5
+			from,                           // 5
 			whereClause,                    // 6
 			timezone,                       // 7
 			shift,                          // 8
@@ -89,8 +89,18 @@ func (q *MetricsViewToplist) Resolve(ctx context.Context, rt *runtime.Runtime, i
 		q.Where = convertFilterToExpression(q.Filter)
 	}
 
+	// Read from a rollup if one can answer the query
+	mv := q.MetricsView
+	rollupMV, err := resolveRollup(ctx, rt, instanceID, q.MetricsViewName, mv, q.ResolvedMVSecurity, q.rollupRequest())
+	if err != nil {
+		return err
+	}
+	if rollupMV != nil {
+		mv = rollupMV
+	}
+
 	// Build query
-	sql, args, err := q.buildMetricsTopListSQL(q.MetricsView, olap.Dialect(), q.ResolvedMVSecurity)
+	sql, args, err := q.buildMetricsTopListSQL(mv, olap.Dialect(), q.ResolvedMVSecurity)
 	if err != nil {
 		return fmt.Errorf("error building query: %w", err)
 	}
@@ -184,11 +194,32 @@ func (q *MetricsViewToplist) generateFilename(mv *runtimev1.MetricsViewSpec) str
 	return filename
 }
 
+// rollupRequest describes the query for selecting a rollup that can answer it.
+func (q *MetricsViewToplist) rollupRequest() *rollupRequest {
+	req := &rollupRequest{
+		dimensions:  []string{q.DimensionName},
+		measures:    q.MeasureNames,
+		where:       q.Where,
+		unsupported: len(q.InlineMeasures) > 0,
+	}
+	if q.TimeStart != nil {
+		req.start = q.TimeStart.AsTime()
+	}
+	if q.TimeEnd != nil {
+		req.end = q.TimeEnd.AsTime()
+	}
+	return req
+}
+
 func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsViewSpec, dialect drivers.Dialect, policy *runtime.ResolvedMetricsViewSecurity) (string, []any, error) {
 	ms, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames)
 	if err != nil {
 		return "", nil, err
 	}
+	err = requireNoWindowMeasures(ms)
+	if err != nil {
+		return "", nil, err
+	}
 
 	dim, err := metricsViewDimension(mv, q.DimensionName)
 	if err != nil {
@@ -258,9 +289,14 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsViewSpe
 		limitClause = fmt.Sprintf("LIMIT %d", *q.Limit)
 	}
 
+	from, err := metricsViewFrom(mv, policy, []*runtimev1.MetricsViewSpec_DimensionV2{dim}, q.Where, dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
 	sql := fmt.Sprintf("SELECT %s FROM %s %s WHERE %s GROUP BY 1 %s %s %s OFFSET %d",
 		strings.Join(selectCols, ", "),
-		safeName(mv.Table),
+		from,
 		unnestClause,
 		whereClause,
 		havingClause,
@@ -116,6 +116,10 @@ func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsViewSpec,
 	if err != nil {
 		return "", nil, err
 	}
+	err = requireNoWindowMeasures(ms)
+	if err != nil {
+		return "", nil, err
+	}
 
 	selectCols := []string{}
 	for _, m := range ms {
@@ -145,10 +149,15 @@ func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsViewSpec,
 		args = append(args, clauseArgs...)
 	}
 
+	from, err := metricsViewFrom(mv, policy, nil, q.Where, dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
 	sql := fmt.Sprintf(
-		"SELECT %s FROM %q WHERE %s",
+		"SELECT %s FROM %s WHERE %s",
 		strings.Join(selectCols, ", "),
-		mv.Table,
+		from,
 		whereClause,
 	)
 	return sql, args, nil
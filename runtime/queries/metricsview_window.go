@@ -0,0 +1,198 @@
+package queries
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
+)
+
+// windowContext describes the columns of an aggregated subquery that window measures are computed over.
+type windowContext struct {
+	// timeCol is the quoted name of the column containing the truncated time, or empty if the query is not grouped by time.
+	timeCol   string
+	timeGrain runtimev1.TimeGrain
+	timeZone  string
+	// dims are the names of the other dimensions the query is grouped by, and dimCols their quoted column names.
+	dims    []string
+	dimCols []string
+	dialect drivers.Dialect
+}
+
+// hasWindowMeasures returns true if any of the measures is a window measure.
+func hasWindowMeasures(ms []*runtimev1.MetricsViewSpec_MeasureV2) bool {
+	for _, m := range ms {
+		if m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW {
+			return true
+		}
+	}
+	return false
+}
+
+// metricsViewWindowMeasure returns the measure with the given name if it's a window measure, and nil otherwise.
+func metricsViewWindowMeasure(mv *runtimev1.MetricsViewSpec, name string) *runtimev1.MetricsViewSpec_MeasureV2 {
+	for _, m := range mv.Measures {
+		if strings.EqualFold(m.Name, name) && m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW {
+			return m
+		}
+	}
+	return nil
+}
+
+// requireNoWindowMeasures returns an error if any of the measures is a window measure.
+// It's used by queries that do not support window measures.
+func requireNoWindowMeasures(ms []*runtimev1.MetricsViewSpec_MeasureV2) error {
+	for _, m := range ms {
+		if m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW {
+			return fmt.Errorf("window measure '%s' is not supported in this query", m.Name)
+		}
+	}
+	return nil
+}
+
+// windowMeasureExpression returns a SQL expression that computes a window measure over the rows of an aggregated subquery.
+// The subquery must expose the aggregated value of the measure in a column with the measure's name.
+func windowMeasureExpression(m *runtimev1.MetricsViewSpec_MeasureV2, wc *windowContext) (string, error) {
+	w := m.Window
+	if w == nil {
+		return "", fmt.Errorf("window measure '%s' does not have a window", m.Name)
+	}
+	col := safeName(m.Name)
+
+	switch w.Kind {
+	case runtimev1.MetricsViewSpec_WINDOW_KIND_RUNNING_SUM:
+		if wc.timeCol == "" {
+			return "", fmt.Errorf("window measure '%s' requires the query to be grouped by the time dimension", m.Name)
+		}
+		partitions := append(append([]string{}, wc.dimCols...), windowPeriodExpression(wc, w.Period))
+		return fmt.Sprintf(
+			"SUM(%s) OVER (PARTITION BY %s ORDER BY %s ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)",
+			col,
+			strings.Join(partitions, ", "),
+			wc.timeCol,
+		), nil
+	case runtimev1.MetricsViewSpec_WINDOW_KIND_ROLLING:
+		if wc.timeCol == "" || wc.timeGrain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+			return "", fmt.Errorf("window measure '%s' requires the query to be grouped by the time dimension", m.Name)
+		}
+		if w.Size == 0 {
+			return "", fmt.Errorf("window measure '%s' has an invalid size", m.Name)
+		}
+		if wc.dialect == drivers.DialectDruid {
+			// Druid doesn't support RANGE frames with an interval offset. A ROWS frame would sum the previous rows instead of the previous time grains, which is wrong for sparse time series.
+			return "", fmt.Errorf("rolling window measure '%s' is not supported for dialect %q", m.Name, wc.dialect.String())
+		}
+		// Using RANGE instead of ROWS to correctly handle gaps in sparse time series
+		frame := fmt.Sprintf("RANGE BETWEEN INTERVAL '%d %s' PRECEDING AND CURRENT ROW", w.Size-1, convertToDateTruncSpecifier(wc.timeGrain))
+		return fmt.Sprintf("SUM(%s) OVER (%sORDER BY %s %s)", col, partitionByClause(wc.dimCols), wc.timeCol, frame), nil
+	case runtimev1.MetricsViewSpec_WINDOW_KIND_PERCENT_OF_TOTAL:
+		var partitions []string
+		if w.Dimension != "" {
+			found := false
+			for i, d := range wc.dims {
+				if strings.EqualFold(d, w.Dimension) {
+					found = true
+					continue
+				}
+				partitions = append(partitions, wc.dimCols[i])
+			}
+			if !found {
+				return "", fmt.Errorf("window measure '%s' requires the query to be grouped by dimension '%s'", m.Name, w.Dimension)
+			}
+			if wc.timeCol != "" {
+				partitions = append(partitions, wc.timeCol)
+			}
+		}
+		return fmt.Sprintf("CAST(%s AS DOUBLE) / NULLIF(SUM(%s) OVER (%s), 0)", col, col, strings.TrimSpace(partitionByClause(partitions))), nil
+	default:
+		return "", fmt.Errorf("window measure '%s' has an unknown window kind '%s'", m.Name, w.Kind.String())
+	}
+}
+
+// windowPeriodExpression truncates the time column to the given grain in the query's time zone.
+func windowPeriodExpression(wc *windowContext, grain runtimev1.TimeGrain) string {
+	tz := strings.ReplaceAll(wc.timeZone, "'", "''")
+	if wc.dialect == drivers.DialectDruid {
+		if tz == "" {
+			tz = "UTC"
+		}
+		return fmt.Sprintf("TIME_FLOOR(%s, '%s', null, '%s')", wc.timeCol, convertToDruidTimeFloorSpecifier(grain), tz)
+	}
+	if tz == "" || tz == "UTC" {
+		return fmt.Sprintf("date_trunc('%s', %s)", convertToDateTruncSpecifier(grain), wc.timeCol)
+	}
+	return fmt.Sprintf("date_trunc('%s', timezone('%s', %s::TIMESTAMPTZ))", convertToDateTruncSpecifier(grain), tz, wc.timeCol)
+}
+
+// windowLookback returns the start of the rows needed to compute the window measures for the time buckets from bucketStart onwards.
+// A running sum needs the rows since the start of its period, and a rolling window needs the size-1 time grains before the bucket.
+func windowLookback(ms []*runtimev1.MetricsViewSpec_MeasureV2, bucketStart time.Time, grain runtimev1.TimeGrain, tz *time.Location) time.Time {
+	lookback := bucketStart
+	for _, m := range ms {
+		if m.Window == nil {
+			continue
+		}
+		var t time.Time
+		switch m.Window.Kind {
+		case runtimev1.MetricsViewSpec_WINDOW_KIND_RUNNING_SUM:
+			t = timeutil.TruncateTime(bucketStart.In(tz), convTimeGrain(m.Window.Period), tz, 1, 1)
+		case runtimev1.MetricsViewSpec_WINDOW_KIND_ROLLING:
+			t = addTimeGrains(bucketStart, grain, -int(m.Window.Size-1), tz)
+		default:
+			continue
+		}
+		if t.Before(lookback) {
+			lookback = t
+		}
+	}
+	return lookback
+}
+
+// windowTimeStartClause returns a clause that replaces "timeCol >= start" in the inner query of a query with window measures.
+// It includes the rows since lookback, except for the rows in the first time bucket that are before start.
+// The outer query must filter out the time buckets before bucketStart.
+func windowTimeStartClause(timeCol string, start, lookback, bucketStart time.Time, args *[]any) string {
+	clause := fmt.Sprintf(" AND %s >= ?", timeCol)
+	*args = append(*args, lookback)
+	if bucketStart.Before(start) {
+		clause += fmt.Sprintf(" AND (%s >= ? OR %s < ?)", timeCol, timeCol)
+		*args = append(*args, start, bucketStart)
+	}
+	return clause
+}
+
+// addTimeGrains adds n time grains to t in the given time zone.
+func addTimeGrains(t time.Time, grain runtimev1.TimeGrain, n int, tz *time.Location) time.Time {
+	t = t.In(tz)
+	switch grain {
+	case runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND:
+		return t.Add(time.Duration(n) * time.Millisecond)
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND:
+		return t.Add(time.Duration(n) * time.Second)
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		return t.Add(time.Duration(n) * time.Minute)
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		return t.Add(time.Duration(n) * time.Hour)
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		return t.AddDate(0, 0, n)
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		return t.AddDate(0, 0, 7*n)
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return t.AddDate(0, n, 0)
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		return t.AddDate(0, 3*n, 0)
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return t.AddDate(n, 0, 0)
+	}
+	return t
+}
+
+func partitionByClause(cols []string) string {
+	if len(cols) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("PARTITION BY %s ", strings.Join(cols, ", "))
+}
@@ -0,0 +1,214 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_windowMeasureExpression(t *testing.T) {
+	runningSum := &runtimev1.MetricsViewSpec_MeasureV2{
+		Name: "revenue_mtd",
+		Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW,
+		Window: &runtimev1.MetricsViewSpec_MeasureWindow{
+			Kind:   runtimev1.MetricsViewSpec_WINDOW_KIND_RUNNING_SUM,
+			Period: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+		},
+	}
+	rolling := &runtimev1.MetricsViewSpec_MeasureV2{
+		Name: "revenue_7d",
+		Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW,
+		Window: &runtimev1.MetricsViewSpec_MeasureWindow{
+			Kind: runtimev1.MetricsViewSpec_WINDOW_KIND_ROLLING,
+			Size: 7,
+		},
+	}
+	share := &runtimev1.MetricsViewSpec_MeasureV2{
+		Name: "revenue_share",
+		Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW,
+		Window: &runtimev1.MetricsViewSpec_MeasureWindow{
+			Kind:      runtimev1.MetricsViewSpec_WINDOW_KIND_PERCENT_OF_TOTAL,
+			Dimension: "country",
+		},
+	}
+
+	wc := &windowContext{
+		timeCol:   `"ts"`,
+		timeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		dims:      []string{"country", "device"},
+		dimCols:   []string{`"country"`, `"device"`},
+		dialect:   drivers.DialectDuckDB,
+	}
+
+	expr, err := windowMeasureExpression(runningSum, wc)
+	require.NoError(t, err)
+	require.Equal(t, `SUM("revenue_mtd") OVER (PARTITION BY "country", "device", date_trunc('MONTH', "ts") ORDER BY "ts" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`, expr)
+
+	expr, err = windowMeasureExpression(rolling, wc)
+	require.NoError(t, err)
+	require.Equal(t, `SUM("revenue_7d") OVER (PARTITION BY "country", "device" ORDER BY "ts" RANGE BETWEEN INTERVAL '6 DAY' PRECEDING AND CURRENT ROW)`, expr)
+
+	expr, err = windowMeasureExpression(share, wc)
+	require.NoError(t, err)
+	require.Equal(t, `CAST("revenue_share" AS DOUBLE) / NULLIF(SUM("revenue_share") OVER (PARTITION BY "device", "ts"), 0)`, expr)
+
+	// Druid
+	wc.dialect = drivers.DialectDruid
+	wc.timeZone = "Asia/Kolkata"
+	expr, err = windowMeasureExpression(runningSum, wc)
+	require.NoError(t, err)
+	require.Equal(t, `SUM("revenue_mtd") OVER (PARTITION BY "country", "device", TIME_FLOOR("ts", 'P1M', null, 'Asia/Kolkata') ORDER BY "ts" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`, expr)
+
+	// Druid doesn't support time-based window frames, and a row-based frame would be wrong for sparse time series
+	_, err = windowMeasureExpression(rolling, wc)
+	require.ErrorContains(t, err, "rolling window measure 'revenue_7d' is not supported for dialect")
+
+	// Missing time and dimension
+	wc = &windowContext{dialect: drivers.DialectDuckDB}
+	_, err = windowMeasureExpression(runningSum, wc)
+	require.ErrorContains(t, err, "requires the query to be grouped by the time dimension")
+	_, err = windowMeasureExpression(share, wc)
+	require.ErrorContains(t, err, "requires the query to be grouped by dimension 'country'")
+
+	share.Window.Dimension = ""
+	expr, err = windowMeasureExpression(share, wc)
+	require.NoError(t, err)
+	require.Equal(t, `CAST("revenue_share" AS DOUBLE) / NULLIF(SUM("revenue_share") OVER (), 0)`, expr)
+}
+
+func Test_buildMetricsAggregationSQL_window(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table:         "orders",
+		TimeDimension: "ts",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "sum(amount)"},
+			{
+				Name:       "revenue_7d",
+				Expression: "revenue",
+				Type:       runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW,
+				Window: &runtimev1.MetricsViewSpec_MeasureWindow{
+					Kind: runtimev1.MetricsViewSpec_WINDOW_KIND_ROLLING,
+					Size: 7,
+				},
+			},
+		},
+	}
+
+	lmt := int64(10)
+	q := &MetricsViewAggregation{
+		MetricsViewName: "orders_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{Name: "country"},
+			{Name: "ts", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{Name: "revenue"},
+			{Name: "revenue_7d"},
+		},
+		Sort:        []*runtimev1.MetricsViewAggregationSort{{Name: "ts"}},
+		Limit:       &lmt,
+		MetricsView: mv,
+	}
+
+	sql, _, err := q.buildMetricsAggregationSQL(mv, drivers.DialectDuckDB, nil)
+	require.NoError(t, err)
+	require.Contains(t, sql, `FROM (SELECT ("country") as "country", date_trunc('DAY', "ts") as "ts", sum(amount) as "revenue", (sum(amount)) as "revenue_7d" FROM "orders"`)
+	require.Contains(t, sql, `SUM("revenue_7d") OVER (PARTITION BY "country" ORDER BY "ts" RANGE BETWEEN INTERVAL '6 DAY' PRECEDING AND CURRENT ROW) as "revenue_7d"`)
+	require.Contains(t, sql, `ORDER BY "ts" NULLS LAST LIMIT 10 OFFSET 0`)
+
+	// Window measures are not supported in toplists
+	_, err = metricsViewMeasureExpression(mv, "revenue_7d")
+	require.ErrorContains(t, err, "not supported")
+}
+
+func Test_buildMetricsAggregationSQL_window_time_range(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table:         "orders",
+		TimeDimension: "ts",
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Expression: "sum(amount)"},
+			{
+				Name:       "revenue_mtd",
+				Expression: "revenue",
+				Type:       runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW,
+				Window: &runtimev1.MetricsViewSpec_MeasureWindow{
+					Kind:   runtimev1.MetricsViewSpec_WINDOW_KIND_RUNNING_SUM,
+					Period: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+				},
+			},
+			{
+				Name:       "revenue_7d",
+				Expression: "revenue",
+				Type:       runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW,
+				Window: &runtimev1.MetricsViewSpec_MeasureWindow{
+					Kind: runtimev1.MetricsViewSpec_WINDOW_KIND_ROLLING,
+					Size: 7,
+				},
+			},
+		},
+	}
+
+	// The time range starts in the middle of a month and of a day
+	start := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	q := &MetricsViewAggregation{
+		MetricsViewName: "orders_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{Name: "ts", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{Name: "revenue"},
+			{Name: "revenue_mtd"},
+		},
+		TimeRange: &runtimev1.TimeRange{
+			Start: timestamppb.New(start),
+			End:   timestamppb.New(end),
+		},
+		Sort:        []*runtimev1.MetricsViewAggregationSort{{Name: "ts"}},
+		MetricsView: mv,
+	}
+
+	// The inner query includes the rows since the start of the month, but not the rows of the first day before the start
+	sql, args, err := q.buildMetricsAggregationSQL(mv, drivers.DialectDuckDB, nil)
+	require.NoError(t, err)
+	require.Contains(t, sql, `WHERE 1=1 AND "ts" >= ? AND ("ts" >= ? OR "ts" < ?) AND "ts" < ? GROUP BY`)
+	require.Contains(t, sql, `) WHERE "ts" >= ? ORDER BY "ts" NULLS LAST`)
+	day := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, []any{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), start, day, end, day}, args)
+
+	// A rolling window needs the size-1 days before the first day
+	q.Measures[1].Name = "revenue_7d"
+	_, args, err = q.buildMetricsAggregationSQL(mv, drivers.DialectDuckDB, nil)
+	require.NoError(t, err)
+	require.Equal(t, []any{time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC), start, day, end, day}, args)
+}
+
+func Test_windowLookback(t *testing.T) {
+	rolling := &runtimev1.MetricsViewSpec_MeasureV2{
+		Name:   "revenue_4w",
+		Type:   runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW,
+		Window: &runtimev1.MetricsViewSpec_MeasureWindow{Kind: runtimev1.MetricsViewSpec_WINDOW_KIND_ROLLING, Size: 4},
+	}
+	runningSum := &runtimev1.MetricsViewSpec_MeasureV2{
+		Name:   "revenue_ytd",
+		Type:   runtimev1.MetricsViewSpec_MEASURE_TYPE_WINDOW,
+		Window: &runtimev1.MetricsViewSpec_MeasureWindow{Kind: runtimev1.MetricsViewSpec_WINDOW_KIND_RUNNING_SUM, Period: runtimev1.TimeGrain_TIME_GRAIN_YEAR},
+	}
+
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+	bucketStart := time.Date(2024, 3, 4, 0, 0, 0, 0, tz)
+
+	lookback := windowLookback([]*runtimev1.MetricsViewSpec_MeasureV2{rolling}, bucketStart, runtimev1.TimeGrain_TIME_GRAIN_WEEK, tz)
+	require.True(t, time.Date(2024, 2, 12, 0, 0, 0, 0, tz).Equal(lookback))
+
+	lookback = windowLookback([]*runtimev1.MetricsViewSpec_MeasureV2{rolling, runningSum}, bucketStart, runtimev1.TimeGrain_TIME_GRAIN_WEEK, tz)
+	require.True(t, time.Date(2024, 1, 1, 0, 0, 0, 0, tz).Equal(lookback))
+}
@@ -13,19 +13,21 @@ import (
 
 type TableCardinality struct {
 	TableName string
+	Security  *runtime.ResolvedTableSecurity
 	Result    int64
 }
 
 var _ runtime.Query = &TableCardinality{}
 
 func (q *TableCardinality) Key() string {
-	return fmt.Sprintf("TableCardinality:%s", q.TableName)
+	return fmt.Sprintf("TableCardinality:%s:%s", q.TableName, tableSecurityKey(q.Security))
 }
 
 func (q *TableCardinality) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -46,10 +48,6 @@ func (q *TableCardinality) UnmarshalResult(v any) error {
 }
 
 func (q *TableCardinality) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	countSQL := fmt.Sprintf("SELECT count(*) AS count FROM %s",
-		safeName(q.TableName),
-	)
-
 	olap, release, err := rt.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
@@ -60,6 +58,13 @@ func (q *TableCardinality) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
+	countSQL := fmt.Sprintf("SELECT count(*) AS count FROM %s", from)
+
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            countSQL,
 		Priority:         priority,
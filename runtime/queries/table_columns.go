@@ -14,19 +14,21 @@ import (
 
 type TableColumns struct {
 	TableName string
+	Security  *runtime.ResolvedTableSecurity
 	Result    []*runtimev1.ProfileColumn
 }
 
 var _ runtime.Query = &TableColumns{}
 
 func (q *TableColumns) Key() string {
-	return fmt.Sprintf("TableColumns:%s", q.TableName)
+	return fmt.Sprintf("TableColumns:%s:%s", q.TableName, tableSecurityKey(q.Security))
 }
 
 func (q *TableColumns) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -62,11 +64,16 @@ func (q *TableColumns) Resolve(ctx context.Context, rt *runtime.Runtime, instanc
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
+	from, err := tableFrom(q.TableName, q.Security, olap.Dialect())
+	if err != nil {
+		return err
+	}
+
 	return olap.WithConnection(ctx, priority, false, false, func(ctx context.Context, ensuredCtx context.Context, _ *sql.Conn) error {
 		// views return duplicate column names, so we need to create a temporary table
 		temporaryTableName := tempName("profile_columns_")
 		err = olap.Exec(ctx, &drivers.Statement{
-			Query:            fmt.Sprintf(`CREATE TEMPORARY TABLE "%s" AS (SELECT * FROM "%s" LIMIT 1)`, temporaryTableName, q.TableName),
+			Query:            fmt.Sprintf(`CREATE TEMPORARY TABLE "%s" AS (SELECT * FROM %s LIMIT 1)`, temporaryTableName, from),
 			Priority:         priority,
 			ExecutionTimeout: defaultExecutionTimeout,
 		})
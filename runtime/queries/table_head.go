@@ -14,6 +14,7 @@ import (
 type TableHead struct {
 	TableName string
 	Limit     int
+	Security  *runtime.ResolvedTableSecurity
 	Result    []*structpb.Struct
 	Schema    *runtimev1.StructType
 }
@@ -21,13 +22,14 @@ type TableHead struct {
 var _ runtime.Query = &TableHead{}
 
 func (q *TableHead) Key() string {
-	return fmt.Sprintf("TableHead:%s:%d", q.TableName, q.Limit)
+	return fmt.Sprintf("TableHead:%s:%d:%s", q.TableName, q.Limit, tableSecurityKey(q.Security))
 }
 
 func (q *TableHead) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -64,7 +66,10 @@ func (q *TableHead) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
-	query := q.buildTableHeadSQL()
+	query, err := q.buildTableHeadSQL(olap.Dialect())
+	if err != nil {
+		return err
+	}
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            query,
 		Priority:         priority,
@@ -96,7 +101,10 @@ func (q *TableHead) Export(ctx context.Context, rt *runtime.Runtime, instanceID
 	case drivers.DialectDuckDB:
 		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
 			filename := q.TableName
-			sql := q.buildTableHeadSQL()
+			sql, err := q.buildTableHeadSQL(olap.Dialect())
+			if err != nil {
+				return err
+			}
 			args := []interface{}{}
 			if err := duckDBCopyExport(ctx, w, opts, sql, args, filename, olap, opts.Format); err != nil {
 				return err
@@ -146,7 +154,12 @@ func (q *TableHead) generalExport(ctx context.Context, rt *runtime.Runtime, inst
 	return nil
 }
 
-func (q *TableHead) buildTableHeadSQL() string {
+func (q *TableHead) buildTableHeadSQL(dialect drivers.Dialect) (string, error) {
+	from, err := tableFrom(q.TableName, q.Security, dialect)
+	if err != nil {
+		return "", err
+	}
+
 	limitClause := ""
 	if q.Limit > 0 {
 		limitClause = fmt.Sprintf(" LIMIT %d", q.Limit)
@@ -154,8 +167,8 @@ func (q *TableHead) buildTableHeadSQL() string {
 
 	sql := fmt.Sprintf(
 		`SELECT * FROM %s%s`,
-		safeName(q.TableName),
+		from,
 		limitClause,
 	)
-	return sql
+	return sql, nil
 }
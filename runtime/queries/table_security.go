@@ -0,0 +1,64 @@
+package queries
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// tableFrom returns the source to use in the FROM clause of a table or column query.
+// It applies the security policy's row filter and column restrictions to the table.
+// The source is aliased to the table's name, so expressions that reference the table keep working.
+// If the policy doesn't restrict rows or columns, it returns the table's name.
+func tableFrom(table string, policy *runtime.ResolvedTableSecurity, dialect drivers.Dialect) (string, error) {
+	name := safeName(table)
+	if policy == nil {
+		return name, nil
+	}
+	if !policy.Access {
+		return "", errors.New("access to the table is denied by its security policy")
+	}
+	if policy.RowFilter == "" && len(policy.Include) == 0 && len(policy.Exclude) == 0 {
+		return name, nil
+	}
+
+	cols := "*"
+	if len(policy.Include) > 0 {
+		names := make([]string, len(policy.Include))
+		for i, c := range policy.Include {
+			names[i] = safeName(c)
+		}
+		cols = strings.Join(names, ", ")
+	} else if len(policy.Exclude) > 0 {
+		if dialect != drivers.DialectDuckDB {
+			return "", fmt.Errorf("excluding columns is not supported for dialect %q", dialect.String())
+		}
+		names := make([]string, len(policy.Exclude))
+		for i, c := range policy.Exclude {
+			names[i] = safeName(c)
+		}
+		cols = fmt.Sprintf("* EXCLUDE (%s)", strings.Join(names, ", "))
+	}
+
+	from := fmt.Sprintf("(SELECT %s FROM %s", cols, name)
+	if policy.RowFilter != "" {
+		from += " WHERE " + policy.RowFilter
+	}
+	return from + ") AS " + name, nil
+}
+
+// tableSecurityKey returns a string that identifies a resolved table security policy in query cache keys.
+func tableSecurityKey(policy *runtime.ResolvedTableSecurity) string {
+	if policy == nil {
+		return ""
+	}
+	b, err := json.Marshal(policy)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
@@ -0,0 +1,78 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableQueriesSecurity(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithModel(t, "test", `
+		SELECT 'emea' AS region, 'a@example.com' AS email, 1 AS val, TIMESTAMP '2024-01-01' AS ts
+		UNION ALL
+		SELECT 'emea' AS region, 'b@example.com' AS email, 2 AS val, TIMESTAMP '2024-01-02' AS ts
+		UNION ALL
+		SELECT 'apac' AS region, 'c@example.com' AS email, 3 AS val, TIMESTAMP '2024-01-03' AS ts
+	`)
+	ctx := context.Background()
+
+	policy := &runtime.ResolvedTableSecurity{
+		Access:    true,
+		RowFilter: "region = 'emea'",
+		Exclude:   []string{"email"},
+	}
+
+	// Row filter applies to table queries
+	tc := &queries.TableCardinality{TableName: "test", Security: policy}
+	require.NoError(t, tc.Resolve(ctx, rt, instanceID, 0))
+	require.Equal(t, int64(2), tc.Result)
+
+	// Excluded columns are not returned
+	cols := &queries.TableColumns{TableName: "test", Security: policy}
+	require.NoError(t, cols.Resolve(ctx, rt, instanceID, 0))
+	require.Len(t, cols.Result, 3)
+	require.Equal(t, "region", cols.Result[0].Name)
+	require.Equal(t, "val", cols.Result[1].Name)
+	require.Equal(t, "ts", cols.Result[2].Name)
+
+	head := &queries.TableHead{TableName: "test", Limit: 10, Security: policy}
+	require.NoError(t, head.Resolve(ctx, rt, instanceID, 0))
+	require.Len(t, head.Result, 2)
+	require.NotContains(t, head.Result[0].Fields, "email")
+
+	// Row filter applies to column queries
+	topk := &queries.ColumnTopK{TableName: "test", ColumnName: "region", Agg: "count(*)", K: 10, Security: policy}
+	require.NoError(t, topk.Resolve(ctx, rt, instanceID, 0))
+	require.Len(t, topk.Result.Entries, 1)
+	require.Equal(t, "emea", topk.Result.Entries[0].Value.GetStringValue())
+
+	// Row filter applies to the spark line reduction of a timeseries
+	ts := &queries.ColumnTimeseries{TableName: "test", TimestampColumnName: "ts", Pixels: 2, Security: &runtime.ResolvedTableSecurity{Access: true, RowFilter: "val < 3"}}
+	olap, release, err := rt.OLAP(ctx, instanceID)
+	require.NoError(t, err)
+	defer release()
+	spark, err := ts.CreateTimestampRollupReduction(ctx, rt, olap, instanceID, 0, "test", "ts", "val")
+	require.NoError(t, err)
+	require.Len(t, spark, 2)
+	for _, v := range spark {
+		require.Less(t, v.Records.Fields["count"].GetNumberValue(), 3.0)
+	}
+
+	// Excluded columns can't be queried
+	topk = &queries.ColumnTopK{TableName: "test", ColumnName: "email", Agg: "count(*)", K: 10, Security: policy}
+	require.Error(t, topk.Resolve(ctx, rt, instanceID, 0))
+
+	// Only included columns are returned
+	head = &queries.TableHead{TableName: "test", Limit: 10, Security: &runtime.ResolvedTableSecurity{Access: true, Include: []string{"val"}}}
+	require.NoError(t, head.Resolve(ctx, rt, instanceID, 0))
+	require.Len(t, head.Result, 3)
+	require.Len(t, head.Result[0].Fields, 1)
+
+	// Queries fail if access is denied
+	tc = &queries.TableCardinality{TableName: "test", Security: &runtime.ResolvedTableSecurity{Access: false}}
+	require.Error(t, tc.Resolve(ctx, rt, instanceID, 0))
+}
@@ -12,19 +12,21 @@ import (
 type RollupInterval struct {
 	TableName  string
 	ColumnName string
+	Security   *runtime.ResolvedTableSecurity
 	Result     *runtimev1.ColumnRollupIntervalResponse
 }
 
 var _ runtime.Query = &RollupInterval{}
 
 func (q *RollupInterval) Key() string {
-	return fmt.Sprintf("RollupInterval:%s:%s", q.TableName, q.ColumnName)
+	return fmt.Sprintf("RollupInterval:%s:%s:%s", q.TableName, q.ColumnName, tableSecurityKey(q.Security))
 }
 
 func (q *RollupInterval) Deps() []*runtimev1.ResourceName {
 	return []*runtimev1.ResourceName{
 		{Kind: runtime.ResourceKindSource, Name: q.TableName},
 		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+		{Kind: runtime.ResourceKindSeed, Name: q.TableName},
 	}
 }
 
@@ -48,6 +50,7 @@ func (q *RollupInterval) Resolve(ctx context.Context, rt *runtime.Runtime, insta
 	ctr := &ColumnTimeRange{
 		TableName:  q.TableName,
 		ColumnName: q.ColumnName,
+		Security:   q.Security,
 	}
 	err := rt.Query(ctx, instanceID, ctr, priority)
 	if err != nil {
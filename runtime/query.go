@@ -2,27 +2,26 @@ package runtime
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"strings"
-	"time"
 
-	"github.com/dgraph-io/ristretto"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/querycache"
 	"github.com/rilldata/rill/runtime/pkg/singleflight"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
 	meter                        = otel.Meter("github.com/rilldata/rill/runtime")
-	queryCacheHitsCounter        = observability.Must(meter.Int64ObservableCounter("query_cache.hits"))
-	queryCacheMissesCounter      = observability.Must(meter.Int64ObservableCounter("query_cache.misses"))
-	queryCacheItemCountGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.items"))
-	queryCacheSizeBytesGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.size", metric.WithUnit("bytes")))
 	queryCacheEntrySizeHistogram = observability.Must(meter.Int64Histogram("query_cache.entry_size", metric.WithUnit("bytes")))
 )
 
@@ -56,28 +55,79 @@ type Query interface {
 }
 
 func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, priority int) error {
+	// Record the query in the instance's query history (if enabled)
+	rec := r.newQueryRecord(ctx, instanceID, query, priority)
+	if rec == nil {
+		_, err := r.query(ctx, instanceID, query, priority)
+		return err
+	}
+
+	ctx = withQueryRecord(ctx, rec)
+	cacheHit, err := r.query(ctx, instanceID, query, priority)
+	rec.finish(query, cacheHit, err)
+	return err
+}
+
+// QueryAdmitFunc blocks until a query may be resolved. It returns a function that must be called when the query has been resolved.
+type QueryAdmitFunc func(ctx context.Context) (release func(), err error)
+
+type queryAdmitCtxKey struct{}
+
+// WithQueryAdmission returns a context in which Runtime.Query calls admit before resolving a query that is not served from the query cache.
+// Queries resolved while resolving an admitted query (such as nested calls to Runtime.Query) are not subject to admission.
+func WithQueryAdmission(ctx context.Context, admit QueryAdmitFunc) context.Context {
+	return context.WithValue(ctx, queryAdmitCtxKey{}, admit)
+}
+
+// admitQuery calls the QueryAdmitFunc of the context (if any).
+// It returns a context for resolving the admitted query and a function to call when the query has been resolved.
+func admitQuery(ctx context.Context) (context.Context, func(), error) {
+	admit, ok := ctx.Value(queryAdmitCtxKey{}).(QueryAdmitFunc)
+	if !ok {
+		return ctx, func() {}, nil
+	}
+
+	release, err := admit(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return context.WithValue(ctx, queryAdmitCtxKey{}, nil), release, nil
+}
+
+// query resolves a query using the query cache. It returns true if the result was served from the cache.
+func (r *Runtime) query(ctx context.Context, instanceID string, query Query, priority int) (bool, error) {
+	// resolve resolves the query without using the cache
+	resolve := func() (bool, error) {
+		ctx, release, err := admitQuery(ctx)
+		if err != nil {
+			return false, err
+		}
+		defer release()
+		return false, query.Resolve(ctx, r, instanceID, priority)
+	}
+
 	qk := query.Key()
-	// If key is empty, skip caching
-	if qk == "" {
-		return query.Resolve(ctx, r, instanceID, priority)
+	// If key is empty or the query is being explained, skip caching
+	if qk == "" || queryExplainerFromContext(ctx) != nil {
+		return resolve()
 	}
 
 	// Skip caching for specific named drivers.
 	// TODO: Make this configurable with a default provided by the driver.
 	olap, release, err := r.OLAP(ctx, instanceID)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if olap.Dialect() == drivers.DialectDruid {
 		release()
-		return query.Resolve(ctx, r, instanceID, priority)
+		return resolve()
 	}
 	release()
 
 	// Get dependency cache keys
 	ctrl, err := r.Controller(ctx, instanceID)
 	if err != nil {
-		return err
+		return false, err
 	}
 	deps := query.Deps()
 	depKeys := make([]string, 0, len(deps))
@@ -87,14 +137,17 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 			// Deps are approximate, not exact (see docstring for Deps()), so they may not all exist
 			continue
 		}
-		// Using StateUpdatedOn instead of StateVersion because the state version is reset when the resource is deleted and recreated.
-		key := fmt.Sprintf("%s:%s:%d:%d", res.Meta.Name.Kind, res.Meta.Name.Name, res.Meta.StateUpdatedOn.Seconds, res.Meta.StateUpdatedOn.Nanos/int32(time.Millisecond))
+		hash, err := resourceHash(res)
+		if err != nil {
+			return false, err
+		}
+		key := fmt.Sprintf("%s:%s:%s", res.Meta.Name.Kind, res.Meta.Name.Name, hash)
 		depKeys = append(depKeys, key)
 	}
 
 	// If there were no known dependencies, skip caching
 	if len(depKeys) == 0 {
-		return query.Resolve(ctx, r, instanceID, priority)
+		return resolve()
 	}
 
 	// Build cache key
@@ -106,9 +159,9 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	}.String()
 
 	// Try to get from cache
-	if val, ok := r.queryCache.cache.Get(key); ok {
+	if val, ok := r.queryCache.get(ctx, key); ok {
 		observability.AddRequestAttributes(ctx, attribute.Bool("query.cache_hit", true))
-		return query.UnmarshalResult(val)
+		return true, query.UnmarshalResult(val)
 	}
 	observability.AddRequestAttributes(ctx, attribute.Bool("query.cache_hit", false))
 
@@ -116,30 +169,48 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	owner := false
 	val, err := r.queryCache.singleflight.Do(ctx, key, func(ctx context.Context) (any, error) {
 		// Try cache again
-		if val, ok := r.queryCache.cache.Get(key); ok {
+		if val, ok := r.queryCache.get(ctx, key); ok {
 			return val, nil
 		}
 
 		// Load
-		err := query.Resolve(ctx, r, instanceID, priority)
+		ctx, release, err := admitQuery(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		err = query.Resolve(ctx, r, instanceID, priority)
 		if err != nil {
 			return nil, err
 		}
 
 		owner = true
 		res := query.MarshalResult()
-		r.queryCache.cache.Set(key, res.Value, res.Bytes)
+		r.queryCache.set(ctx, key, res.Value, res.Bytes)
 		queryCacheEntrySizeHistogram.Record(ctx, res.Bytes, metric.WithAttributes(attribute.String("query", queryName(query))))
 		return res.Value, nil
 	})
 	if err != nil {
-		return err
+		return false, err
 	}
 
+	// If another caller resolved the query, it was served from the cache from this caller's perspective
 	if !owner {
-		return query.UnmarshalResult(val)
+		return true, query.UnmarshalResult(val)
 	}
-	return nil
+	return false, nil
+}
+
+// resourceHash returns a hash of a resource's spec and state for use in query cache keys.
+// Unlike the resource's StateUpdatedOn or StateVersion, it's the same on every runtime replica that has the same version of the resource,
+// so replicas that share a query cache can share cached results. The state includes details like a model's table and refresh time.
+func resourceHash(res *runtimev1.Resource) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(&runtimev1.Resource{Resource: res.Resource})
+	if err != nil {
+		return "", err
+	}
+	hash := md5.Sum(b)
+	return hex.EncodeToString(hash[:]), nil
 }
 
 type queryCacheKey struct {
@@ -153,46 +224,39 @@ func (k queryCacheKey) String() string {
 }
 
 type queryCache struct {
-	cache        *ristretto.Cache
+	cache        querycache.Cache
 	singleflight *singleflight.Group[string, any]
-	metrics      metric.Registration
+	logger       *zap.Logger
 }
 
-func newQueryCache(sizeInBytes int64) *queryCache {
-	if sizeInBytes <= 100 {
-		panic(fmt.Sprintf("invalid cache size should be greater than 100: %v", sizeInBytes))
+func newQueryCache(cache querycache.Cache, logger *zap.Logger) *queryCache {
+	return &queryCache{
+		cache:        cache,
+		singleflight: &singleflight.Group[string, any]{},
+		logger:       logger,
 	}
-	cache, err := ristretto.NewCache(&ristretto.Config{
-		// Use 5% of cache memory for storing counters. Each counter takes roughly 3 bytes.
-		// Recommended value is 10x the number of items in cache when full.
-		// Tune this again based on metrics.
-		NumCounters: int64(float64(sizeInBytes) * 0.05 / 3),
-		MaxCost:     int64(float64(sizeInBytes) * 0.95),
-		BufferItems: 64,
-		Metrics:     true,
-	})
+}
+
+// get returns a cached query result. Errors from the cache backend are logged and treated as cache misses.
+func (c *queryCache) get(ctx context.Context, key string) (any, bool) {
+	val, ok, err := c.cache.Get(ctx, key)
 	if err != nil {
-		panic(err)
+		c.logger.Warn("failed to get query result from cache", zap.Error(err), observability.ZapCtx(ctx))
+		return nil, false
 	}
+	return val, ok
+}
 
-	metrics := observability.Must(meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
-		observer.ObserveInt64(queryCacheHitsCounter, int64(cache.Metrics.Hits()))
-		observer.ObserveInt64(queryCacheMissesCounter, int64(cache.Metrics.Misses()))
-		observer.ObserveInt64(queryCacheItemCountGauge, int64(cache.Metrics.KeysAdded()-cache.Metrics.KeysEvicted()))
-		observer.ObserveInt64(queryCacheSizeBytesGauge, int64(cache.Metrics.CostAdded()-cache.Metrics.CostEvicted()))
-		return nil
-	}, queryCacheHitsCounter, queryCacheMissesCounter, queryCacheItemCountGauge, queryCacheSizeBytesGauge))
-
-	return &queryCache{
-		cache:        cache,
-		singleflight: &singleflight.Group[string, any]{},
-		metrics:      metrics,
+// set caches a query result. Errors from the cache backend are logged.
+func (c *queryCache) set(ctx context.Context, key string, val any, cost int64) {
+	err := c.cache.Set(ctx, key, val, cost)
+	if err != nil {
+		c.logger.Warn("failed to set query result in cache", zap.Error(err), observability.ZapCtx(ctx))
 	}
 }
 
 func (c *queryCache) close() error {
-	c.cache.Close()
-	return c.metrics.Unregister()
+	return c.cache.Close()
 }
 
 func queryName(q Query) string {
@@ -0,0 +1,136 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// QueryExplainer collects the statements executed by queries resolved with a context returned from WithQueryExplainer, along with their query plans.
+// Queries resolved in such a context bypass the query cache, so all their statements are executed.
+type QueryExplainer struct {
+	analyze    bool
+	mu         sync.Mutex
+	statements []*runtimev1.ExplainedStatement
+	err        error
+}
+
+type queryExplainerCtxKey struct{}
+
+// WithQueryExplainer returns a context that collects the statements executed by calls to Runtime.Query in the returned QueryExplainer.
+// If analyze is true, the statements are explained with EXPLAIN ANALYZE, which executes them again to report timings (ignored for Druid).
+func WithQueryExplainer(ctx context.Context, analyze bool) (context.Context, *QueryExplainer) {
+	e := &QueryExplainer{analyze: analyze}
+	return context.WithValue(ctx, queryExplainerCtxKey{}, e), e
+}
+
+// queryExplainerFromContext returns the query explainer in the context (if any).
+func queryExplainerFromContext(ctx context.Context) *QueryExplainer {
+	e, _ := ctx.Value(queryExplainerCtxKey{}).(*QueryExplainer)
+	return e
+}
+
+// Statements returns the collected statements along with their query plans.
+// It returns an error if explaining any of the statements failed.
+func (e *QueryExplainer) Statements() ([]*runtimev1.ExplainedStatement, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.statements, nil
+}
+
+// explain explains stmt and adds it to the collected statements. It must be called before stmt is executed.
+// It runs in the caller's context, so statements executed in a call to OLAPStore.WithConnection are explained on the same connection
+// (which is required for statements that reference temporary tables).
+// Only read-only statements are explained since explaining a statement with EXPLAIN ANALYZE executes it.
+// For DuckDB, it uses EXPLAIN (or EXPLAIN ANALYZE if requested). For Druid, it uses EXPLAIN PLAN FOR.
+func (e *QueryExplainer) explain(ctx context.Context, olap drivers.OLAPStore, stmt *drivers.Statement) {
+	args := make([]string, len(stmt.Args))
+	for i, arg := range stmt.Args {
+		args[i] = fmt.Sprintf("%v", arg)
+	}
+	res := &runtimev1.ExplainedStatement{
+		Sql:  stmt.Query,
+		Args: args,
+	}
+
+	var err error
+	if isReadOnlyStatement(stmt.Query) {
+		res.Plan, err = explainStatement(ctx, olap, stmt, e.analyze)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		if e.err == nil {
+			e.err = fmt.Errorf("failed to explain statement: %w", err)
+		}
+		return
+	}
+	e.statements = append(e.statements, res)
+}
+
+// explainStatement executes an EXPLAIN statement for stmt and returns the plan column of its result rows.
+func explainStatement(ctx context.Context, olap drivers.OLAPStore, stmt *drivers.Statement, analyze bool) (string, error) {
+	var prefix string
+	var planCol int
+	switch olap.Dialect() {
+	case drivers.DialectDuckDB:
+		// Returns the columns (explain_key, explain_value)
+		prefix = "EXPLAIN "
+		if analyze {
+			prefix = "EXPLAIN ANALYZE "
+		}
+		planCol = 1
+	case drivers.DialectDruid:
+		// Druid doesn't support EXPLAIN ANALYZE, so analyze is ignored
+		// Returns the columns (PLAN, RESOURCES, ATTRIBUTES)
+		prefix = "EXPLAIN PLAN FOR "
+		planCol = 0
+	default:
+		return "", fmt.Errorf("explain is not supported for dialect %q", olap.Dialect().String())
+	}
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    prefix + stmt.Query,
+		Args:     stmt.Args,
+		Priority: stmt.Priority,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var plan []string
+	for rows.Next() {
+		vals, err := rows.SliceScan()
+		if err != nil {
+			return "", err
+		}
+		if planCol < len(vals) {
+			plan = append(plan, fmt.Sprintf("%v", vals[planCol]))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	return strings.Join(plan, "\n"), nil
+}
+
+// isReadOnlyStatement returns true if the SQL statement is a query that doesn't modify any data (such as a SELECT).
+func isReadOnlyStatement(sql string) bool {
+	sql = strings.TrimLeft(sql, " \t\r\n(")
+	for _, kw := range []string{"SELECT", "WITH", "FROM"} {
+		if len(sql) >= len(kw) && strings.EqualFold(sql[:len(kw)], kw) {
+			return true
+		}
+	}
+	return false
+}
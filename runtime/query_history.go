@@ -0,0 +1,159 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxQueryHistoryArgLength is the max length of an arg recorded in the query history. Longer args are truncated.
+const maxQueryHistoryArgLength = 256
+
+// queryRecord collects information about a query for the instance's query history.
+// It's passed through the context so statements executed while resolving the query can be recorded (see recordingOLAP).
+type queryRecord struct {
+	ctrl       *Controller
+	start      time.Time
+	priority   int
+	subject    string
+	mu         sync.Mutex
+	statements []*runtimev1.QueryHistoryStatement
+}
+
+// newQueryRecord starts recording a query. It returns nil if the instance doesn't keep a query history.
+func (r *Runtime) newQueryRecord(ctx context.Context, instanceID string, query Query, priority int) *queryRecord {
+	ctrl, err := r.Controller(ctx, instanceID)
+	if err != nil || ctrl.QueryLog == nil {
+		return nil
+	}
+
+	var subject string
+	if dims := activity.GetDimsFromContext(ctx); dims != nil {
+		for _, dim := range *dims {
+			if dim.Key == "user_id" {
+				subject = dim.Value.AsString()
+			}
+		}
+	}
+
+	return &queryRecord{
+		ctrl:     ctrl,
+		start:    time.Now(),
+		priority: priority,
+		subject:  subject,
+	}
+}
+
+// addStatement records a statement executed while resolving the query.
+func (q *queryRecord) addStatement(stmt *drivers.Statement, d time.Duration) {
+	args := make([]string, len(stmt.Args))
+	for i, arg := range stmt.Args {
+		s := fmt.Sprintf("%v", arg)
+		if len(s) > maxQueryHistoryArgLength {
+			s = s[:maxQueryHistoryArgLength] + "..."
+		}
+		args[i] = s
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.statements = append(q.statements, &runtimev1.QueryHistoryStatement{
+		Sql:        stmt.Query,
+		Args:       args,
+		DurationMs: uint32(d.Milliseconds()),
+	})
+}
+
+// finish adds the query to the instance's query history.
+func (q *queryRecord) finish(query Query, cacheHit bool, err error) {
+	e := &runtimev1.QueryHistoryEntry{
+		StartTime:  timestamppb.New(q.start),
+		QueryType:  queryName(query),
+		DurationMs: uint32(time.Since(q.start).Milliseconds()),
+		CacheHit:   cacheHit,
+		Priority:   int32(q.priority),
+		Subject:    q.subject,
+		Rows:       -1,
+	}
+
+	for _, dep := range query.Deps() {
+		if dep.Kind == ResourceKindMetricsView {
+			e.MetricsView = dep.Name
+			break
+		}
+	}
+
+	if err != nil {
+		e.Error = err.Error()
+	} else if res := query.MarshalResult(); res != nil {
+		e.Rows = queryResultRowCount(res.Value)
+	}
+
+	q.mu.Lock()
+	e.Statements = q.statements
+	q.mu.Unlock()
+
+	q.ctrl.QueryLog.Add(e)
+}
+
+// queryResultRowCount returns the number of rows in a query result. It returns -1 if it's not known.
+// Most query results are protobuf messages with the rows in a repeated field named "data".
+func queryResultRowCount(v any) int64 {
+	msg, ok := v.(proto.Message)
+	if !ok || msg == nil {
+		return -1
+	}
+	m := msg.ProtoReflect()
+	if !m.IsValid() {
+		return -1
+	}
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name("data"))
+	if fd == nil || !fd.IsList() {
+		return -1
+	}
+	return int64(m.Get(fd).List().Len())
+}
+
+type queryRecordCtxKey struct{}
+
+// withQueryRecord returns a context that records statements executed through the OLAP handles returned by Runtime.OLAP.
+func withQueryRecord(ctx context.Context, q *queryRecord) context.Context {
+	return context.WithValue(ctx, queryRecordCtxKey{}, q)
+}
+
+// queryRecordFromContext returns the query record in the context (if any).
+func queryRecordFromContext(ctx context.Context) *queryRecord {
+	q, _ := ctx.Value(queryRecordCtxKey{}).(*queryRecord)
+	return q
+}
+
+// recordingOLAP wraps an OLAP store and records executed statements in the query record and query explainer of the caller's context.
+type recordingOLAP struct {
+	drivers.OLAPStore
+}
+
+// Execute implements drivers.OLAPStore.
+func (o *recordingOLAP) Execute(ctx context.Context, stmt *drivers.Statement) (*drivers.Result, error) {
+	// Explain the statement before executing it, since it may depend on state that's cleaned up after it runs (e.g. temporary tables)
+	if e := queryExplainerFromContext(ctx); e != nil && !stmt.DryRun {
+		e.explain(ctx, o.OLAPStore, stmt)
+	}
+
+	start := time.Now()
+	res, err := o.OLAPStore.Execute(ctx, stmt)
+	if stmt.DryRun {
+		return res, err
+	}
+	if q := queryRecordFromContext(ctx); q != nil {
+		q.addStatement(stmt, time.Since(start))
+	}
+	return res, err
+}
@@ -0,0 +1,18 @@
+package runtime
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestQueryResultRowCount(t *testing.T) {
+	require.Equal(t, int64(2), queryResultRowCount(&runtimev1.MetricsViewAggregationResponse{Data: []*structpb.Struct{{}, {}}}))
+	require.Equal(t, int64(0), queryResultRowCount(&runtimev1.MetricsViewAggregationResponse{}))
+	require.Equal(t, int64(-1), queryResultRowCount(&runtimev1.TableCardinalityResponse{Cardinality: 10}))
+	require.Equal(t, int64(-1), queryResultRowCount((*runtimev1.MetricsViewAggregationResponse)(nil)))
+	require.Equal(t, int64(-1), queryResultRowCount(int64(10)))
+	require.Equal(t, int64(-1), queryResultRowCount(nil))
+}
@@ -0,0 +1,46 @@
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestResourceHash(t *testing.T) {
+	newModel := func(updatedOn, refreshedOn time.Time) *runtimev1.Resource {
+		return &runtimev1.Resource{
+			Meta: &runtimev1.ResourceMeta{
+				Name:           &runtimev1.ResourceName{Kind: ResourceKindModel, Name: "m1"},
+				StateVersion:   1,
+				StateUpdatedOn: timestamppb.New(updatedOn),
+			},
+			Resource: &runtimev1.Resource_Model{
+				Model: &runtimev1.ModelV2{
+					Spec: &runtimev1.ModelSpec{Connector: "duckdb", Sql: "SELECT 1"},
+					State: &runtimev1.ModelState{
+						Connector:   "duckdb",
+						Table:       "m1",
+						RefreshedOn: timestamppb.New(refreshedOn),
+					},
+				},
+			},
+		}
+	}
+
+	refreshedOn := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	h1, err := resourceHash(newModel(time.Now(), refreshedOn))
+	require.NoError(t, err)
+
+	// Replicas update the resource's metadata at different times, but have the same spec and state
+	h2, err := resourceHash(newModel(time.Now().Add(time.Minute), refreshedOn))
+	require.NoError(t, err)
+	require.Equal(t, h1, h2)
+
+	// Refreshing the model changes the hash
+	h3, err := resourceHash(newModel(time.Now(), refreshedOn.Add(time.Hour)))
+	require.NoError(t, err)
+	require.NotEqual(t, h1, h3)
+}
@@ -2,13 +2,20 @@ package reconcilers
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/queries"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func init() {
@@ -64,6 +71,7 @@ func (r *MetricsViewReconciler) Reconcile(ctx context.Context, n *runtimev1.Reso
 
 	// Exit early for deletion
 	if self.Meta.DeletedOn != nil {
+		r.dropRollups(ctx, mv.Spec.Connector, mv.State.Rollups)
 		return runtime.ReconcileResult{}
 	}
 
@@ -82,14 +90,218 @@ func (r *MetricsViewReconciler) Reconcile(ctx context.Context, n *runtimev1.Reso
 		mv.State.ValidSpec = nil
 	}
 
+	// Materialize rollups for the valid spec, or drop them if the spec is invalid
+	var rollupErr error
+	if validateErr == nil {
+		rollupErr = r.reconcileRollups(ctx, self, mv)
+	} else {
+		r.dropRollups(ctx, mv.Spec.Connector, mv.State.Rollups)
+		mv.State.Rollups = nil
+		mv.State.RollupsHash = ""
+	}
+
 	err = r.C.UpdateState(ctx, self.Meta.Name, self)
 	if err != nil {
 		return runtime.ReconcileResult{Err: err}
 	}
 
-	return runtime.ReconcileResult{Err: validateErr}
+	return runtime.ReconcileResult{Err: errors.Join(validateErr, rollupErr)}
+}
+
+// reconcileRollups materializes the rollups of a metrics view and updates its state with the rollup tables.
+// The rollups are only rebuilt when the spec or the state of the metrics view's refs (i.e. the underlying model) has changed.
+func (r *MetricsViewReconciler) reconcileRollups(ctx context.Context, self *runtimev1.Resource, mv *runtimev1.MetricsViewV2) error {
+	spec := mv.State.ValidSpec
+
+	if len(spec.Rollups) == 0 {
+		r.dropRollups(ctx, spec.Connector, mv.State.Rollups)
+		mv.State.Rollups = nil
+		mv.State.RollupsHash = ""
+		return nil
+	}
+
+	refreshedOn := runtime.LatestRefreshedOn(ctx, r.C, self.Meta.Refs)
+	hash, err := r.rollupsHash(ctx, self.Meta.Refs, refreshedOn, spec)
+	if err != nil {
+		return err
+	}
+
+	// Skip if the rollups are up to date
+	if hash == mv.State.RollupsHash && len(mv.State.Rollups) == len(spec.Rollups) {
+		exists := true
+		for _, s := range mv.State.Rollups {
+			if _, ok := olapTableInfo(ctx, r.C, spec.Connector, s.Table); !ok {
+				exists = false
+				break
+			}
+		}
+		if exists {
+			return nil
+		}
+	}
+
+	olap, release, err := r.C.AcquireOLAP(ctx, spec.Connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	var states []*runtimev1.MetricsViewRollupState
+	var errs []error
+	for _, rollup := range spec.Rollups {
+		s, err := r.createRollup(ctx, olap, self.Meta.Name.Name, spec, rollup)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to materialize rollup %q: %w", rollup.Name, err))
+			continue
+		}
+		s.RefsRefreshedOn = refreshedOn
+		states = append(states, s)
+	}
+
+	// Drop rollup tables that are no longer used
+	var stale []*runtimev1.MetricsViewRollupState
+	for _, prev := range mv.State.Rollups {
+		found := false
+		for _, s := range states {
+			if s.Table == prev.Table {
+				found = true
+				break
+			}
+		}
+		if !found {
+			stale = append(stale, prev)
+		}
+	}
+	r.dropRollups(ctx, spec.Connector, stale)
+
+	mv.State.Rollups = states
+	if len(errs) == 0 {
+		mv.State.RollupsHash = hash
+	} else {
+		mv.State.RollupsHash = ""
+	}
+
+	return errors.Join(errs...)
+}
+
+// createRollup materializes a rollup into a staging table and then renames it to the rollup's table.
+func (r *MetricsViewReconciler) createRollup(ctx context.Context, olap drivers.OLAPStore, name string, spec *runtimev1.MetricsViewSpec, rollup *runtimev1.MetricsViewSpec_RollupV2) (*runtimev1.MetricsViewRollupState, error) {
+	if olap.Dialect() != drivers.DialectDuckDB {
+		return nil, fmt.Errorf("rollups are not supported for dialect %q", olap.Dialect())
+	}
+
+	sql, err := queries.BuildMetricsViewRollupSQL(spec, rollup, olap.Dialect())
+	if err != nil {
+		return nil, err
+	}
+
+	tableName := r.rollupTableName(name, rollup.Name)
+	stagingTableName := "__rill_tmp_rollup_" + name + "_" + rollup.Name
+	if t, ok := olapTableInfo(ctx, r.C, spec.Connector, stagingTableName); ok {
+		olapDropTableIfExists(ctx, r.C, spec.Connector, t.Name, t.View)
+	}
+
+	err = olap.CreateTableAsSelect(ctx, stagingTableName, false, sql)
+	if err != nil {
+		// Drop the staging table in a separate context in case ctx was cancelled
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		olapDropTableIfExists(cleanupCtx, r.C, spec.Connector, stagingTableName, false)
+		return nil, err
+	}
+
+	err = olapForceRenameTable(ctx, r.C, spec.Connector, stagingTableName, false, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query: fmt.Sprintf("SELECT COUNT(*) FROM %s", safeSQLName(tableName)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var count int64
+	if res.Next() {
+		if err := res.Scan(&count); err != nil {
+			return nil, err
+		}
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	return &runtimev1.MetricsViewRollupState{
+		Name:     rollup.Name,
+		Table:    tableName,
+		RowCount: count,
+	}, nil
 }
 
+// dropRollups drops the tables of the given rollups.
+func (r *MetricsViewReconciler) dropRollups(ctx context.Context, connector string, rollups []*runtimev1.MetricsViewRollupState) {
+	for _, s := range rollups {
+		olapDropTableIfExists(ctx, r.C, connector, s.Table, false)
+	}
+}
+
+// rollupTableName returns the name of the table that a rollup is materialized into.
+func (r *MetricsViewReconciler) rollupTableName(name, rollup string) string {
+	return "__rill_rollup_" + name + "_" + rollup
+}
+
+// rollupsHash computes a hash of the metrics view spec, the state versions of its refs and the latest refresh time of its sources and models.
+// The refresh time is included since state versions are reset when a resource is deleted and recreated.
+// It changes when the rollups need to be materialized again.
+func (r *MetricsViewReconciler) rollupsHash(ctx context.Context, refs []*runtimev1.ResourceName, refreshedOn *timestamppb.Timestamp, spec *runtimev1.MetricsViewSpec) (string, error) {
+	hash := md5.New()
+
+	for _, ref := range refs { // Refs are always sorted
+		_, err := hash.Write([]byte(ref.Kind))
+		if err != nil {
+			return "", err
+		}
+		_, err = hash.Write([]byte(ref.Name))
+		if err != nil {
+			return "", err
+		}
+
+		// Write state version (doesn't matter how the spec or meta has changed, only if/when state changes)
+		r, err := r.C.Get(ctx, ref, false)
+		var stateVersion int64
+		if err == nil {
+			stateVersion = r.Meta.StateVersion
+		} else {
+			stateVersion = -1
+		}
+		err = binary.Write(hash, binary.BigEndian, stateVersion)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if refreshedOn != nil {
+		err := binary.Write(hash, binary.BigEndian, refreshedOn.AsTime().UnixNano())
+		if err != nil {
+			return "", err
+		}
+	}
+
+	specBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	_, err = hash.Write(specBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// validate validates the metrics view against the underlying table.
 func (r *MetricsViewReconciler) validate(ctx context.Context, mv *runtimev1.MetricsViewSpec) error {
 	olap, release, err := r.C.AcquireOLAP(ctx, mv.Connector)
 	if err != nil {
@@ -107,8 +319,16 @@ func (r *MetricsViewReconciler) validate(ctx context.Context, mv *runtimev1.Metr
 	}
 
 	fields := make(map[string]*runtimev1.StructType_Field, len(t.Schema.Fields))
-	for _, f := range t.Schema.Fields {
+	columns := make([]string, len(t.Schema.Fields))
+	for i, f := range t.Schema.Fields {
 		fields[strings.ToLower(f.Name)] = f
+		columns[i] = f.Name
+	}
+
+	// Check measure expressions don't reference names that are both a measure and a column
+	err = runtime.ValidateMeasureColumnReferences(mv, columns)
+	if err != nil {
+		return err
 	}
 
 	// Check time dimension exists
@@ -122,19 +342,68 @@ func (r *MetricsViewReconciler) validate(ctx context.Context, mv *runtimev1.Metr
 		}
 	}
 
+	// Check additional time dimensions exist
+	for _, td := range mv.TimeDimensions {
+		f, ok := fields[strings.ToLower(td)]
+		if !ok {
+			return fmt.Errorf("time dimension %q is not a column in table %q", td, mv.Table)
+		}
+		if f.Type.Code != runtimev1.Type_CODE_TIMESTAMP && f.Type.Code != runtimev1.Type_CODE_DATE {
+			return fmt.Errorf("time dimension %q is not a TIMESTAMP column", td)
+		}
+	}
+
+	// Check joined tables and join columns exist
+	joinFields := make(map[string]map[string]*runtimev1.StructType_Field, len(mv.Joins))
+	for _, j := range mv.Joins {
+		jt, err := olap.InformationSchema().Lookup(ctx, j.Table)
+		if err != nil {
+			if errors.Is(err, drivers.ErrNotFound) {
+				return fmt.Errorf("joined table %q does not exist", j.Table)
+			}
+			return fmt.Errorf("could not find joined table %q: %w", j.Table, err)
+		}
+		jf := make(map[string]*runtimev1.StructType_Field, len(jt.Schema.Fields))
+		for _, f := range jt.Schema.Fields {
+			jf[strings.ToLower(f.Name)] = f
+		}
+		if _, ok := fields[strings.ToLower(j.On)]; !ok {
+			return fmt.Errorf("join column %q is not a column in table %q", j.On, mv.Table)
+		}
+		if _, ok := jf[strings.ToLower(j.On)]; !ok {
+			return fmt.Errorf("join column %q is not a column in table %q", j.On, j.Table)
+		}
+		joinFields[strings.ToLower(j.Table)] = jf
+	}
+
 	var errs []error
 
 	// Check dimension columns exist
 	for _, d := range mv.Dimensions {
+		if d.Table != "" {
+			jf, ok := joinFields[strings.ToLower(d.Table)]
+			if !ok {
+				errs = append(errs, fmt.Errorf("failed to validate dimension %q: table %q is not joined", d.Name, d.Table))
+			} else if _, ok := jf[strings.ToLower(d.Column)]; !ok {
+				errs = append(errs, fmt.Errorf("failed to validate dimension %q: column %q not found in table %q", d.Name, d.Column, d.Table))
+			}
+			continue
+		}
 		err = validateDimension(ctx, olap, t, d, fields)
 		if err != nil {
 			errs = append(errs, err)
 		}
 	}
 
+	// Check derived measures don't have circular references
+	err = runtime.ValidateMeasureReferences(mv)
+	if err != nil {
+		return err
+	}
+
 	// Check measure expressions are valid
 	for _, d := range mv.Measures {
-		err := validateMeasure(ctx, olap, t, d)
+		err := validateMeasure(ctx, olap, t, mv, d)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("invalid expression for measure %q: %w", d.Name, err))
 		}
@@ -150,7 +419,11 @@ func (r *MetricsViewReconciler) validate(ctx context.Context, mv *runtimev1.Metr
 		}
 	}
 
-	return errors.Join(errs...)
+	err = errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
 func validateDimension(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, d *runtimev1.MetricsViewSpec_DimensionV2, fields map[string]*runtimev1.StructType_Field) error {
@@ -171,9 +444,13 @@ func validateDimension(ctx context.Context, olap drivers.OLAPStore, t *drivers.T
 	return nil
 }
 
-func validateMeasure(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, m *runtimev1.MetricsViewSpec_MeasureV2) error {
-	err := olap.Exec(ctx, &drivers.Statement{
-		Query:  fmt.Sprintf("SELECT %s from %s", m.Expression, safeSQLName(t.Name)),
+func validateMeasure(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, mv *runtimev1.MetricsViewSpec, m *runtimev1.MetricsViewSpec_MeasureV2) error {
+	expr, err := runtime.ResolveMeasureExpression(mv, m.Name)
+	if err != nil {
+		return err
+	}
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query:  fmt.Sprintf("SELECT %s from %s", expr, safeSQLName(t.Name)),
 		DryRun: true,
 	})
 	return err
@@ -412,8 +412,9 @@ func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resou
 	var sql string
 	if spec.UsesTemplating {
 		sql, err = compilerv1.ResolveTemplate(spec.Sql, compilerv1.TemplateData{
-			User:      map[string]interface{}{},
-			Variables: inst.ResolveVariables(),
+			User:        map[string]interface{}{},
+			Variables:   inst.ResolveVariables(),
+			Environment: inst.Environment,
 			Self: compilerv1.TemplateResource{
 				Meta:  self.Meta,
 				Spec:  spec,
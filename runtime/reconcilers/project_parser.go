@@ -144,7 +144,7 @@ func (r *ProjectParserReconciler) Reconcile(ctx context.Context, n *runtimev1.Re
 	}
 
 	// Parse the project
-	parser, err := compilerv1.Parse(ctx, repo, r.C.InstanceID, inst.OLAPConnector, duckdbConnectors)
+	parser, err := compilerv1.Parse(ctx, repo, r.C.InstanceID, inst.Environment, inst.OLAPConnector, duckdbConnectors)
 	if err != nil {
 		return runtime.ReconcileResult{Err: fmt.Errorf("failed to parse: %w", err)}
 	}
@@ -534,6 +534,10 @@ func (r *ProjectParserReconciler) putParserResourceDef(ctx context.Context, inst
 		if existing == nil || !equalThemeSpec(existing.GetTheme().Spec, def.ThemeSpec) {
 			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Theme{Theme: &runtimev1.Theme{Spec: def.ThemeSpec}}}
 		}
+	case compilerv1.ResourceKindSeed:
+		if existing == nil || !equalSeedSpec(existing.GetSeed().Spec, def.SeedSpec) {
+			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Seed{Seed: &runtimev1.Seed{Spec: def.SeedSpec}}}
+		}
 	default:
 		panic(fmt.Errorf("unknown resource kind %q", def.Name.Kind))
 	}
@@ -669,6 +673,8 @@ func resourceNameFromCompiler(name compilerv1.ResourceName) *runtimev1.ResourceN
 		return &runtimev1.ResourceName{Kind: runtime.ResourceKindReport, Name: name.Name}
 	case compilerv1.ResourceKindTheme:
 		return &runtimev1.ResourceName{Kind: runtime.ResourceKindTheme, Name: name.Name}
+	case compilerv1.ResourceKindSeed:
+		return &runtimev1.ResourceName{Kind: runtime.ResourceKindSeed, Name: name.Name}
 	default:
 		panic(fmt.Errorf("unknown resource kind %q", name.Kind))
 	}
@@ -688,6 +694,8 @@ func resourceNameToCompiler(name *runtimev1.ResourceName) compilerv1.ResourceNam
 		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindReport, Name: name.Name}
 	case runtime.ResourceKindTheme:
 		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindTheme, Name: name.Name}
+	case runtime.ResourceKindSeed:
+		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindSeed, Name: name.Name}
 	default:
 		panic(fmt.Errorf("unknown resource kind %q", name.Kind))
 	}
@@ -732,3 +740,7 @@ func equalReportSpec(a, b *runtimev1.ReportSpec) bool {
 func equalThemeSpec(a, b *runtimev1.ThemeSpec) bool {
 	return proto.Equal(a, b)
 }
+
+func equalSeedSpec(a, b *runtimev1.SeedSpec) bool {
+	return proto.Equal(a, b)
+}
@@ -77,9 +77,9 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 	}
 
 	for _, res := range resources {
-		// Check sources and models always; also check reports if OnlyNames is not empty (i.e. explicitly specified).
+		// Check sources, models and seeds always; also check reports if OnlyNames is not empty (i.e. explicitly specified).
 		switch res.Meta.Name.Kind {
-		case runtime.ResourceKindSource, runtime.ResourceKindModel:
+		case runtime.ResourceKindSource, runtime.ResourceKindModel, runtime.ResourceKindSeed:
 			// nothing to do
 		case runtime.ResourceKindReport:
 			if len(trigger.Spec.OnlyNames) == 0 {
@@ -117,6 +117,9 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 		case runtime.ResourceKindModel:
 			model := res.GetModel()
 			model.Spec.Trigger = true
+		case runtime.ResourceKindSeed:
+			seed := res.GetSeed()
+			seed.Spec.Trigger = true
 		case runtime.ResourceKindReport:
 			report := res.GetReport()
 			report.Spec.Trigger = true
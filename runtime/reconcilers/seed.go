@@ -0,0 +1,301 @@
+package reconcilers
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func init() {
+	runtime.RegisterReconcilerInitializer(runtime.ResourceKindSeed, newSeedReconciler)
+}
+
+type SeedReconciler struct {
+	C *runtime.Controller
+}
+
+func newSeedReconciler(c *runtime.Controller) runtime.Reconciler {
+	return &SeedReconciler{C: c}
+}
+
+func (r *SeedReconciler) Close(ctx context.Context) error {
+	return nil
+}
+
+func (r *SeedReconciler) AssignSpec(from, to *runtimev1.Resource) error {
+	a := from.GetSeed()
+	b := to.GetSeed()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign spec from %T to %T", from.Resource, to.Resource)
+	}
+	b.Spec = a.Spec
+	return nil
+}
+
+func (r *SeedReconciler) AssignState(from, to *runtimev1.Resource) error {
+	a := from.GetSeed()
+	b := to.GetSeed()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
+	}
+	b.State = a.State
+	return nil
+}
+
+func (r *SeedReconciler) ResetState(res *runtimev1.Resource) error {
+	res.GetSeed().State = &runtimev1.SeedState{}
+	return nil
+}
+
+func (r *SeedReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceName) runtime.ReconcileResult {
+	self, err := r.C.Get(ctx, n, true)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	seed := self.GetSeed()
+	if seed == nil {
+		return runtime.ReconcileResult{Err: errors.New("not a seed")}
+	}
+
+	// The table name is derived from the resource name.
+	tableName := self.Meta.Name.Name
+
+	// Handle deletion
+	if self.Meta.DeletedOn != nil {
+		olapDropTableIfExists(ctx, r.C, seed.State.Connector, seed.State.Table, false)
+		olapDropTableIfExists(ctx, r.C, seed.State.Connector, r.stagingTableName(tableName), false)
+		return runtime.ReconcileResult{}
+	}
+
+	// Handle renames
+	if self.Meta.RenamedFrom != nil {
+		if t, ok := olapTableInfo(ctx, r.C, seed.State.Connector, seed.State.Table); ok && !t.View {
+			err = olapForceRenameTable(ctx, r.C, seed.State.Connector, seed.State.Table, false, tableName)
+			if err != nil {
+				return runtime.ReconcileResult{Err: fmt.Errorf("failed to rename table: %w", err)}
+			}
+			seed.State.Table = tableName
+			err = r.C.UpdateState(ctx, self.Meta.Name, self)
+			if err != nil {
+				return runtime.ReconcileResult{Err: err}
+			}
+		}
+	}
+
+	// Check if the seed needs to be (re)loaded
+	hash := r.specHash(seed.Spec)
+	_, tableExists := olapTableInfo(ctx, r.C, seed.State.Connector, seed.State.Table)
+	trigger := seed.Spec.Trigger
+	trigger = trigger || seed.State.Table != tableName
+	trigger = trigger || seed.State.SpecHash != hash
+	trigger = trigger || !tableExists
+	if !trigger {
+		return runtime.ReconcileResult{}
+	}
+
+	// If the connector was changed, drop data in the old connector
+	if seed.State.Table != "" && seed.State.Connector != seed.Spec.Connector {
+		olapDropTableIfExists(ctx, r.C, seed.State.Connector, seed.State.Table, false)
+	}
+
+	// Load the data into a staging table, then swap it with the existing table.
+	// This ensures the seed's table is replaced atomically with the code that uses it.
+	r.C.Logger.Info("Loading seed data", slog.String("name", n.Name), slog.String("connector", seed.Spec.Connector))
+	stagingTableName := r.stagingTableName(tableName)
+	loadErr := r.load(ctx, seed.Spec, stagingTableName)
+	if loadErr == nil {
+		loadErr = olapForceRenameTable(ctx, r.C, seed.Spec.Connector, stagingTableName, false, tableName)
+	}
+
+	// Cleanup the staging table if ctx was cancelled or loading failed
+	cleanupCtx := ctx
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		cleanupCtx, cancel = context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancel()
+	}
+
+	// Update state
+	if loadErr == nil {
+		seed.State.Connector = seed.Spec.Connector
+		seed.State.Table = tableName
+		seed.State.SpecHash = hash
+		seed.State.RefreshedOn = timestamppb.Now()
+	} else {
+		olapDropTableIfExists(cleanupCtx, r.C, seed.Spec.Connector, stagingTableName, false)
+		olapDropTableIfExists(cleanupCtx, r.C, seed.State.Connector, seed.State.Table, false)
+		seed.State.Connector = ""
+		seed.State.Table = ""
+		seed.State.SpecHash = ""
+		seed.State.RefreshedOn = nil
+	}
+	err = r.C.UpdateState(ctx, self.Meta.Name, self)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	if ctx.Err() != nil {
+		return runtime.ReconcileResult{Err: errors.Join(loadErr, ctx.Err())}
+	}
+
+	// Reset spec.Trigger
+	if seed.Spec.Trigger {
+		err := r.setTriggerFalse(ctx, n)
+		if err != nil {
+			return runtime.ReconcileResult{Err: err}
+		}
+	}
+
+	if loadErr != nil {
+		return runtime.ReconcileResult{Err: fmt.Errorf("failed to load seed: %w", loadErr)}
+	}
+	return runtime.ReconcileResult{}
+}
+
+// specHash computes a hash of the spec properties that impact the loaded data.
+func (r *SeedReconciler) specHash(spec *runtimev1.SeedSpec) string {
+	hash := md5.New()
+	hash.Write([]byte(spec.Connector))
+	hash.Write([]byte(spec.Path))
+	hash.Write([]byte(spec.Format))
+	hash.Write([]byte(spec.ContentHash))
+
+	cols := make([]string, 0, len(spec.ColumnTypes))
+	for col := range spec.ColumnTypes {
+		cols = append(cols, col)
+	}
+	sort.Strings(cols)
+	for _, col := range cols {
+		hash.Write([]byte(col))
+		hash.Write([]byte(spec.ColumnTypes[col]))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// stagingTableName returns a stable temporary table name for a seed's table.
+func (r *SeedReconciler) stagingTableName(table string) string {
+	return "__rill_tmp_seed_" + table
+}
+
+// setTriggerFalse sets the seed's spec.Trigger to false.
+// Unlike the State, the Spec may be edited concurrently with a Reconcile call, so we need to read and edit it under a lock.
+func (r *SeedReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.ResourceName) error {
+	r.C.Lock(ctx)
+	defer r.C.Unlock(ctx)
+
+	self, err := r.C.Get(ctx, n, false)
+	if err != nil {
+		return err
+	}
+
+	seed := self.GetSeed()
+	if seed == nil {
+		return fmt.Errorf("not a seed")
+	}
+
+	seed.Spec.Trigger = false
+	return r.C.UpdateSpec(ctx, self.Meta.Name, self)
+}
+
+// load reads the seed's data file from the repo and creates a table from it.
+// It reads the file through the repo (instead of from the repo's root directory) so seeds also work for repos that are not stored on local disk.
+func (r *SeedReconciler) load(ctx context.Context, spec *runtimev1.SeedSpec, tableName string) error {
+	olap, release, err := r.C.AcquireOLAP(ctx, spec.Connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if olap.Dialect() != drivers.DialectDuckDB {
+		return fmt.Errorf("seeds are not supported for connector %q", spec.Connector)
+	}
+
+	repo, release, err := r.C.Runtime.Repo(ctx, r.C.InstanceID)
+	if err != nil {
+		return fmt.Errorf("failed to access repo: %w", err)
+	}
+	data, err := repo.Get(ctx, spec.Path)
+	release()
+	if err != nil {
+		return err
+	}
+
+	// Write the data to a temporary file that the OLAP can read from
+	f, err := os.CreateTemp("", "rill_seed_*"+filepath.Ext(spec.Path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(data)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	sql, err := seedSelectSQL(spec, f.Name())
+	if err != nil {
+		return err
+	}
+
+	olapDropTableIfExists(ctx, r.C, spec.Connector, tableName, false)
+	return olap.CreateTableAsSelect(ctx, tableName, false, sql)
+}
+
+// seedSelectSQL returns a DuckDB query that reads the seed's data from path, applying the spec's column type overrides.
+func seedSelectSQL(spec *runtimev1.SeedSpec, path string) (string, error) {
+	cols := make([]string, 0, len(spec.ColumnTypes))
+	for col := range spec.ColumnTypes {
+		cols = append(cols, col)
+	}
+	sort.Strings(cols)
+
+	switch spec.Format {
+	case "csv":
+		// For CSV files, we pass the types to the reader. Casting after inference would lose information, e.g. leading zeros in codes.
+		if len(cols) == 0 {
+			return fmt.Sprintf("SELECT * FROM read_csv_auto(%s, header=true)", safeSQLString(path)), nil
+		}
+		types := make([]string, len(cols))
+		for i, col := range cols {
+			types[i] = fmt.Sprintf("%s: %s", safeSQLString(col), safeSQLString(spec.ColumnTypes[col]))
+		}
+		return fmt.Sprintf("SELECT * FROM read_csv_auto(%s, header=true, types={%s})", safeSQLString(path), strings.Join(types, ", ")), nil
+	case "json", "parquet":
+		from := fmt.Sprintf("read_json_auto(%s, format='auto')", safeSQLString(path))
+		if spec.Format == "parquet" {
+			from = fmt.Sprintf("read_parquet(%s)", safeSQLString(path))
+		}
+		if len(cols) == 0 {
+			return fmt.Sprintf("SELECT * FROM %s", from), nil
+		}
+		casts := make([]string, len(cols))
+		for i, col := range cols {
+			casts[i] = fmt.Sprintf("CAST(%s AS %s) AS %s", safeSQLName(col), spec.ColumnTypes[col], safeSQLName(col))
+		}
+		return fmt.Sprintf("SELECT * REPLACE (%s) FROM %s", strings.Join(casts, ", "), from), nil
+	default:
+		return "", fmt.Errorf("unsupported seed format %q", spec.Format)
+	}
+}
+
+// safeSQLString returns a quoted SQL string literal.
+func safeSQLString(s string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -316,8 +317,24 @@ func (r *SourceReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.Res
 func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Resource, tableName string) (outErr error) {
 	src := self.GetSource().Spec
 
+	inst, err := r.C.Runtime.Instance(ctx, r.C.InstanceID)
+	if err != nil {
+		return err
+	}
+
+	// If sources are stubbed, ingest from the stub file instead of the source's connector
+	srcConnector := src.SourceConnector
+	var stubPath string
+	if inst.SourceStubs != nil && srcConnector != "local_file" {
+		stubPath = inst.SourceStubs[strings.ToLower(self.Meta.Name.Name)]
+		if stubPath == "" {
+			return errors.New("sources are stubbed, but no stub data was provided for this source")
+		}
+		srcConnector = "local_file"
+	}
+
 	// Get connections and transporter
-	srcConn, release1, err := r.C.AcquireConn(ctx, src.SourceConnector)
+	srcConn, release1, err := r.C.AcquireConn(ctx, srcConnector)
 	if err != nil {
 		return err
 	}
@@ -331,14 +348,25 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Res
 	if !ok {
 		t, ok = srcConn.AsTransporter(srcConn, sinkConn)
 		if !ok {
-			return fmt.Errorf("cannot transfer data between connectors %q and %q", src.SourceConnector, src.SinkConnector)
+			return fmt.Errorf("cannot transfer data between connectors %q and %q", srcConnector, src.SinkConnector)
 		}
 	}
 
 	// Get source and sink configs
-	srcConfig, err := r.driversSource(ctx, self, src.Properties)
-	if err != nil {
-		return err
+	var srcConfig map[string]any
+	if stubPath != "" {
+		srcConfig = map[string]any{"path": stubPath}
+	} else {
+		srcConfig, err = r.driversSource(ctx, self, src.Properties)
+		if err != nil {
+			return err
+		}
+		if inst.SourceExtractPolicy != nil && srcConfig["extract"] == nil {
+			switch srcConn.Driver() {
+			case "s3", "gcs", "azure":
+				srcConfig["extract"] = inst.SourceExtractPolicy
+			}
+		}
 	}
 	sinkConfig, err := driversSink(sinkConn, tableName)
 	if err != nil {
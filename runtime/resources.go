@@ -1,5 +1,12 @@
 package runtime
 
+import (
+	"context"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
 // Built-in resource kinds
 const (
 	ResourceKindProjectParser  string = "rill.runtime.v1.ProjectParser"
@@ -12,4 +19,31 @@ const (
 	ResourceKindRefreshTrigger string = "rill.runtime.v1.RefreshTrigger"
 	ResourceKindBucketPlanner  string = "rill.runtime.v1.BucketPlanner"
 	ResourceKindTheme          string = "rill.runtime.v1.Theme"
+	ResourceKindSeed           string = "rill.runtime.v1.Seed"
 )
+
+// LatestRefreshedOn returns the latest time that any of the given sources or models was refreshed.
+// Other resource kinds are ignored. It returns nil if none of the sources or models exist or have been refreshed.
+func LatestRefreshedOn(ctx context.Context, c *Controller, refs []*runtimev1.ResourceName) *timestamppb.Timestamp {
+	var res *timestamppb.Timestamp
+	for _, ref := range refs {
+		if ref.Kind != ResourceKindSource && ref.Kind != ResourceKindModel {
+			continue
+		}
+		r, err := c.Get(ctx, ref, false)
+		if err != nil {
+			continue
+		}
+		var t *timestamppb.Timestamp
+		switch ref.Kind {
+		case ResourceKindSource:
+			t = r.GetSource().GetState().GetRefreshedOn()
+		case ResourceKindModel:
+			t = r.GetModel().GetState().GetRefreshedOn()
+		}
+		if t != nil && (res == nil || t.AsTime().After(res.AsTime())) {
+			res = t
+		}
+	}
+	return res
+}
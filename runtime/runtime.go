@@ -11,6 +11,8 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/conncache"
 	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/querycache"
+	"github.com/rilldata/rill/runtime/pkg/secrets"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
@@ -28,6 +30,15 @@ type Options struct {
 	ControllerLogBufferSizeBytes int64
 	AllowHostAccess              bool
 	SafeSourceRefresh            bool
+	// SecretStores are the stores that "secret://<store>/<path>#<key>" references in connector configs are resolved against
+	SecretStores map[string]secrets.Store
+	// QueryHistoryCapacity is the max number of queries kept in each instance's query history. If 0, the query history is disabled.
+	QueryHistoryCapacity  int
+	QueryHistorySizeBytes int64
+	// QueryHistoryDir is a directory to persist query histories in when an instance's controller is closed. If empty, they are not persisted.
+	QueryHistoryDir string
+	// QueryCache is the backend for the query cache. If nil, an in-memory cache of QueryCacheSizeBytes is used. It is closed when the runtime is closed.
+	QueryCache querycache.Cache
 }
 
 type Runtime struct {
@@ -40,6 +51,7 @@ type Runtime struct {
 	connCache      conncache.Cache
 	queryCache     *queryCache
 	securityEngine *securityEngine
+	secrets        *secrets.Resolver
 }
 
 func New(ctx context.Context, opts *Options, logger *zap.Logger, ac activity.Client, emailClient *email.Client) (*Runtime, error) {
@@ -47,13 +59,19 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, ac activity.Cli
 		emailClient = email.New(email.NewNoopSender())
 	}
 
+	qc := opts.QueryCache
+	if qc == nil {
+		qc = querycache.NewMemory(opts.QueryCacheSizeBytes)
+	}
+
 	rt := &Runtime{
 		Email:          emailClient,
 		opts:           opts,
 		logger:         logger,
 		activity:       ac,
-		queryCache:     newQueryCache(opts.QueryCacheSizeBytes),
+		queryCache:     newQueryCache(qc, logger),
 		securityEngine: newSecurityEngine(opts.SecurityEngineCacheSize, logger),
+		secrets:        secrets.NewResolver(opts.SecretStores),
 	}
 
 	rt.connCache = rt.newConnectionCache()
@@ -97,6 +115,12 @@ func (r *Runtime) ResolveMetricsViewSecurity(attributes map[string]any, instance
 	return r.securityEngine.resolveMetricsViewSecurity(attributes, instanceID, mv, lastUpdatedOn)
 }
 
+// ResolveTableSecurity resolves the security policy of the source or model that produces the given table.
+// It returns nil if policy is nil.
+func (r *Runtime) ResolveTableSecurity(attributes map[string]any, instanceID, table string, policy *runtimev1.TableSecurity, lastUpdatedOn time.Time) (*ResolvedTableSecurity, error) {
+	return r.securityEngine.resolveTableSecurity(attributes, instanceID, table, policy, lastUpdatedOn)
+}
+
 // GetInstanceAttributes fetches an instance and converts its annotations to attributes
 // nil is returned if an error occurred or instance was not found
 func (r *Runtime) GetInstanceAttributes(ctx context.Context, instanceID string) []attribute.KeyValue {
@@ -2,9 +2,10 @@ package runtime
 
 import (
 	"crypto/md5"
-	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -40,42 +41,77 @@ type ResolvedMetricsViewSecurity struct {
 	RowFilter string
 	Include   []string
 	Exclude   []string
+	// Mask maps dimension names to the masking function ("hash", "redact" or "partial") to apply to their values.
+	Mask map[string]string
 }
 
-func computeCacheKey(instanceID string, mv *runtimev1.MetricsViewSpec, lastUpdatedOn time.Time, attributes map[string]any) (string, error) {
+// maskStrictness ranks the masking functions, so the strictest one applies when several mask rules match the same dimension.
+var maskStrictness = map[string]int{
+	"partial": 1,
+	"hash":    2,
+	"redact":  3,
+}
+
+// ResolvedTableSecurity is a source or model's table security policy resolved for a specific user.
+type ResolvedTableSecurity struct {
+	Access    bool
+	RowFilter string
+	Include   []string
+	Exclude   []string
+}
+
+// CanAccessColumn returns true if the policy allows access to the given column.
+// Column names are matched case-insensitively. A nil policy allows access to all columns.
+func (s *ResolvedTableSecurity) CanAccessColumn(name string) bool {
+	if s == nil {
+		return true
+	}
+	if !s.Access {
+		return false
+	}
+	if len(s.Include) > 0 {
+		for _, inc := range s.Include {
+			if strings.EqualFold(inc, name) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, exc := range s.Exclude {
+		if strings.EqualFold(exc, name) {
+			return false
+		}
+	}
+	return true
+}
+
+func computeCacheKey(instanceID, kind, name string, lastUpdatedOn time.Time, attributes map[string]any) (string, error) {
 	hash := md5.New()
 	_, err := hash.Write([]byte(instanceID))
 	if err != nil {
 		return "", err
 	}
-	_, err = hash.Write([]byte(mv.Table))
+	_, err = hash.Write([]byte(kind))
 	if err != nil {
 		return "", err
 	}
-	_, err = hash.Write([]byte(lastUpdatedOn.String()))
+	_, err = hash.Write([]byte(name))
 	if err != nil {
 		return "", err
 	}
-	// go through attributes in a deterministic order (alphabetical by keys)
-	if attributes["admin"] != nil {
-		err = binary.Write(hash, binary.BigEndian, attributes["admin"])
-		if err != nil {
-			return "", err
-		}
+	_, err = hash.Write([]byte(lastUpdatedOn.String()))
+	if err != nil {
+		return "", err
 	}
-	if attributes["email"] != nil {
-		_, err = hash.Write([]byte(attributes["email"].(string)))
-		if err != nil {
-			return "", err
-		}
+	// json.Marshal sorts map keys, so the attributes are hashed in a deterministic order.
+	// All attributes are hashed since security policies can reference custom user attributes.
+	attrs, err := json.Marshal(attributes)
+	if err != nil {
+		return "", err
 	}
-	if attributes["groups"] != nil {
-		for _, g := range attributes["groups"].([]interface{}) {
-			_, err = hash.Write([]byte(g.(string)))
-			if err != nil {
-				return "", err
-			}
-		}
+	_, err = hash.Write(attrs)
+	if err != nil {
+		return "", err
 	}
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
@@ -91,7 +127,7 @@ func (p *securityEngine) resolveMetricsViewSecurity(attributes map[string]any, i
 		return openAccess, nil
 	}
 
-	cacheKey, err := computeCacheKey(instanceID, mv, lastUpdatedOn, attributes)
+	cacheKey, err := computeCacheKey(instanceID, ResourceKindMetricsView, mv.Table, lastUpdatedOn, attributes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to compute cache key: %w", err)
 	}
@@ -168,6 +204,136 @@ func (p *securityEngine) resolveMetricsViewSecurity(attributes map[string]any, i
 		}
 	}
 
+	for _, mask := range mv.Security.Mask {
+		if mask.Condition != "" {
+			cond, err := rillv1.ResolveTemplate(mask.Condition, templateData)
+			if err != nil {
+				return nil, err
+			}
+			maskCond, err := rillv1.EvaluateBoolExpression(cond)
+			if err != nil {
+				return nil, err
+			}
+			if !maskCond {
+				continue
+			}
+		}
+		for _, name := range mask.Names {
+			name = metricsViewDimensionName(mv, name)
+			if maskStrictness[mask.With] > maskStrictness[resolved.Mask[name]] {
+				if resolved.Mask == nil {
+					resolved.Mask = make(map[string]string)
+				}
+				resolved.Mask[name] = mask.With
+			}
+		}
+	}
+
 	p.cache.Add(cacheKey, resolved)
 	return resolved, nil
 }
+
+// resolveTableSecurity resolves the security policy of the source or model that produces a table for the given user attributes.
+// Unlike for metrics views, access is granted if the policy doesn't define an access condition,
+// since table policies are mainly used to restrict the rows and columns available to profiling queries.
+func (p *securityEngine) resolveTableSecurity(attributes map[string]any, instanceID, table string, policy *runtimev1.TableSecurity, lastUpdatedOn time.Time) (*ResolvedTableSecurity, error) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	// if attributes is empty that means auth is disabled and also no user context is available
+	// since we are controlling the attributes we can safely return the open policy
+	if len(attributes) == 0 {
+		return &ResolvedTableSecurity{Access: true}, nil
+	}
+
+	cacheKey, err := computeCacheKey(instanceID, "table", table, lastUpdatedOn, attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute cache key: %w", err)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	cached, ok := p.cache.Get(cacheKey)
+	if ok {
+		return cached.(*ResolvedTableSecurity), nil
+	}
+
+	resolved := &ResolvedTableSecurity{Access: true}
+	templateData := rillv1.TemplateData{User: attributes}
+
+	if policy.Access != "" {
+		access, err := rillv1.ResolveTemplate(policy.Access, templateData)
+		if err != nil {
+			return nil, err
+		}
+		resolved.Access, err = rillv1.EvaluateBoolExpression(access)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if policy.RowFilter != "" {
+		filter, err := rillv1.ResolveTemplate(policy.RowFilter, templateData)
+		if err != nil {
+			return nil, err
+		}
+		resolved.RowFilter = filter
+	}
+
+	resolved.Include, err = resolveColumnConditions(policy.Include, templateData)
+	if err != nil {
+		return nil, err
+	}
+	// If the policy has include conditions, but none of them apply to the user, no columns are accessible
+	if len(policy.Include) > 0 && len(resolved.Include) == 0 {
+		resolved.Access = false
+	}
+
+	resolved.Exclude, err = resolveColumnConditions(policy.Exclude, templateData)
+	if err != nil {
+		return nil, err
+	}
+
+	p.cache.Add(cacheKey, resolved)
+	return resolved, nil
+}
+
+// resolveColumnConditions returns the names of the columns of the conditions that evaluate to true.
+func resolveColumnConditions(conds []*runtimev1.TableSecurity_ColumnCondition, templateData rillv1.TemplateData) ([]string, error) {
+	var res []string
+	seen := map[string]bool{}
+	for _, c := range conds {
+		cond, err := rillv1.ResolveTemplate(c.Condition, templateData)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := rillv1.EvaluateBoolExpression(cond)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		for _, name := range c.Names {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			res = append(res, name)
+		}
+	}
+	return res, nil
+}
+
+// metricsViewDimensionName returns the name of the metrics view dimension that matches name case-insensitively.
+// It returns name unchanged if no dimension matches.
+func metricsViewDimensionName(mv *runtimev1.MetricsViewSpec, name string) string {
+	for _, dim := range mv.Dimensions {
+		if strings.EqualFold(dim.Name, name) {
+			return dim.Name
+		}
+	}
+	return name
+}
@@ -103,6 +103,67 @@ func TestResolveMetricsView(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "test_custom_attributes",
+			args: args{
+				attr: map[string]any{
+					"name":      "test",
+					"email":     "test@rilldata.com",
+					"domain":    "rilldata.com",
+					"groups":    []interface{}{"emea", "sales"},
+					"admin":     false,
+					"region":    "emea",
+					"tenant_id": float64(42),
+				},
+				mv: &runtimev1.MetricsViewSpec{
+					Security: &runtimev1.MetricsViewSpec_SecurityV2{
+						Access:    "{{ has \"emea\" .user.groups }}",
+						RowFilter: "WHERE region = '{{ .user.region }}' AND tenant_id = {{ .user.tenant_id }}",
+						Include:   nil,
+						Exclude:   nil,
+					},
+				},
+			},
+			want: &ResolvedMetricsViewSecurity{
+				Access:    true,
+				RowFilter: "WHERE region = 'emea' AND tenant_id = 42",
+				Include:   nil,
+				Exclude:   nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "test_mask",
+			args: args{
+				attr: map[string]any{
+					"name":   "test",
+					"email":  "test@rilldata.com",
+					"domain": "rilldata.com",
+					"groups": []interface{}{"test"},
+					"admin":  false,
+				},
+				mv: &runtimev1.MetricsViewSpec{
+					Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+						{Name: "email", Column: "email"},
+						{Name: "country", Column: "country"},
+						{Name: "ssn", Column: "ssn"},
+					},
+					Security: &runtimev1.MetricsViewSpec_SecurityV2{
+						Access: "true",
+						Mask: []*runtimev1.MetricsViewSpec_SecurityV2_MaskV2{
+							{Condition: "{{ not .user.admin }}", Names: []string{"email", "country"}, With: "partial"},
+							{Condition: "{{ not .user.admin }}", Names: []string{"Email"}, With: "hash"},
+							{Condition: "{{ .user.admin }}", Names: []string{"ssn"}, With: "redact"},
+						},
+					},
+				},
+			},
+			want: &ResolvedMetricsViewSecurity{
+				Access: true,
+				Mask:   map[string]string{"email": "hash", "country": "partial"},
+			},
+			wantErr: false,
+		},
 		{
 			name: "test_no_groups",
 			args: args{
@@ -481,3 +542,69 @@ func TestResolveMetricsView(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveTableSecurity(t *testing.T) {
+	attr := map[string]any{
+		"name":   "test",
+		"email":  "test@rilldata.com",
+		"domain": "rilldata.com",
+		"groups": []interface{}{"emea"},
+		"admin":  false,
+	}
+
+	p := newSecurityEngine(10, zap.NewNop())
+
+	// Access is granted if the policy doesn't set an access condition
+	got, err := p.resolveTableSecurity(attr, "", "orders", &runtimev1.TableSecurity{
+		RowFilter: "region = '{{ index .user.groups 0 }}'",
+		Exclude: []*runtimev1.TableSecurity_ColumnCondition{
+			{Condition: "{{ not .user.admin }}", Names: []string{"email", "phone"}},
+		},
+	}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ResolvedTableSecurity{
+		Access:    true,
+		RowFilter: "region = 'emea'",
+		Exclude:   []string{"email", "phone"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("resolveTableSecurity() got = %v, want %v", got, want)
+	}
+
+	// Access is denied if none of the include conditions apply
+	got, err = p.resolveTableSecurity(attr, "", "customers", &runtimev1.TableSecurity{
+		Include: []*runtimev1.TableSecurity_ColumnCondition{
+			{Condition: "{{ .user.admin }}", Names: []string{"email"}},
+		},
+	}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Access {
+		t.Errorf("resolveTableSecurity() got access, want no access")
+	}
+}
+
+func TestResolvedTableSecurityCanAccessColumn(t *testing.T) {
+	var open *ResolvedTableSecurity
+	if !open.CanAccessColumn("email") {
+		t.Errorf("nil policy should allow all columns")
+	}
+
+	exclude := &ResolvedTableSecurity{Access: true, Exclude: []string{"email"}}
+	if exclude.CanAccessColumn("EMAIL") || !exclude.CanAccessColumn("region") {
+		t.Errorf("exclude policy should only deny excluded columns")
+	}
+
+	include := &ResolvedTableSecurity{Access: true, Include: []string{"region"}}
+	if include.CanAccessColumn("email") || !include.CanAccessColumn("Region") {
+		t.Errorf("include policy should only allow included columns")
+	}
+
+	denied := &ResolvedTableSecurity{Access: false}
+	if denied.CanAccessColumn("region") {
+		t.Errorf("policy without access should deny all columns")
+	}
+}
@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"strings"
+
 	"github.com/golang-jwt/jwt/v4"
 )
 
@@ -14,6 +16,52 @@ type Claims interface {
 	CanInstance(instanceID string, p Permission) bool
 	// Attributes returns the token attributes used in template rendering.
 	Attributes() map[string]any
+	// Restrictions returns the resource and filter restrictions of the token (nil if it isn't restricted).
+	Restrictions() *Restrictions
+	// TokenID returns the ID of the token if it can be revoked (empty otherwise).
+	TokenID() string
+}
+
+// Restrictions scopes a token to specific resources and enforces filters on their metrics view queries.
+// It is used for embed tokens.
+type Restrictions struct {
+	// Resources lists the resources the token can access. If empty, no resources can be accessed.
+	Resources []ResourceName `json:"res,omitempty"`
+	// MetricsViewFilters maps metrics view names to SQL expressions that are applied to all queries against them.
+	MetricsViewFilters map[string]string `json:"mvf,omitempty"`
+}
+
+// ResourceName identifies a resource in Restrictions.
+type ResourceName struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// CanAccessResource returns true if the restrictions allow access to the given resource.
+// Resource names are matched case-insensitively. It returns true if r is nil.
+func (r *Restrictions) CanAccessResource(kind, name string) bool {
+	if r == nil {
+		return true
+	}
+	for _, n := range r.Resources {
+		if n.Kind == kind && strings.EqualFold(n.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// MetricsViewFilter returns the filter to apply to queries against the given metrics view (empty if there is none).
+func (r *Restrictions) MetricsViewFilter(name string) string {
+	if r == nil {
+		return ""
+	}
+	for n, f := range r.MetricsViewFilters {
+		if strings.EqualFold(n, name) {
+			return f
+		}
+	}
+	return ""
 }
 
 // jwtClaims implements Claims and resolve permissions based on a JWT payload.
@@ -22,6 +70,7 @@ type jwtClaims struct {
 	System    []Permission            `json:"sys,omitempty"`
 	Instances map[string][]Permission `json:"ins,omitempty"`
 	Attrs     map[string]any          `json:"attr,omitempty"`
+	Restr     *Restrictions           `json:"restr,omitempty"`
 }
 
 func (c *jwtClaims) Subject() string {
@@ -50,6 +99,14 @@ func (c *jwtClaims) Attributes() map[string]any {
 	return c.Attrs
 }
 
+func (c *jwtClaims) Restrictions() *Restrictions {
+	return c.Restr
+}
+
+func (c *jwtClaims) TokenID() string {
+	return c.ID
+}
+
 // openClaims implements Claims and allows all actions.
 // It is used for servers with auth disabled.
 type openClaims struct{}
@@ -70,6 +127,14 @@ func (c openClaims) Attributes() map[string]any {
 	return nil
 }
 
+func (c openClaims) Restrictions() *Restrictions {
+	return nil
+}
+
+func (c openClaims) TokenID() string {
+	return ""
+}
+
 // anonClaims implements Claims with no permissions.
 // It is used for unauthorized requests when auth is enabled.
 type anonClaims struct{}
@@ -90,6 +155,14 @@ func (c anonClaims) Attributes() map[string]any {
 	return nil
 }
 
+func (c anonClaims) Restrictions() *Restrictions {
+	return nil
+}
+
+func (c anonClaims) TokenID() string {
+	return ""
+}
+
 // devJWTClaims implements Claims and allows all actions but have user attributes for access policies.
 // It is used for mimicking user attributes on local when auth is disabled.
 type devJWTClaims struct {
@@ -112,3 +185,11 @@ func (c devJWTClaims) CanInstance(instanceID string, p Permission) bool {
 func (c devJWTClaims) Attributes() map[string]any {
 	return c.Attrs
 }
+
+func (c devJWTClaims) Restrictions() *Restrictions {
+	return nil
+}
+
+func (c devJWTClaims) TokenID() string {
+	return ""
+}
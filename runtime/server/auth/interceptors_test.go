@@ -11,7 +11,7 @@ import (
 )
 
 func TestMiddleware(t *testing.T) {
-	iss, aud, close := newTestIssuerAndAudience(t)
+	iss, aud, _, close := newTestIssuerAndAudience(t)
 	defer close()
 
 	t.Run("Anon", func(t *testing.T) {
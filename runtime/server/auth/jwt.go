@@ -107,11 +107,13 @@ func NewEphemeralIssuer(issuerURL string) (*Issuer, error) {
 // TokenOptions provides options for Issuer.NewToken.
 type TokenOptions struct {
 	AudienceURL         string
+	ID                  string
 	Subject             string
 	TTL                 time.Duration
 	SystemPermissions   []Permission
 	InstancePermissions map[string][]Permission
 	Attributes          map[string]any
+	Restrictions        *Restrictions
 }
 
 // NewToken issues a new JWT based on the provided options.
@@ -125,10 +127,12 @@ func (i *Issuer) NewToken(opts TokenOptions) (string, error) {
 			Issuer:    i.issuerURL,
 			Subject:   opts.Subject,
 			Audience:  []string{opts.AudienceURL},
+			ID:        opts.ID,
 		},
 		System:    opts.SystemPermissions,
 		Instances: opts.InstancePermissions,
 		Attrs:     opts.Attributes,
+		Restr:     opts.Restrictions,
 	}
 
 	token := jwt.NewWithClaims(jwt.GetSigningMethod(i.signingKey.Algorithm), claims)
@@ -153,11 +157,13 @@ func (i *Issuer) WellKnownHandler() http.Handler {
 // Audience represents a receiver of tokens from Issuer.
 // The Audience is used by the runtime to parse claims from a JWT.
 // It parses and validates tokens and resolves permissions.
-// It refreshes its JWKS in the background from {issuerURL}/.well-known/jwks.json.
+// It refreshes its JWKS in the background from {issuerURL}/.well-known/jwks.json,
+// and its list of revoked tokens from {issuerURL}/.well-known/revoked-tokens.json.
 type Audience struct {
 	issuerURL   string
 	audienceURL string
 	jwks        *keyfunc.JWKS
+	revoked     *revocationList
 }
 
 // OpenAudience creates an Audience. Remember to call Close() when done.
@@ -206,16 +212,25 @@ func OpenAudience(ctx context.Context, logger *zap.Logger, issuerURL, audienceUR
 		return nil, err
 	}
 
+	// The revoked tokens are assumed to be served on issuer
+	revokedURL, err := url.JoinPath(issuerURL, ".well-known/revoked-tokens.json")
+	if err != nil {
+		jwks.EndBackground()
+		return nil, err
+	}
+
 	return &Audience{
 		issuerURL:   issuerURL,
 		audienceURL: audienceURL,
 		jwks:        jwks,
+		revoked:     newRevocationList(ctx, logger, revokedURL),
 	}, nil
 }
 
-// Close stops background refresh of the JWKS.
+// Close stops background refresh of the JWKS and revoked tokens.
 func (a *Audience) Close() {
 	a.jwks.EndBackground()
+	a.revoked.close()
 }
 
 // ParseAndValidate parses and validates a JWT and returns Claims if successful.
@@ -235,9 +250,19 @@ func (a *Audience) ParseAndValidate(tokenStr string) (Claims, error) {
 		return nil, fmt.Errorf("invalid token audience %q (expected %q)", claims.Audience, a.audienceURL)
 	}
 
+	if a.IsRevoked(claims.ID) {
+		return nil, fmt.Errorf("token has been revoked")
+	}
+
 	return claims, nil
 }
 
+// IsRevoked returns true if the token with the given ID has been revoked.
+// It also returns true if the list of revoked tokens could not be refreshed recently. Tokens without an ID can't be revoked.
+func (a *Audience) IsRevoked(tokenID string) bool {
+	return tokenID != "" && a.revoked.contains(tokenID)
+}
+
 func NewDevToken(attr map[string]any) (string, error) {
 	claims := &devJWTClaims{
 		Attrs: attr,
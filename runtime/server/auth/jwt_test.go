@@ -4,6 +4,8 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,7 +14,7 @@ import (
 )
 
 func TestTokens(t *testing.T) {
-	iss, aud, close := newTestIssuerAndAudience(t)
+	iss, aud, revoke, close := newTestIssuerAndAudience(t)
 	defer close()
 
 	t.Run("Simple", func(t *testing.T) {
@@ -59,15 +61,105 @@ func TestTokens(t *testing.T) {
 		_, err = aud.ParseAndValidate(token)
 		require.Error(t, err)
 	})
+
+	t.Run("Restrictions", func(t *testing.T) {
+		token, err := iss.NewToken(TokenOptions{
+			AudienceURL:         aud.audienceURL,
+			TTL:                 time.Duration(time.Hour),
+			InstancePermissions: map[string][]Permission{"example": {ReadMetrics}},
+			Restrictions: &Restrictions{
+				Resources:          []ResourceName{{Kind: "rill.runtime.v1.MetricsView", Name: "ad_bids"}},
+				MetricsViewFilters: map[string]string{"ad_bids": "publisher = 'Google'"},
+			},
+		})
+		require.NoError(t, err)
+
+		claims, err := aud.ParseAndValidate(token)
+		require.NoError(t, err)
+		r := claims.Restrictions()
+		require.True(t, r.CanAccessResource("rill.runtime.v1.MetricsView", "AD_BIDS"))
+		require.False(t, r.CanAccessResource("rill.runtime.v1.MetricsView", "other"))
+		require.False(t, r.CanAccessResource("rill.runtime.v1.Model", "ad_bids"))
+		require.Equal(t, "publisher = 'Google'", r.MetricsViewFilter("Ad_Bids"))
+		require.Equal(t, "", r.MetricsViewFilter("other"))
+	})
+
+	t.Run("Revoked", func(t *testing.T) {
+		token, err := iss.NewToken(TokenOptions{
+			AudienceURL: aud.audienceURL,
+			ID:          "embed-1",
+			TTL:         time.Duration(time.Hour),
+		})
+		require.NoError(t, err)
+
+		_, err = aud.ParseAndValidate(token)
+		require.NoError(t, err)
+
+		revoke(RevokedToken{ID: "embed-1", ExpiresOn: time.Now().Add(time.Hour)})
+		require.NoError(t, aud.revoked.refresh(context.Background()))
+
+		_, err = aud.ParseAndValidate(token)
+		require.ErrorContains(t, err, "revoked")
+	})
+
+	t.Run("RevokedStale", func(t *testing.T) {
+		token, err := iss.NewToken(TokenOptions{
+			AudienceURL: aud.audienceURL,
+			ID:          "embed-2",
+			TTL:         time.Duration(time.Hour),
+		})
+		require.NoError(t, err)
+
+		_, err = aud.ParseAndValidate(token)
+		require.NoError(t, err)
+
+		// Revocable tokens are rejected if the list of revoked tokens is too old
+		aud.revoked.mu.Lock()
+		aud.revoked.refreshedOn = time.Now().Add(-2 * revocationMaxStaleness)
+		aud.revoked.mu.Unlock()
+		_, err = aud.ParseAndValidate(token)
+		require.ErrorContains(t, err, "revoked")
+
+		// Tokens without an ID are not affected
+		token, err = iss.NewToken(TokenOptions{
+			AudienceURL: aud.audienceURL,
+			TTL:         time.Duration(time.Hour),
+		})
+		require.NoError(t, err)
+		_, err = aud.ParseAndValidate(token)
+		require.NoError(t, err)
+
+		require.NoError(t, aud.revoked.refresh(context.Background()))
+		require.False(t, aud.IsRevoked("embed-2"))
+	})
 }
 
-func newTestIssuerAndAudience(t *testing.T) (*Issuer, *Audience, func()) {
+func TestNilRestrictions(t *testing.T) {
+	var r *Restrictions
+	require.True(t, r.CanAccessResource("rill.runtime.v1.MetricsView", "ad_bids"))
+	require.Equal(t, "", r.MetricsViewFilter("ad_bids"))
+}
+
+func newTestIssuerAndAudience(t *testing.T) (*Issuer, *Audience, func(RevokedToken), func()) {
 	// Create Issuer and serve on a test server
 	iss, err := NewEphemeralIssuer("")
 	require.NoError(t, err)
 
+	var mu sync.Mutex
+	var revoked []RevokedToken
+	revoke := func(t RevokedToken) {
+		mu.Lock()
+		defer mu.Unlock()
+		revoked = append(revoked, t)
+	}
+
 	mux := http.NewServeMux()
 	mux.Handle("/.well-known/jwks.json", iss.WellKnownHandler())
+	mux.Handle("/.well-known/revoked-tokens.json", RevokedTokensHandler(func(ctx context.Context) ([]RevokedToken, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(revoked), nil
+	}))
 
 	srv := httptest.NewServer(mux)
 	iss.issuerURL = srv.URL
@@ -77,5 +169,5 @@ func newTestIssuerAndAudience(t *testing.T) (*Issuer, *Audience, func()) {
 	aud, err := OpenAudience(context.Background(), zap.NewNop(), srv.URL, audienceURL)
 	require.NoError(t, err)
 
-	return iss, aud, func() { srv.Close(); aud.Close() }
+	return iss, aud, revoke, func() { srv.Close(); aud.Close() }
 }
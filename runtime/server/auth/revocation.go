@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// revocationRefreshInterval is the interval at which an Audience refreshes its list of revoked tokens.
+const revocationRefreshInterval = time.Minute
+
+// revocationMaxStaleness is how long a list of revoked tokens can be used after it was last refreshed successfully.
+// After that, all revocable tokens are considered revoked until a refresh succeeds.
+const revocationMaxStaleness = 5 * revocationRefreshInterval
+
+// RevokedToken is an entry in the list of revoked tokens served by the issuer.
+type RevokedToken struct {
+	ID        string    `json:"id"`
+	ExpiresOn time.Time `json:"expires_on"`
+}
+
+// RevokedTokensHandler serves the list of revoked tokens returned by fn.
+// The Audience expects it to be mounted on {issuerURL}/.well-known/revoked-tokens.json.
+// Tokens only need to be listed until they expire.
+func RevokedTokensHandler(fn func(ctx context.Context) ([]RevokedToken, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens, err := fn(r.Context())
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to list revoked tokens: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+		if tokens == nil {
+			tokens = []RevokedToken{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(tokens)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to encode revoked tokens: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+	})
+}
+
+// revocationList tracks the IDs of revoked tokens. It refreshes them in the background from the issuer.
+// If a refresh fails, it keeps the previous list until it's older than revocationMaxStaleness.
+// It fails closed: if the list has never been loaded or is too old, all tokens are considered revoked.
+type revocationList struct {
+	url         string
+	logger      *zap.Logger
+	client      *http.Client
+	cancel      context.CancelFunc
+	mu          sync.RWMutex
+	tokens      map[string]time.Time
+	refreshedOn time.Time
+}
+
+func newRevocationList(ctx context.Context, logger *zap.Logger, url string) *revocationList {
+	ctx, cancel := context.WithCancel(ctx)
+	l := &revocationList{
+		url:    url,
+		logger: logger,
+		client: &http.Client{Timeout: 10 * time.Second},
+		cancel: cancel,
+		tokens: make(map[string]time.Time),
+	}
+
+	// Not fatal, since only revocable tokens (with an ID) are rejected until a refresh succeeds
+	err := l.refresh(ctx)
+	if err != nil {
+		logger.Warn("revoked tokens fetch failed", zap.Error(err))
+	}
+
+	go l.refreshInBackground(ctx)
+
+	return l
+}
+
+func (l *revocationList) close() {
+	l.cancel()
+}
+
+// contains returns true if the token with the given ID has been revoked, or if the list is not fresh enough to tell.
+func (l *revocationList) contains(id string) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if time.Since(l.refreshedOn) > revocationMaxStaleness {
+		return true
+	}
+	expiresOn, ok := l.tokens[id]
+	return ok && time.Now().Before(expiresOn)
+}
+
+func (l *revocationList) refreshInBackground(ctx context.Context) {
+	ticker := time.NewTicker(revocationRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := l.refresh(ctx)
+			if err != nil && ctx.Err() == nil {
+				l.logger.Error("revoked tokens refresh failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (l *revocationList) refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, l.url, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	res, err := l.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	var revoked []RevokedToken
+	err = json.NewDecoder(res.Body).Decode(&revoked)
+	if err != nil {
+		return err
+	}
+
+	tokens := make(map[string]time.Time, len(revoked))
+	for _, t := range revoked {
+		tokens[t.ID] = t.ExpiresOn
+	}
+
+	l.mu.Lock()
+	l.tokens = tokens
+	l.refreshedOn = time.Now()
+	l.mu.Unlock()
+
+	return nil
+}
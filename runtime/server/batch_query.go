@@ -2,31 +2,253 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sync"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// defaultQueryBatchConcurrency is the number of batched queries to execute concurrently for OLAP stores that don't limit concurrency.
+const defaultQueryBatchConcurrency = 10
+
+// QueryBatch implements QueryService.
+// Identical queries in the batch are only executed once. The unique queries are resolved concurrently, so queries that can be served
+// from the query cache are answered immediately. The remaining queries are executed in order of priority with concurrency bounded by the
+// OLAP store's concurrency (see queryBatchScheduler).
+// Results are streamed in the order they complete.
 func (s *Server) QueryBatch(req *runtimev1.QueryBatchRequest, srv runtimev1.QueryService_QueryBatchServer) error {
-	// TODO: Performance improvements:
-	//       1. Check for access upfront based on what is in the request
-	//       2. Check for cache and return those queries immediately before creating a goroutine
-	//       3. Use a goroutine pool with size equal to driver's concurrency to execute the queries
+	batches, err := groupQueryBatch(req.Queries)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	g, ctx := errgroup.WithContext(srv.Context())
+	// Stream sends are not safe for concurrent use
+	var mu sync.Mutex
+	send := func(b *queryBatchItem, resp *runtimev1.QueryBatchResponse) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, idx := range b.indexes {
+			err := srv.Send(&runtimev1.QueryBatchResponse{Index: uint32(idx), Result: resp.Result, Error: resp.Error})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 
-	for idx, qry := range req.Queries {
-		idx := idx
-		qry := qry
+	g, ctx := errgroup.WithContext(srv.Context())
+	sched := newQueryBatchScheduler(batches, s.queryBatchConcurrency(ctx, req.InstanceId))
+	for _, b := range batches {
+		b := b
 		g.Go(func() error {
-			resp := s.forwardQuery(ctx, req.InstanceId, idx, qry)
-			return srv.Send(resp)
+			qctx := runtime.WithQueryAdmission(ctx, func(ctx context.Context) (func(), error) {
+				return sched.admit(ctx, b)
+			})
+			resp := s.forwardQuery(qctx, req.InstanceId, b.indexes[0], b.query)
+			sched.finish(b)
+			return send(b, resp)
 		})
 	}
 
 	return g.Wait()
 }
 
+// queryBatchItem is a unique query in a QueryBatchRequest.
+type queryBatchItem struct {
+	query    *runtimev1.Query
+	indexes  []int
+	priority int
+	settled  bool // Set by queryBatchScheduler when the query has requested admission or finished
+}
+
+// groupQueryBatch groups identical queries, preserving the order of their first occurrence.
+func groupQueryBatch(queries []*runtimev1.Query) ([]*queryBatchItem, error) {
+	var batches []*queryBatchItem
+	batchesByKey := make(map[string]*queryBatchItem)
+	for idx, qry := range queries {
+		key, err := proto.MarshalOptions{Deterministic: true}.Marshal(qry)
+		if err != nil {
+			return nil, err
+		}
+		if b, ok := batchesByKey[string(key)]; ok {
+			b.indexes = append(b.indexes, idx)
+			continue
+		}
+		b := &queryBatchItem{query: qry, indexes: []int{idx}, priority: queryPriority(qry)}
+		batchesByKey[string(key)] = b
+		batches = append(batches, b)
+	}
+	return batches, nil
+}
+
+// queryBatchScheduler admits the queries of a batch that are not served from the query cache in order of priority,
+// with at most limit queries executing concurrently.
+// To respect the priority order, it doesn't admit any queries until each query in the batch has either requested admission or finished.
+type queryBatchScheduler struct {
+	mu        sync.Mutex
+	limit     int
+	running   int
+	unsettled int
+	waiting   []*queryBatchWaiter
+}
+
+// queryBatchWaiter is a query waiting for admission.
+type queryBatchWaiter struct {
+	item  *queryBatchItem
+	order int
+	ready chan struct{}
+}
+
+func newQueryBatchScheduler(batches []*queryBatchItem, limit int) *queryBatchScheduler {
+	return &queryBatchScheduler{
+		limit:     limit,
+		unsettled: len(batches),
+	}
+}
+
+// admit blocks until b may execute. It returns a function that must be called when it's done executing.
+func (s *queryBatchScheduler) admit(ctx context.Context, b *queryBatchItem) (func(), error) {
+	s.mu.Lock()
+	w := &queryBatchWaiter{item: b, order: b.indexes[0], ready: make(chan struct{})}
+	s.waiting = append(s.waiting, w)
+	s.settle(b)
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return s.release, nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		idx := slices.Index(s.waiting, w)
+		if idx >= 0 {
+			s.waiting = slices.Delete(s.waiting, idx, idx+1)
+			s.mu.Unlock()
+		} else {
+			// Cancelled and admitted at the same time
+			s.mu.Unlock()
+			s.release()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// finish must be called when b has finished (whether or not it requested admission).
+func (s *queryBatchScheduler) finish(b *queryBatchItem) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settle(b)
+}
+
+// release frees the slot of an admitted query.
+func (s *queryBatchScheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.running--
+	s.dispatch()
+}
+
+// settle marks b as settled and admits waiting queries if possible. It must be called while holding mu.
+func (s *queryBatchScheduler) settle(b *queryBatchItem) {
+	if !b.settled {
+		b.settled = true
+		s.unsettled--
+	}
+	s.dispatch()
+}
+
+// dispatch admits waiting queries in order of priority while there are free slots. It must be called while holding mu.
+func (s *queryBatchScheduler) dispatch() {
+	if s.unsettled > 0 {
+		return
+	}
+	for s.running < s.limit && len(s.waiting) > 0 {
+		next := 0
+		for i, w := range s.waiting {
+			if w.item.priority > s.waiting[next].item.priority || (w.item.priority == s.waiting[next].item.priority && w.order < s.waiting[next].order) {
+				next = i
+			}
+		}
+		w := s.waiting[next]
+		s.waiting = slices.Delete(s.waiting, next, next+1)
+		s.running++
+		close(w.ready)
+	}
+}
+
+// queryBatchConcurrency returns the number of batched queries to execute concurrently for an instance.
+func (s *Server) queryBatchConcurrency(ctx context.Context, instanceID string) int {
+	olap, release, err := s.runtime.OLAP(ctx, instanceID)
+	if err != nil {
+		// The queries will fail with the error
+		return defaultQueryBatchConcurrency
+	}
+	defer release()
+
+	n, ok := olap.Concurrency()
+	if !ok || n < 1 {
+		return defaultQueryBatchConcurrency
+	}
+	return n
+}
+
+// queryPriority returns the priority set on a batched query's request. It returns 0 if the request doesn't have a priority.
+func queryPriority(qry *runtimev1.Query) int {
+	m := qry.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("query"))
+	if fd == nil {
+		return 0
+	}
+	req := m.Get(fd).Message()
+	pfd := req.Descriptor().Fields().ByName("priority")
+	if pfd == nil || pfd.Kind() != protoreflect.Int32Kind {
+		return 0
+	}
+	return int(req.Get(pfd).Int())
+}
+
+// ExplainQuery implements QueryService.
+func (s *Server) ExplainQuery(ctx context.Context, req *runtimev1.ExplainQueryRequest) (*runtimev1.ExplainQueryResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.query", fmt.Sprintf("%T", req.Query.Query)),
+		attribute.Bool("args.analyze", req.Analyze),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	// The query handler checks access to the query itself
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadProfiling) {
+		return nil, ErrForbidden
+	}
+
+	if req.Query.Query == nil {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	ctx, explainer := runtime.WithQueryExplainer(ctx, req.Analyze)
+	res := s.forwardQuery(ctx, req.InstanceId, 0, req.Query)
+	if res.Error != "" {
+		return nil, status.Error(codes.InvalidArgument, res.Error)
+	}
+
+	stmts, err := explainer.Statements()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &runtimev1.ExplainQueryResponse{Statements: stmts}, nil
+}
+
 func (s *Server) forwardQuery(ctx context.Context, instID string, idx int, qry *runtimev1.Query) *runtimev1.QueryBatchResponse {
 	var err error
 	res := &runtimev1.QueryResult{}
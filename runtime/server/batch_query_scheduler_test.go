@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestQueryBatchScheduler(t *testing.T) {
+	// Indexes 1 and 3 are identical, and index 4 is cached
+	queries := []*runtimev1.Query{
+		batchTestQuery("a", 1),
+		batchTestQuery("b", 5),
+		batchTestQuery("c", 3),
+		batchTestQuery("b", 5),
+		batchTestQuery("d", 9),
+		batchTestQuery("e", 2),
+		batchTestQuery("f", 3),
+	}
+	cached := map[string]bool{"d": true}
+
+	batches, err := groupQueryBatch(queries)
+	require.NoError(t, err)
+	require.Len(t, batches, 6)
+	require.Equal(t, []int{1, 3}, batches[1].indexes)
+
+	t.Run("priority order", func(t *testing.T) {
+		olap := runQueryBatchTest(t, queries, cached, 1)
+		// Duplicates are executed once, cached queries are not executed, and ties are executed in order of appearance
+		require.Equal(t, []string{"b", "c", "f", "e", "a"}, olap.executed)
+		require.Equal(t, 1, olap.maxRunning)
+	})
+
+	t.Run("concurrency limit", func(t *testing.T) {
+		olap := runQueryBatchTest(t, queries, cached, 2)
+		require.ElementsMatch(t, []string{"a", "b", "c", "e", "f"}, olap.executed)
+		require.Equal(t, 2, olap.maxRunning)
+	})
+}
+
+// recordingOLAP records the queries executed against it and the max number of concurrently executing queries.
+type recordingOLAP struct {
+	mu         sync.Mutex
+	executed   []string
+	running    int
+	maxRunning int
+}
+
+func (o *recordingOLAP) execute(name string) {
+	o.mu.Lock()
+	o.executed = append(o.executed, name)
+	o.running++
+	o.maxRunning = max(o.maxRunning, o.running)
+	o.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	o.mu.Lock()
+	o.running--
+	o.mu.Unlock()
+}
+
+// runQueryBatchTest schedules queries like QueryBatch, but executes them against a recordingOLAP.
+// Queries with a measure name in cached are answered without requesting admission.
+func runQueryBatchTest(t *testing.T, queries []*runtimev1.Query, cached map[string]bool, limit int) *recordingOLAP {
+	batches, err := groupQueryBatch(queries)
+	require.NoError(t, err)
+
+	olap := &recordingOLAP{}
+	sched := newQueryBatchScheduler(batches, limit)
+	g, ctx := errgroup.WithContext(context.Background())
+	for _, b := range batches {
+		b := b
+		g.Go(func() error {
+			defer sched.finish(b)
+
+			name := b.query.GetMetricsViewTotalsRequest().MeasureNames[0]
+			if cached[name] {
+				return nil
+			}
+
+			release, err := sched.admit(ctx, b)
+			if err != nil {
+				return err
+			}
+			defer release()
+			olap.execute(name)
+			return nil
+		})
+	}
+	require.NoError(t, g.Wait())
+
+	return olap
+}
+
+func batchTestQuery(measure string, priority int32) *runtimev1.Query {
+	return &runtimev1.Query{
+		Query: &runtimev1.Query_MetricsViewTotalsRequest{
+			MetricsViewTotalsRequest: &runtimev1.MetricsViewTotalsRequest{
+				MetricsViewName: "mv",
+				MeasureNames:    []string{measure},
+				Priority:        priority,
+			},
+		},
+	}
+}
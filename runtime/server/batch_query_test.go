@@ -3,12 +3,15 @@ package server_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestServer_QueryBatch_MetricsViewQueries(t *testing.T) {
@@ -423,6 +426,138 @@ func TestServer_QueryBatch_SomeErrors(t *testing.T) {
 	}
 }
 
+func TestServer_QueryBatch_DuplicateQueries(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	totals := func(priority int32) *runtimev1.Query {
+		return &runtimev1.Query{
+			Query: &runtimev1.Query_MetricsViewTotalsRequest{
+				MetricsViewTotalsRequest: &runtimev1.MetricsViewTotalsRequest{
+					MetricsViewName: "ad_bids_metrics",
+					MeasureNames:    []string{"measure_0"},
+					Priority:        priority,
+				},
+			},
+		}
+	}
+
+	req := &runtimev1.QueryBatchRequest{
+		InstanceId: instanceId,
+		Queries:    []*runtimev1.Query{totals(1), totals(2), totals(1)},
+	}
+
+	// Run the batch twice so the second run is served from the cache
+	for i := 0; i < 2; i++ {
+		batchServer := newFakeBatchServer()
+		err := server.QueryBatch(req, batchServer)
+		require.NoError(t, err)
+		require.Equal(t, len(req.Queries), len(batchServer.responses))
+
+		haveResponse := make([]bool, len(req.Queries))
+		for _, response := range batchServer.responses {
+			require.Empty(t, response.Error)
+			require.False(t, haveResponse[response.Index])
+			haveResponse[response.Index] = true
+			require.Equal(t, 1, len(response.Result.GetMetricsViewTotalsResponse().Data.Fields))
+		}
+	}
+}
+
+func TestServer_ExplainQuery(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	res, err := server.ExplainQuery(testCtx(), &runtimev1.ExplainQueryRequest{
+		InstanceId: instanceId,
+		Query: &runtimev1.Query{
+			Query: &runtimev1.Query_MetricsViewTotalsRequest{
+				MetricsViewTotalsRequest: &runtimev1.MetricsViewTotalsRequest{
+					MetricsViewName: "ad_bids_metrics",
+					MeasureNames:    []string{"measure_0"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Statements, 1)
+	require.Contains(t, res.Statements[0].Sql, "ad_bids")
+	require.NotEmpty(t, res.Statements[0].Plan)
+
+	// Explaining the same query again should not be served from the cache
+	res, err = server.ExplainQuery(testCtx(), &runtimev1.ExplainQueryRequest{
+		InstanceId: instanceId,
+		Query: &runtimev1.Query{
+			Query: &runtimev1.Query_MetricsViewTotalsRequest{
+				MetricsViewTotalsRequest: &runtimev1.MetricsViewTotalsRequest{
+					MetricsViewName: "ad_bids_metrics",
+					MeasureNames:    []string{"measure_0"},
+				},
+			},
+		},
+		Analyze: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Statements, 1)
+	require.NotEmpty(t, res.Statements[0].Plan)
+
+	// The query must be set
+	_, err = server.ExplainQuery(testCtx(), &runtimev1.ExplainQueryRequest{
+		InstanceId: instanceId,
+		Query:      &runtimev1.Query{},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Queries that fail are reported as errors
+	_, err = server.ExplainQuery(testCtx(), &runtimev1.ExplainQueryRequest{
+		InstanceId: instanceId,
+		Query: &runtimev1.Query{
+			Query: &runtimev1.Query_MetricsViewTotalsRequest{
+				MetricsViewTotalsRequest: &runtimev1.MetricsViewTotalsRequest{
+					MetricsViewName: "ad_bids_metrics",
+					MeasureNames:    []string{"missing"},
+				},
+			},
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestServer_ExplainQuery_ColumnTimeSeries(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	// ColumnTimeSeries creates and drops a temporary table, so its statements must be explained on the connection that executes them
+	res, err := server.ExplainQuery(testCtx(), &runtimev1.ExplainQueryRequest{
+		InstanceId: instanceId,
+		Query: &runtimev1.Query{
+			Query: &runtimev1.Query_ColumnTimeSeriesRequest{
+				ColumnTimeSeriesRequest: &runtimev1.ColumnTimeSeriesRequest{
+					TableName:           "ad_bids",
+					TimestampColumnName: "timestamp",
+					TimeRange: &runtimev1.TimeSeriesTimeRange{
+						Interval: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Statements)
+
+	var explained int
+	for _, stmt := range res.Statements {
+		sql := strings.ToUpper(strings.TrimSpace(stmt.Sql))
+		if strings.HasPrefix(sql, "CREATE") || strings.HasPrefix(sql, "DROP") {
+			require.Empty(t, stmt.Plan)
+			continue
+		}
+		require.NotEmpty(t, stmt.Plan)
+		explained++
+	}
+	require.Greater(t, explained, 0)
+}
+
 type fakeBatchServer struct {
 	grpc.ServerStream
 	responses []*runtimev1.QueryBatchResponse
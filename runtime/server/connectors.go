@@ -69,7 +69,12 @@ func (s *Server) ScanConnectors(ctx context.Context, req *runtimev1.ScanConnecto
 	}
 	defer release()
 
-	p, err := rillv1.Parse(ctx, repo, req.InstanceId, "", nil)
+	inst, err := s.runtime.Instance(ctx, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := rillv1.Parse(ctx, repo, req.InstanceId, inst.Environment, "", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -29,7 +29,9 @@ func (s *Server) GetLogs(ctx context.Context, req *runtimev1.GetLogsRequest) (*r
 		attribute.Bool("args.ascending", req.Ascending),
 	)
 
-	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadObjects) {
+	// The logs may reference any resource, so tokens that are restricted to specific resources can't read them
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadObjects) || claims.Restrictions() != nil {
 		return nil, ErrForbidden
 	}
 
@@ -40,6 +42,58 @@ func (s *Server) GetLogs(ctx context.Context, req *runtimev1.GetLogsRequest) (*r
 	return &runtimev1.GetLogsResponse{Logs: ctrl.Logs.GetLogs(req.Ascending, int(req.Limit))}, nil
 }
 
+// ListQueryHistory implements runtimev1.RuntimeServiceServer
+func (s *Server) ListQueryHistory(ctx context.Context, req *runtimev1.ListQueryHistoryRequest) (*runtimev1.ListQueryHistoryResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.Int("args.min_duration_ms", int(req.MinDurationMs)),
+		attribute.Int("args.limit", int(req.Limit)),
+	)
+
+	// The history contains the SQL and args of other users' queries, so only instance managers can see all of it.
+	// Other callers with profiling access only see their own queries, and the args are redacted since they may contain data the caller can't access.
+	claims := auth.GetClaims(ctx)
+	manage := claims.Can(auth.ManageInstances)
+	if !manage && !claims.CanInstance(req.InstanceId, auth.ReadProfiling) {
+		return nil, ErrForbidden
+	}
+
+	ctrl, err := s.runtime.Controller(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if ctrl.QueryLog == nil {
+		return nil, status.Error(codes.FailedPrecondition, "query history is not enabled for this runtime")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = -1
+	}
+
+	if manage {
+		return &runtimev1.ListQueryHistoryResponse{Entries: ctrl.QueryLog.List(req.MinDurationMs, "", limit)}, nil
+	}
+
+	// Filter to the caller's own queries. Without a subject, there's nothing to filter by.
+	subject := claims.Subject()
+	if subject == "" {
+		return &runtimev1.ListQueryHistoryResponse{}, nil
+	}
+	entries := ctrl.QueryLog.List(req.MinDurationMs, subject, limit)
+	for i, e := range entries {
+		// Clone the entry since it's shared with the query log
+		e = proto.Clone(e).(*runtimev1.QueryHistoryEntry)
+		for _, stmt := range e.Statements {
+			stmt.Args = nil
+		}
+		entries[i] = e
+	}
+
+	return &runtimev1.ListQueryHistoryResponse{Entries: entries}, nil
+}
+
 // WatchLogs implements runtimev1.RuntimeServiceServer
 func (s *Server) WatchLogs(req *runtimev1.WatchLogsRequest, srv runtimev1.RuntimeService_WatchLogsServer) error {
 	ctx := srv.Context()
@@ -49,7 +103,9 @@ func (s *Server) WatchLogs(req *runtimev1.WatchLogsRequest, srv runtimev1.Runtim
 		attribute.Bool("args.replay", req.Replay),
 	)
 
-	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadObjects) {
+	// The logs may reference any resource, so tokens that are restricted to specific resources can't read them
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadObjects) || claims.Restrictions() != nil {
 		return ErrForbidden
 	}
 
@@ -273,9 +329,13 @@ func (s *Server) CreateTrigger(ctx context.Context, req *runtimev1.CreateTrigger
 	return &runtimev1.CreateTriggerResponse{}, nil
 }
 
-// applySecurityPolicy applies relevant security policies to the resource.
+// applySecurityPolicy applies relevant security policies and the token's resource restrictions to the resource.
 // The input resource will not be modified in-place (so no need to set clone=true when obtaining it from the catalog).
 func (s *Server) applySecurityPolicy(ctx context.Context, instID string, r *runtimev1.Resource) (*runtimev1.Resource, bool, error) {
+	if !auth.GetClaims(ctx).Restrictions().CanAccessResource(r.Meta.Name.Kind, r.Meta.Name.Name) {
+		return nil, false, nil
+	}
+
 	switch r.Resource.(type) {
 	case *runtimev1.Resource_MetricsView:
 		return s.applySecurityPolicyMetricsView(ctx, instID, r)
@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"reflect"
+	"slices"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -81,7 +82,8 @@ func (s *Server) Export(ctx context.Context, req *runtimev1.ExportRequest) (*run
 		req.BakedQuery = ""
 	}
 
-	tkn, err := s.generateDownloadToken(req, auth.GetClaims(ctx).Attributes())
+	claims := auth.GetClaims(ctx)
+	tkn, err := s.generateDownloadToken(req, claims.Attributes(), claims.Restrictions(), claims.TokenID())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate download token: %s", err.Error())
 	}
@@ -95,8 +97,12 @@ func (s *Server) Export(ctx context.Context, req *runtimev1.ExportRequest) (*run
 
 func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 	rawTkn := req.URL.Query().Get("token")
-	request, attrs, err := s.parseDownloadToken(rawTkn)
+	request, attrs, restrictions, err := s.parseDownloadToken(rawTkn)
 	if err != nil {
+		if errors.Is(err, errDownloadTokenRevoked) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
 		http.Error(w, fmt.Sprintf("failed to parse download token: %s", err.Error()), http.StatusBadRequest)
 		return
 	}
@@ -105,14 +111,14 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 	switch v := request.Query.Query.(type) {
 	case *runtimev1.Query_MetricsViewAggregationRequest:
 		r := v.MetricsViewAggregationRequest
-		mv, security, err := resolveMVAndSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.MetricsView, attrs)
+		mv, security, err := resolveMVAndSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.MetricsView, attrs, restrictions)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 
 		for _, dim := range r.Dimensions {
-			if dim.Name == mv.TimeDimension {
+			if dim.Name == mv.TimeDimension || slices.Contains(mv.TimeDimensions, dim.Name) {
 				// checkFieldAccess doesn't currently check the time dimension
 				continue
 			}
@@ -152,6 +158,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			Measures:           r.Measures,
 			Sort:               r.Sort,
 			TimeRange:          tr,
+			TimeDimension:      r.TimeDimension,
 			Where:              r.Where,
 			Having:             r.Having,
 			Limit:              limitPtr,
@@ -162,7 +169,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 	case *runtimev1.Query_MetricsViewToplistRequest:
 		r := v.MetricsViewToplistRequest
 
-		mv, security, err := resolveMVAndSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.MetricsViewName, attrs)
+		mv, security, err := resolveMVAndSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.MetricsViewName, attrs, restrictions)
 		if err != nil {
 			if errors.Is(err, ErrForbidden) {
 				http.Error(w, "action not allowed", http.StatusUnauthorized)
@@ -212,7 +219,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 		}
 	case *runtimev1.Query_MetricsViewRowsRequest:
 		r := v.MetricsViewRowsRequest
-		mv, security, err := resolveMVAndSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.MetricsViewName, attrs)
+		mv, security, err := resolveMVAndSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.MetricsViewName, attrs, restrictions)
 		if err != nil {
 			if errors.Is(err, ErrForbidden) {
 				http.Error(w, "action not allowed", http.StatusUnauthorized)
@@ -242,7 +249,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 	case *runtimev1.Query_MetricsViewTimeSeriesRequest:
 		r := v.MetricsViewTimeSeriesRequest
 
-		mv, security, err := resolveMVAndSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.MetricsViewName, attrs)
+		mv, security, err := resolveMVAndSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.MetricsViewName, attrs, restrictions)
 		if err != nil {
 			if errors.Is(err, ErrForbidden) {
 				http.Error(w, "action not allowed", http.StatusUnauthorized)
@@ -265,6 +272,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			TimeStart:          r.TimeStart,
 			TimeEnd:            r.TimeEnd,
 			TimeGranularity:    r.TimeGranularity,
+			TimeDimension:      r.TimeDimension,
 			Where:              r.Where,
 			Having:             r.Having,
 			TimeZone:           r.TimeZone,
@@ -274,7 +282,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 	case *runtimev1.Query_MetricsViewComparisonRequest:
 		r := v.MetricsViewComparisonRequest
 
-		mv, security, err := resolveMVAndSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.MetricsViewName, attrs)
+		mv, security, err := resolveMVAndSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.MetricsViewName, attrs, restrictions)
 		if err != nil {
 			if errors.Is(err, ErrForbidden) {
 				http.Error(w, "action not allowed", http.StatusUnauthorized)
@@ -303,6 +311,7 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			Measures:            r.Measures,
 			TimeRange:           r.TimeRange,
 			ComparisonTimeRange: r.ComparisonTimeRange,
+			TimeDimension:       r.TimeDimension,
 			Limit:               s.resolveExportLimit(request.Limit, r.Limit),
 			Offset:              r.Offset,
 			Sort:                r.Sort,
@@ -318,9 +327,20 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 
+		security, err := resolveTableSecurityFromAttributes(req.Context(), s.runtime, request.InstanceId, r.TableName, attrs, restrictions)
+		if err != nil {
+			if errors.Is(err, ErrForbidden) {
+				http.Error(w, "action not allowed", http.StatusUnauthorized)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
 		q = &queries.TableHead{
 			TableName: r.TableName,
 			Limit:     int(r.Limit),
+			Security:  security,
 			Result:    nil,
 		}
 	default:
@@ -374,11 +394,18 @@ func (s *Server) resolveExportLimit(base, override int64) int64 {
 // downloadTokenTTL determines how long a download token is valid.
 const downloadTokenTTL = 1 * time.Hour
 
+// errDownloadTokenRevoked is returned when a download token was generated with an access token that has since been revoked.
+var errDownloadTokenRevoked = errors.New("download token was generated with a revoked access token")
+
 // downloadToken is the non-encrypted representation of a download token.
 type downloadToken struct {
-	Request    []byte         `json:"req"`
-	Attributes map[string]any `json:"attrs"`
-	ExpiresOn  time.Time      `json:"exp"`
+	Request      []byte             `json:"req"`
+	Attributes   map[string]any     `json:"attrs"`
+	Restrictions *auth.Restrictions `json:"restr"`
+	// TokenID is the ID of the revocable access token the download token was generated with (if any).
+	// The download token is rejected if the access token has been revoked.
+	TokenID   string    `json:"tid"`
+	ExpiresOn time.Time `json:"exp"`
 }
 
 // register downloadToken for gob encoding
@@ -387,7 +414,8 @@ func init() {
 }
 
 // generateDownloadToken generates and encrypts a download token for the given request and attributes.
-func (s *Server) generateDownloadToken(req *runtimev1.ExportRequest, attrs map[string]any) (string, error) {
+// The tokenID is the ID of the caller's access token, which is checked for revocation when the download token is used.
+func (s *Server) generateDownloadToken(req *runtimev1.ExportRequest, attrs map[string]any, restrictions *auth.Restrictions, tokenID string) (string, error) {
 	r, err := proto.Marshal(req)
 	if err != nil {
 		return "", err
@@ -399,9 +427,11 @@ func (s *Server) generateDownloadToken(req *runtimev1.ExportRequest, attrs map[s
 	}
 
 	tkn := downloadToken{
-		Request:    r,
-		Attributes: attrs,
-		ExpiresOn:  time.Now().Add(downloadTokenTTL),
+		Request:      r,
+		Attributes:   attrs,
+		Restrictions: restrictions,
+		TokenID:      tokenID,
+		ExpiresOn:    time.Now().Add(downloadTokenTTL),
 	}
 
 	res, err := s.codec.Encode(tkn)
@@ -412,30 +442,34 @@ func (s *Server) generateDownloadToken(req *runtimev1.ExportRequest, attrs map[s
 	return res, nil
 }
 
-// parseDownloadToken decrypts and parses a download token and returns the request and attributes.
-func (s *Server) parseDownloadToken(tknStr string) (*runtimev1.ExportRequest, map[string]any, error) {
+// parseDownloadToken decrypts and parses a download token and returns the request, attributes and restrictions.
+func (s *Server) parseDownloadToken(tknStr string) (*runtimev1.ExportRequest, map[string]any, *auth.Restrictions, error) {
 	tkn := downloadToken{}
 	err := s.codec.Decode(tknStr, &tkn)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if tkn.ExpiresOn.Before(time.Now()) {
-		return nil, nil, fmt.Errorf("download token expired")
+		return nil, nil, nil, fmt.Errorf("download token expired")
+	}
+
+	if tkn.TokenID != "" && s.aud != nil && s.aud.IsRevoked(tkn.TokenID) {
+		return nil, nil, nil, errDownloadTokenRevoked
 	}
 
 	r, err := gzipDecompress(tkn.Request)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	req := &runtimev1.ExportRequest{}
 	err = proto.Unmarshal(r, req)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return req, tkn.Attributes, nil
+	return req, tkn.Attributes, tkn.Restrictions, nil
 }
 
 // gzipCompress compress the input bytes using gzip.
@@ -67,6 +67,7 @@ func (s *Server) CreateInstance(ctx context.Context, req *runtimev1.CreateInstan
 		attribute.String("args.olap_connector", req.OlapConnector),
 		attribute.String("args.repo_connector", req.RepoConnector),
 		attribute.String("args.admin_connector", req.AdminConnector),
+		attribute.String("args.environment", req.Environment),
 		attribute.StringSlice("args.connectors", toString(req.Connectors)),
 	)
 
@@ -79,6 +80,7 @@ func (s *Server) CreateInstance(ctx context.Context, req *runtimev1.CreateInstan
 		OLAPConnector:                req.OlapConnector,
 		RepoConnector:                req.RepoConnector,
 		AdminConnector:               req.AdminConnector,
+		Environment:                  req.Environment,
 		Connectors:                   req.Connectors,
 		Variables:                    req.Variables,
 		Annotations:                  req.Annotations,
@@ -112,6 +114,9 @@ func (s *Server) EditInstance(ctx context.Context, req *runtimev1.EditInstanceRe
 	if req.AdminConnector != nil {
 		observability.AddRequestAttributes(ctx, attribute.String("args.admin_connector", *req.AdminConnector))
 	}
+	if req.Environment != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.environment", *req.Environment))
+	}
 	if len(req.Connectors) > 0 {
 		observability.AddRequestAttributes(ctx, attribute.StringSlice("args.connectors", toString(req.Connectors)))
 	}
@@ -145,6 +150,7 @@ func (s *Server) EditInstance(ctx context.Context, req *runtimev1.EditInstanceRe
 		OLAPConnector:                valOrDefault(req.OlapConnector, oldInst.OLAPConnector),
 		RepoConnector:                valOrDefault(req.RepoConnector, oldInst.RepoConnector),
 		AdminConnector:               valOrDefault(req.AdminConnector, oldInst.AdminConnector),
+		Environment:                  valOrDefault(req.Environment, oldInst.Environment),
 		Connectors:                   connectors,
 		ProjectConnectors:            oldInst.ProjectConnectors,
 		Variables:                    variables,
@@ -194,6 +200,7 @@ func instanceToPB(inst *drivers.Instance) *runtimev1.Instance {
 		OlapConnector:                inst.OLAPConnector,
 		RepoConnector:                inst.RepoConnector,
 		AdminConnector:               inst.AdminConnector,
+		Environment:                  inst.Environment,
 		CreatedOn:                    timestamppb.New(inst.CreatedOn),
 		UpdatedOn:                    timestamppb.New(inst.UpdatedOn),
 		Connectors:                   inst.Connectors,
@@ -27,6 +27,14 @@ func (s *Server) ColumnTopK(ctx context.Context, req *runtimev1.ColumnTopKReques
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.CanAccessColumn(req.ColumnName) {
+		return nil, ErrForbidden
+	}
+
 	agg := "count(*)"
 	if req.Agg != "" {
 		agg = req.Agg
@@ -42,9 +50,10 @@ func (s *Server) ColumnTopK(ctx context.Context, req *runtimev1.ColumnTopKReques
 		ColumnName: req.ColumnName,
 		Agg:        agg,
 		K:          k,
+		Security:   policy,
 	}
 
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
@@ -72,12 +81,21 @@ func (s *Server) ColumnNullCount(ctx context.Context, req *runtimev1.ColumnNullC
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.CanAccessColumn(req.ColumnName) {
+		return nil, ErrForbidden
+	}
+
 	q := &queries.ColumnNullCount{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
+		Security:   policy,
 	}
 
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
@@ -101,11 +119,20 @@ func (s *Server) ColumnDescriptiveStatistics(ctx context.Context, req *runtimev1
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.CanAccessColumn(req.ColumnName) {
+		return nil, ErrForbidden
+	}
+
 	q := &queries.ColumnDescriptiveStatistics{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
+		Security:   policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
@@ -164,11 +191,20 @@ func (s *Server) ColumnTimeGrain(ctx context.Context, req *runtimev1.ColumnTimeG
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.CanAccessColumn(req.ColumnName) {
+		return nil, ErrForbidden
+	}
+
 	q := &queries.ColumnTimeGrain{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
+		Security:   policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
@@ -192,12 +228,21 @@ func (s *Server) ColumnNumericHistogram(ctx context.Context, req *runtimev1.Colu
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.CanAccessColumn(req.ColumnName) {
+		return nil, ErrForbidden
+	}
+
 	q := &queries.ColumnNumericHistogram{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
 		Method:     req.HistogramMethod,
+		Security:   policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
@@ -229,11 +274,20 @@ func (s *Server) ColumnRugHistogram(ctx context.Context, req *runtimev1.ColumnRu
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.CanAccessColumn(req.ColumnName) {
+		return nil, ErrForbidden
+	}
+
 	q := &queries.ColumnRugHistogram{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
+		Security:   policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
@@ -262,11 +316,20 @@ func (s *Server) ColumnTimeRange(ctx context.Context, req *runtimev1.ColumnTimeR
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.CanAccessColumn(req.ColumnName) {
+		return nil, ErrForbidden
+	}
+
 	q := &queries.ColumnTimeRange{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
+		Security:   policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
@@ -289,11 +352,20 @@ func (s *Server) ColumnCardinality(ctx context.Context, req *runtimev1.ColumnCar
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.CanAccessColumn(req.ColumnName) {
+		return nil, ErrForbidden
+	}
+
 	q := &queries.ColumnCardinality{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
+		Security:   policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, err
 	}
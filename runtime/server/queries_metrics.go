@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -43,7 +44,7 @@ func (s *Server) MetricsViewAggregation(ctx context.Context, req *runtimev1.Metr
 	}
 
 	for _, dim := range req.Dimensions {
-		if dim.Name == mv.TimeDimension {
+		if dim.Name == mv.TimeDimension || slices.Contains(mv.TimeDimensions, dim.Name) {
 			// checkFieldAccess doesn't currently check the time dimension
 			continue
 		}
@@ -75,6 +76,7 @@ func (s *Server) MetricsViewAggregation(ctx context.Context, req *runtimev1.Metr
 		Measures:           req.Measures,
 		Sort:               req.Sort,
 		TimeRange:          tr,
+		TimeDimension:      req.TimeDimension,
 		Where:              req.Where,
 		Having:             req.Having,
 		Limit:              &req.Limit,
@@ -222,6 +224,7 @@ func (s *Server) MetricsViewComparison(ctx context.Context, req *runtimev1.Metri
 		Measures:            req.Measures,
 		TimeRange:           req.TimeRange,
 		ComparisonTimeRange: req.ComparisonTimeRange,
+		TimeDimension:       req.TimeDimension,
 		Limit:               req.Limit,
 		Offset:              req.Offset,
 		Sort:                req.Sort,
@@ -284,6 +287,7 @@ func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.Metri
 		TimeStart:          req.TimeStart,
 		TimeEnd:            req.TimeEnd,
 		TimeGranularity:    req.TimeGranularity,
+		TimeDimension:      req.TimeDimension,
 		Where:              req.Where,
 		Having:             req.Having,
 		TimeZone:           req.TimeZone,
@@ -423,6 +427,7 @@ func (s *Server) MetricsViewTimeRange(ctx context.Context, req *runtimev1.Metric
 
 	q := &queries.MetricsViewTimeRange{
 		MetricsViewName:    req.MetricsViewName,
+		TimeDimension:      req.TimeDimension,
 		MetricsView:        mv,
 		ResolvedMVSecurity: security,
 	}
@@ -451,23 +456,15 @@ func validateInlineMeasures(ms []*runtimev1.InlineMeasure) error {
 }
 
 func resolveMVAndSecurity(ctx context.Context, rt *runtime.Runtime, instanceID, metricsViewName string) (*runtimev1.MetricsViewSpec, *runtime.ResolvedMetricsViewSecurity, error) {
-	mv, lastUpdatedOn, err := lookupMetricsView(ctx, rt, instanceID, metricsViewName)
-	if err != nil {
-		return nil, nil, err
-	}
+	claims := auth.GetClaims(ctx)
+	return resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, metricsViewName, claims.Attributes(), claims.Restrictions())
+}
 
-	resolvedSecurity, err := rt.ResolveMetricsViewSecurity(auth.GetClaims(ctx).Attributes(), instanceID, mv, lastUpdatedOn)
-	if err != nil {
-		return nil, nil, err
-	}
-	if resolvedSecurity != nil && !resolvedSecurity.Access {
+func resolveMVAndSecurityFromAttributes(ctx context.Context, rt *runtime.Runtime, instanceID, metricsViewName string, attrs map[string]any, restrictions *auth.Restrictions) (*runtimev1.MetricsViewSpec, *runtime.ResolvedMetricsViewSecurity, error) {
+	if !restrictions.CanAccessResource(runtime.ResourceKindMetricsView, metricsViewName) {
 		return nil, nil, ErrForbidden
 	}
 
-	return mv, resolvedSecurity, nil
-}
-
-func resolveMVAndSecurityFromAttributes(ctx context.Context, rt *runtime.Runtime, instanceID, metricsViewName string, attrs map[string]any) (*runtimev1.MetricsViewSpec, *runtime.ResolvedMetricsViewSecurity, error) {
 	mv, lastUpdatedOn, err := lookupMetricsView(ctx, rt, instanceID, metricsViewName)
 	if err != nil {
 		return nil, nil, err
@@ -482,7 +479,26 @@ func resolveMVAndSecurityFromAttributes(ctx context.Context, rt *runtime.Runtime
 		return nil, nil, ErrForbidden
 	}
 
-	return mv, resolvedSecurity, nil
+	return mv, withMetricsViewFilter(resolvedSecurity, restrictions.MetricsViewFilter(metricsViewName)), nil
+}
+
+// withMetricsViewFilter adds a mandatory filter from a token's restrictions to a resolved security policy.
+// It returns a copy since resolved policies are cached and shared between requests.
+func withMetricsViewFilter(policy *runtime.ResolvedMetricsViewSecurity, filter string) *runtime.ResolvedMetricsViewSecurity {
+	if filter == "" {
+		return policy
+	}
+	if policy == nil {
+		return &runtime.ResolvedMetricsViewSecurity{Access: true, RowFilter: filter}
+	}
+
+	res := *policy
+	if res.RowFilter == "" {
+		res.RowFilter = filter
+	} else {
+		res.RowFilter = fmt.Sprintf("(%s) AND (%s)", res.RowFilter, filter)
+	}
+	return &res
 }
 
 // returns the metrics view and the time the catalog was last updated
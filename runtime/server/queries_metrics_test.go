@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
 	"github.com/stretchr/testify/require"
 )
 
@@ -14,3 +15,16 @@ func TestInlineMeasureValidation(t *testing.T) {
 	require.NoError(t, validateInlineMeasures([]*runtimev1.InlineMeasure{{Expression: "count(distinct my_dim)"}}))
 	require.Error(t, validateInlineMeasures([]*runtimev1.InlineMeasure{{Expression: "SUM(my_dim)"}}))
 }
+
+func TestWithMetricsViewFilter(t *testing.T) {
+	require.Nil(t, withMetricsViewFilter(nil, ""))
+
+	res := withMetricsViewFilter(nil, "publisher = 'Google'")
+	require.Equal(t, &runtime.ResolvedMetricsViewSecurity{Access: true, RowFilter: "publisher = 'Google'"}, res)
+
+	policy := &runtime.ResolvedMetricsViewSecurity{Access: true, RowFilter: "domain = 'google.com'", Exclude: []string{"bid_price"}}
+	res = withMetricsViewFilter(policy, "publisher = 'Google'")
+	require.Equal(t, "(domain = 'google.com') AND (publisher = 'Google')", res.RowFilter)
+	require.Equal(t, []string{"bid_price"}, res.Exclude)
+	require.Equal(t, "domain = 'google.com'", policy.RowFilter)
+}
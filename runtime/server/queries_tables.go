@@ -2,12 +2,18 @@ package server
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const _tableHeadDefaultLimit = 25
@@ -26,10 +32,16 @@ func (s *Server) TableCardinality(ctx context.Context, req *runtimev1.TableCardi
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+
 	q := &queries.TableCardinality{
 		TableName: req.TableName,
+		Security:  policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, err
 	}
@@ -57,11 +69,17 @@ func (s *Server) TableColumns(ctx context.Context, req *runtimev1.TableColumnsRe
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+
 	q := &queries.TableColumns{
 		TableName: req.TableName,
+		Security:  policy,
 	}
 
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +103,11 @@ func (s *Server) TableRows(ctx context.Context, req *runtimev1.TableRowsRequest)
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+
 	limit := int(req.Limit)
 	if limit == 0 {
 		limit = _tableHeadDefaultLimit
@@ -93,9 +116,10 @@ func (s *Server) TableRows(ctx context.Context, req *runtimev1.TableRowsRequest)
 	q := &queries.TableHead{
 		TableName: req.TableName,
 		Limit:     limit,
+		Security:  policy,
 	}
 
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, err
 	}
@@ -104,3 +128,60 @@ func (s *Server) TableRows(ctx context.Context, req *runtimev1.TableRowsRequest)
 		Data: q.Result,
 	}, nil
 }
+
+// resolveTableSecurity resolves the security policy of the source or model that produces a table for the current user.
+// It returns ErrForbidden if the policy or the token's restrictions deny access to the table, and nil if the table doesn't have a security policy.
+func resolveTableSecurity(ctx context.Context, rt *runtime.Runtime, instanceID, tableName string) (*runtime.ResolvedTableSecurity, error) {
+	claims := auth.GetClaims(ctx)
+	return resolveTableSecurityFromAttributes(ctx, rt, instanceID, tableName, claims.Attributes(), claims.Restrictions())
+}
+
+func resolveTableSecurityFromAttributes(ctx context.Context, rt *runtime.Runtime, instanceID, tableName string, attrs map[string]any, restrictions *auth.Restrictions) (*runtime.ResolvedTableSecurity, error) {
+	if !restrictions.CanAccessResource(runtime.ResourceKindSource, tableName) && !restrictions.CanAccessResource(runtime.ResourceKindModel, tableName) {
+		return nil, ErrForbidden
+	}
+
+	policy, lastUpdatedOn, err := lookupTableSecurity(ctx, rt, instanceID, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedSecurity, err := rt.ResolveTableSecurity(attrs, instanceID, tableName, policy, lastUpdatedOn)
+	if err != nil {
+		return nil, err
+	}
+	if resolvedSecurity != nil && !resolvedSecurity.Access {
+		return nil, ErrForbidden
+	}
+
+	return resolvedSecurity, nil
+}
+
+// lookupTableSecurity returns the security policy of the source or model that produces a table and the time its spec was last updated.
+// It returns a nil policy if the table isn't produced by a source or model.
+func lookupTableSecurity(ctx context.Context, rt *runtime.Runtime, instanceID, tableName string) (*runtimev1.TableSecurity, time.Time, error) {
+	ctrl, err := rt.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, time.Time{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, kind := range []string{runtime.ResourceKindSource, runtime.ResourceKindModel} {
+		res, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: kind, Name: tableName}, false)
+		if err != nil {
+			if errors.Is(err, drivers.ErrResourceNotFound) {
+				continue
+			}
+			return nil, time.Time{}, err
+		}
+
+		var policy *runtimev1.TableSecurity
+		if src := res.GetSource(); src != nil {
+			policy = src.Spec.Security
+		} else if mdl := res.GetModel(); mdl != nil {
+			policy = mdl.Spec.Security
+		}
+		return policy, res.Meta.SpecUpdatedOn.AsTime(), nil
+	}
+
+	return nil, time.Time{}, nil
+}
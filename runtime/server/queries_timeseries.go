@@ -25,11 +25,20 @@ func (s *Server) ColumnRollupInterval(ctx context.Context, req *runtimev1.Column
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.CanAccessColumn(req.ColumnName) {
+		return nil, ErrForbidden
+	}
+
 	q := &queries.RollupInterval{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
+		Security:   policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +69,14 @@ func (s *Server) ColumnTimeSeries(ctx context.Context, req *runtimev1.ColumnTime
 		return nil, ErrForbidden
 	}
 
+	policy, err := resolveTableSecurity(ctx, s.runtime, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.CanAccessColumn(req.TimestampColumnName) {
+		return nil, ErrForbidden
+	}
+
 	q := &queries.ColumnTimeseries{
 		TableName:           req.TableName,
 		TimestampColumnName: req.TimestampColumnName,
@@ -68,8 +85,9 @@ func (s *Server) ColumnTimeSeries(ctx context.Context, req *runtimev1.ColumnTime
 		Pixels:              req.Pixels,
 		SampleSize:          req.SampleSize,
 		TimeZone:            req.TimeZone,
+		Security:            policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, err
 	}
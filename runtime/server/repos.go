@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/server/auth"
@@ -179,6 +180,81 @@ func (s *Server) RenameFile(ctx context.Context, req *runtimev1.RenameFileReques
 	return &runtimev1.RenameFileResponse{}, nil
 }
 
+// FormatFile implements RuntimeService.
+func (s *Server) FormatFile(ctx context.Context, req *runtimev1.FormatFileRequest) (*runtimev1.FormatFileResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.path", req.Path),
+		attribute.Bool("args.dry_run", req.DryRun),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	perm := auth.EditRepo
+	if req.DryRun {
+		perm = auth.ReadRepo
+	}
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, perm) {
+		return nil, ErrForbidden
+	}
+
+	formattable, err := s.pathIsFormattable(ctx, req.InstanceId, req.Path)
+	if err != nil {
+		return nil, err
+	}
+	if !formattable {
+		return nil, status.Errorf(codes.InvalidArgument, "formatting is only supported for SQL files of DuckDB models")
+	}
+
+	blob, _, err := s.runtime.GetFile(ctx, req.InstanceId, req.Path)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	formatted, err := rillv1.FormatSQL(blob)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	changed := formatted != blob
+	if changed && !req.DryRun {
+		err = s.runtime.PutFile(ctx, req.InstanceId, req.Path, strings.NewReader(formatted), false, false)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	return &runtimev1.FormatFileResponse{Blob: formatted, Changed: changed}, nil
+}
+
+// pathIsFormattable parses the instance's project and returns true if the file at path is the SQL file of a DuckDB model.
+func (s *Server) pathIsFormattable(ctx context.Context, instanceID, path string) (bool, error) {
+	inst, err := s.runtime.Instance(ctx, instanceID)
+	if err != nil {
+		return false, err
+	}
+
+	var duckdbConnectors []string
+	for _, connector := range inst.Connectors {
+		if connector.Type == "duckdb" {
+			duckdbConnectors = append(duckdbConnectors, connector.Name)
+		}
+	}
+
+	repo, release, err := s.runtime.Repo(ctx, instanceID)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	p, err := rillv1.Parse(ctx, repo, instanceID, inst.Environment, inst.OLAPConnector, duckdbConnectors)
+	if err != nil {
+		return false, err
+	}
+
+	return p.PathIsFormattable(path), nil
+}
+
 // UploadMultipartFile implements the same functionality as PutFile, but for multipart HTTP upload.
 // It's mounted only on as a REST API and enables upload of large files (such as data files).
 func (s *Server) UploadMultipartFile(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
@@ -13,6 +13,7 @@ import (
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/secrets"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
@@ -42,6 +43,11 @@ type TestingT interface {
 
 // New returns a runtime configured for use in tests.
 func New(t TestingT) *runtime.Runtime {
+	return newRuntime(t, nil)
+}
+
+// newRuntime returns a runtime configured for use in tests that resolves secret references against secretStores.
+func newRuntime(t TestingT, secretStores map[string]secrets.Store) *runtime.Runtime {
 	opts := &runtime.Options{
 		MetastoreConnector: "metastore",
 		SystemConnectors: []*runtimev1.Connector{
@@ -59,6 +65,7 @@ func New(t TestingT) *runtime.Runtime {
 		ControllerLogBufferCapacity:  10000,
 		ControllerLogBufferSizeBytes: int64(datasize.MB * 16),
 		AllowHostAccess:              true,
+		SecretStores:                 secretStores,
 	}
 
 	logger := zap.NewNop()
@@ -81,12 +88,13 @@ type InstanceOptions struct {
 	StageChanges                 bool
 	ModelDefaultMaterialize      bool
 	ModelMaterializeDelaySeconds uint32
+	SecretStores                 map[string]secrets.Store
 }
 
 // NewInstanceWithOptions creates a runtime and an instance for use in tests.
 // The instance's repo is a temp directory that will be cleared when the tests finish.
 func NewInstanceWithOptions(t TestingT, opts InstanceOptions) (*runtime.Runtime, string) {
-	rt := New(t)
+	rt := newRuntime(t, opts.SecretStores)
 
 	tmpDir := t.TempDir()
 	inst := &drivers.Instance{
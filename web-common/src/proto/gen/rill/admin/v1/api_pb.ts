@@ -5,6 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
+import { ResourceName } from "../../runtime/v1/resources_pb.js";
 import { ExportFormat } from "../../runtime/v1/export_format_pb.js";
 
 /**
@@ -1281,6 +1282,245 @@ export class GetIFrameResponse extends Message<GetIFrameResponse> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.IssueEmbedTokenRequest
+ */
+export class IssueEmbedTokenRequest extends Message<IssueEmbedTokenRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string branch = 3;
+   */
+  branch = "";
+
+  /**
+   * Resources the token can access.
+   *
+   * @generated from field: repeated rill.runtime.v1.ResourceName resources = 4;
+   */
+  resources: ResourceName[] = [];
+
+  /**
+   * Maps metrics view names to SQL expressions that are applied as filters to all queries against them.
+   * The metrics views must be included in resources.
+   *
+   * @generated from field: map<string, string> metrics_view_filters = 5;
+   */
+  metricsViewFilters: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: uint32 ttl_seconds = 6;
+   */
+  ttlSeconds = 0;
+
+  /**
+   * @generated from oneof rill.admin.v1.IssueEmbedTokenRequest.for
+   */
+  for: {
+    /**
+     * @generated from field: string user_id = 7;
+     */
+    value: string;
+    case: "userId";
+  } | {
+    /**
+     * @generated from field: string user_email = 8;
+     */
+    value: string;
+    case: "userEmail";
+  } | {
+    /**
+     * @generated from field: google.protobuf.Struct attributes = 9;
+     */
+    value: Struct;
+    case: "attributes";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<IssueEmbedTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.IssueEmbedTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "resources", kind: "message", T: ResourceName, repeated: true },
+    { no: 5, name: "metrics_view_filters", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 6, name: "ttl_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "for" },
+    { no: 8, name: "user_email", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "for" },
+    { no: 9, name: "attributes", kind: "message", T: Struct, oneof: "for" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueEmbedTokenRequest {
+    return new IssueEmbedTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueEmbedTokenRequest {
+    return new IssueEmbedTokenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueEmbedTokenRequest {
+    return new IssueEmbedTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IssueEmbedTokenRequest | PlainMessage<IssueEmbedTokenRequest> | undefined, b: IssueEmbedTokenRequest | PlainMessage<IssueEmbedTokenRequest> | undefined): boolean {
+    return proto3.util.equals(IssueEmbedTokenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.IssueEmbedTokenResponse
+ */
+export class IssueEmbedTokenResponse extends Message<IssueEmbedTokenResponse> {
+  /**
+   * @generated from field: string token_id = 1;
+   */
+  tokenId = "";
+
+  /**
+   * @generated from field: string runtime_host = 2;
+   */
+  runtimeHost = "";
+
+  /**
+   * @generated from field: string instance_id = 3;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: string access_token = 4;
+   */
+  accessToken = "";
+
+  /**
+   * @generated from field: uint32 ttl_seconds = 5;
+   */
+  ttlSeconds = 0;
+
+  constructor(data?: PartialMessage<IssueEmbedTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.IssueEmbedTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "runtime_host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "ttl_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueEmbedTokenResponse {
+    return new IssueEmbedTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueEmbedTokenResponse {
+    return new IssueEmbedTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueEmbedTokenResponse {
+    return new IssueEmbedTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IssueEmbedTokenResponse | PlainMessage<IssueEmbedTokenResponse> | undefined, b: IssueEmbedTokenResponse | PlainMessage<IssueEmbedTokenResponse> | undefined): boolean {
+    return proto3.util.equals(IssueEmbedTokenResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RevokeEmbedTokenRequest
+ */
+export class RevokeEmbedTokenRequest extends Message<RevokeEmbedTokenRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string token_id = 3;
+   */
+  tokenId = "";
+
+  constructor(data?: PartialMessage<RevokeEmbedTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RevokeEmbedTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeEmbedTokenRequest {
+    return new RevokeEmbedTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeEmbedTokenRequest {
+    return new RevokeEmbedTokenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeEmbedTokenRequest {
+    return new RevokeEmbedTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeEmbedTokenRequest | PlainMessage<RevokeEmbedTokenRequest> | undefined, b: RevokeEmbedTokenRequest | PlainMessage<RevokeEmbedTokenRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeEmbedTokenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RevokeEmbedTokenResponse
+ */
+export class RevokeEmbedTokenResponse extends Message<RevokeEmbedTokenResponse> {
+  constructor(data?: PartialMessage<RevokeEmbedTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RevokeEmbedTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeEmbedTokenResponse {
+    return new RevokeEmbedTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeEmbedTokenResponse {
+    return new RevokeEmbedTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeEmbedTokenResponse {
+    return new RevokeEmbedTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeEmbedTokenResponse | PlainMessage<RevokeEmbedTokenResponse> | undefined, b: RevokeEmbedTokenResponse | PlainMessage<RevokeEmbedTokenResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeEmbedTokenResponse, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.ListServicesRequest
  */
@@ -2753,152 +2993,318 @@ export class SetOrganizationMemberRoleResponse extends Message<SetOrganizationMe
 }
 
 /**
- * @generated from message rill.admin.v1.ListSuperusersRequest
+ * @generated from message rill.admin.v1.GetUserAttributesRequest
  */
-export class ListSuperusersRequest extends Message<ListSuperusersRequest> {
-  constructor(data?: PartialMessage<ListSuperusersRequest>) {
+export class GetUserAttributesRequest extends Message<GetUserAttributesRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<GetUserAttributesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListSuperusersRequest";
+  static readonly typeName = "rill.admin.v1.GetUserAttributesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSuperusersRequest {
-    return new ListSuperusersRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserAttributesRequest {
+    return new GetUserAttributesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSuperusersRequest {
-    return new ListSuperusersRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserAttributesRequest {
+    return new GetUserAttributesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSuperusersRequest {
-    return new ListSuperusersRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserAttributesRequest {
+    return new GetUserAttributesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSuperusersRequest | PlainMessage<ListSuperusersRequest> | undefined, b: ListSuperusersRequest | PlainMessage<ListSuperusersRequest> | undefined): boolean {
-    return proto3.util.equals(ListSuperusersRequest, a, b);
+  static equals(a: GetUserAttributesRequest | PlainMessage<GetUserAttributesRequest> | undefined, b: GetUserAttributesRequest | PlainMessage<GetUserAttributesRequest> | undefined): boolean {
+    return proto3.util.equals(GetUserAttributesRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListSuperusersResponse
+ * @generated from message rill.admin.v1.GetUserAttributesResponse
  */
-export class ListSuperusersResponse extends Message<ListSuperusersResponse> {
+export class GetUserAttributesResponse extends Message<GetUserAttributesResponse> {
   /**
-   * @generated from field: repeated rill.admin.v1.User users = 1;
+   * @generated from field: google.protobuf.Struct attributes = 1;
    */
-  users: User[] = [];
+  attributes?: Struct;
 
-  constructor(data?: PartialMessage<ListSuperusersResponse>) {
+  constructor(data?: PartialMessage<GetUserAttributesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListSuperusersResponse";
+  static readonly typeName = "rill.admin.v1.GetUserAttributesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "users", kind: "message", T: User, repeated: true },
+    { no: 1, name: "attributes", kind: "message", T: Struct },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSuperusersResponse {
-    return new ListSuperusersResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserAttributesResponse {
+    return new GetUserAttributesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSuperusersResponse {
-    return new ListSuperusersResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserAttributesResponse {
+    return new GetUserAttributesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSuperusersResponse {
-    return new ListSuperusersResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserAttributesResponse {
+    return new GetUserAttributesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSuperusersResponse | PlainMessage<ListSuperusersResponse> | undefined, b: ListSuperusersResponse | PlainMessage<ListSuperusersResponse> | undefined): boolean {
-    return proto3.util.equals(ListSuperusersResponse, a, b);
+  static equals(a: GetUserAttributesResponse | PlainMessage<GetUserAttributesResponse> | undefined, b: GetUserAttributesResponse | PlainMessage<GetUserAttributesResponse> | undefined): boolean {
+    return proto3.util.equals(GetUserAttributesResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SetSuperuserRequest
+ * @generated from message rill.admin.v1.SetUserAttributesRequest
  */
-export class SetSuperuserRequest extends Message<SetSuperuserRequest> {
+export class SetUserAttributesRequest extends Message<SetUserAttributesRequest> {
   /**
-   * @generated from field: string email = 1;
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string email = 2;
    */
   email = "";
 
   /**
-   * @generated from field: bool superuser = 2;
+   * @generated from field: google.protobuf.Struct attributes = 3;
    */
-  superuser = false;
+  attributes?: Struct;
 
-  constructor(data?: PartialMessage<SetSuperuserRequest>) {
+  constructor(data?: PartialMessage<SetUserAttributesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SetSuperuserRequest";
+  static readonly typeName = "rill.admin.v1.SetUserAttributesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "superuser", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "attributes", kind: "message", T: Struct },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSuperuserRequest {
-    return new SetSuperuserRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetUserAttributesRequest {
+    return new SetUserAttributesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSuperuserRequest {
-    return new SetSuperuserRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetUserAttributesRequest {
+    return new SetUserAttributesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSuperuserRequest {
-    return new SetSuperuserRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetUserAttributesRequest {
+    return new SetUserAttributesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetSuperuserRequest | PlainMessage<SetSuperuserRequest> | undefined, b: SetSuperuserRequest | PlainMessage<SetSuperuserRequest> | undefined): boolean {
-    return proto3.util.equals(SetSuperuserRequest, a, b);
+  static equals(a: SetUserAttributesRequest | PlainMessage<SetUserAttributesRequest> | undefined, b: SetUserAttributesRequest | PlainMessage<SetUserAttributesRequest> | undefined): boolean {
+    return proto3.util.equals(SetUserAttributesRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SetSuperuserResponse
+ * @generated from message rill.admin.v1.SetUserAttributesResponse
  */
-export class SetSuperuserResponse extends Message<SetSuperuserResponse> {
-  constructor(data?: PartialMessage<SetSuperuserResponse>) {
+export class SetUserAttributesResponse extends Message<SetUserAttributesResponse> {
+  /**
+   * @generated from field: google.protobuf.Struct attributes = 1;
+   */
+  attributes?: Struct;
+
+  constructor(data?: PartialMessage<SetUserAttributesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SetSuperuserResponse";
+  static readonly typeName = "rill.admin.v1.SetUserAttributesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "attributes", kind: "message", T: Struct },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSuperuserResponse {
-    return new SetSuperuserResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetUserAttributesResponse {
+    return new SetUserAttributesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSuperuserResponse {
-    return new SetSuperuserResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetUserAttributesResponse {
+    return new SetUserAttributesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSuperuserResponse {
-    return new SetSuperuserResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetUserAttributesResponse {
+    return new SetUserAttributesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetSuperuserResponse | PlainMessage<SetSuperuserResponse> | undefined, b: SetSuperuserResponse | PlainMessage<SetSuperuserResponse> | undefined): boolean {
-    return proto3.util.equals(SetSuperuserResponse, a, b);
+  static equals(a: SetUserAttributesResponse | PlainMessage<SetUserAttributesResponse> | undefined, b: SetUserAttributesResponse | PlainMessage<SetUserAttributesResponse> | undefined): boolean {
+    return proto3.util.equals(SetUserAttributesResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SudoGetResourceRequest
+ * @generated from message rill.admin.v1.ListSuperusersRequest
  */
-export class SudoGetResourceRequest extends Message<SudoGetResourceRequest> {
-  /**
+export class ListSuperusersRequest extends Message<ListSuperusersRequest> {
+  constructor(data?: PartialMessage<ListSuperusersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListSuperusersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSuperusersRequest {
+    return new ListSuperusersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSuperusersRequest {
+    return new ListSuperusersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSuperusersRequest {
+    return new ListSuperusersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSuperusersRequest | PlainMessage<ListSuperusersRequest> | undefined, b: ListSuperusersRequest | PlainMessage<ListSuperusersRequest> | undefined): boolean {
+    return proto3.util.equals(ListSuperusersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListSuperusersResponse
+ */
+export class ListSuperusersResponse extends Message<ListSuperusersResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.User users = 1;
+   */
+  users: User[] = [];
+
+  constructor(data?: PartialMessage<ListSuperusersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListSuperusersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "users", kind: "message", T: User, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSuperusersResponse {
+    return new ListSuperusersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSuperusersResponse {
+    return new ListSuperusersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSuperusersResponse {
+    return new ListSuperusersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSuperusersResponse | PlainMessage<ListSuperusersResponse> | undefined, b: ListSuperusersResponse | PlainMessage<ListSuperusersResponse> | undefined): boolean {
+    return proto3.util.equals(ListSuperusersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetSuperuserRequest
+ */
+export class SetSuperuserRequest extends Message<SetSuperuserRequest> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  /**
+   * @generated from field: bool superuser = 2;
+   */
+  superuser = false;
+
+  constructor(data?: PartialMessage<SetSuperuserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetSuperuserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "superuser", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSuperuserRequest {
+    return new SetSuperuserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSuperuserRequest {
+    return new SetSuperuserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSuperuserRequest {
+    return new SetSuperuserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSuperuserRequest | PlainMessage<SetSuperuserRequest> | undefined, b: SetSuperuserRequest | PlainMessage<SetSuperuserRequest> | undefined): boolean {
+    return proto3.util.equals(SetSuperuserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetSuperuserResponse
+ */
+export class SetSuperuserResponse extends Message<SetSuperuserResponse> {
+  constructor(data?: PartialMessage<SetSuperuserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetSuperuserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSuperuserResponse {
+    return new SetSuperuserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSuperuserResponse {
+    return new SetSuperuserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSuperuserResponse {
+    return new SetSuperuserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSuperuserResponse | PlainMessage<SetSuperuserResponse> | undefined, b: SetSuperuserResponse | PlainMessage<SetSuperuserResponse> | undefined): boolean {
+    return proto3.util.equals(SetSuperuserResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SudoGetResourceRequest
+ */
+export class SudoGetResourceRequest extends Message<SudoGetResourceRequest> {
+  /**
    * @generated from oneof rill.admin.v1.SudoGetResourceRequest.id
    */
   id: {
@@ -3675,1234 +4081,2478 @@ export class SetProjectMemberRoleResponse extends Message<SetProjectMemberRoleRe
 }
 
 /**
- * @generated from message rill.admin.v1.GetCurrentUserRequest
+ * @generated from message rill.admin.v1.AddProjectMemberUsergroupRequest
  */
-export class GetCurrentUserRequest extends Message<GetCurrentUserRequest> {
-  constructor(data?: PartialMessage<GetCurrentUserRequest>) {
+export class AddProjectMemberUsergroupRequest extends Message<AddProjectMemberUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string usergroup = 3;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string role = 4;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<AddProjectMemberUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetCurrentUserRequest";
+  static readonly typeName = "rill.admin.v1.AddProjectMemberUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddProjectMemberUsergroupRequest {
+    return new AddProjectMemberUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupRequest {
+    return new AddProjectMemberUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupRequest {
+    return new AddProjectMemberUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined, b: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined): boolean {
-    return proto3.util.equals(GetCurrentUserRequest, a, b);
+  static equals(a: AddProjectMemberUsergroupRequest | PlainMessage<AddProjectMemberUsergroupRequest> | undefined, b: AddProjectMemberUsergroupRequest | PlainMessage<AddProjectMemberUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(AddProjectMemberUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetCurrentUserResponse
+ * @generated from message rill.admin.v1.AddProjectMemberUsergroupResponse
  */
-export class GetCurrentUserResponse extends Message<GetCurrentUserResponse> {
-  /**
-   * @generated from field: rill.admin.v1.User user = 1;
-   */
-  user?: User;
-
-  /**
-   * @generated from field: rill.admin.v1.UserPreferences preferences = 2;
-   */
-  preferences?: UserPreferences;
-
-  constructor(data?: PartialMessage<GetCurrentUserResponse>) {
+export class AddProjectMemberUsergroupResponse extends Message<AddProjectMemberUsergroupResponse> {
+  constructor(data?: PartialMessage<AddProjectMemberUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetCurrentUserResponse";
+  static readonly typeName = "rill.admin.v1.AddProjectMemberUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "user", kind: "message", T: User },
-    { no: 2, name: "preferences", kind: "message", T: UserPreferences },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddProjectMemberUsergroupResponse {
+    return new AddProjectMemberUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupResponse {
+    return new AddProjectMemberUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupResponse {
+    return new AddProjectMemberUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined, b: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined): boolean {
-    return proto3.util.equals(GetCurrentUserResponse, a, b);
+  static equals(a: AddProjectMemberUsergroupResponse | PlainMessage<AddProjectMemberUsergroupResponse> | undefined, b: AddProjectMemberUsergroupResponse | PlainMessage<AddProjectMemberUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(AddProjectMemberUsergroupResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetUserRequest
+ * @generated from message rill.admin.v1.CreateUsergroupRequest
  */
-export class GetUserRequest extends Message<GetUserRequest> {
+export class CreateUsergroupRequest extends Message<CreateUsergroupRequest> {
   /**
-   * @generated from field: string email = 1;
+   * @generated from field: string organization = 1;
    */
-  email = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<GetUserRequest>) {
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<CreateUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetUserRequest";
+  static readonly typeName = "rill.admin.v1.CreateUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetUserRequest | PlainMessage<GetUserRequest> | undefined, b: GetUserRequest | PlainMessage<GetUserRequest> | undefined): boolean {
-    return proto3.util.equals(GetUserRequest, a, b);
+  static equals(a: CreateUsergroupRequest | PlainMessage<CreateUsergroupRequest> | undefined, b: CreateUsergroupRequest | PlainMessage<CreateUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(CreateUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetUserResponse
+ * @generated from message rill.admin.v1.CreateUsergroupResponse
  */
-export class GetUserResponse extends Message<GetUserResponse> {
+export class CreateUsergroupResponse extends Message<CreateUsergroupResponse> {
   /**
-   * @generated from field: rill.admin.v1.User user = 1;
+   * @generated from field: rill.admin.v1.Usergroup usergroup = 1;
    */
-  user?: User;
+  usergroup?: Usergroup;
 
-  constructor(data?: PartialMessage<GetUserResponse>) {
+  constructor(data?: PartialMessage<CreateUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetUserResponse";
+  static readonly typeName = "rill.admin.v1.CreateUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "user", kind: "message", T: User },
+    { no: 1, name: "usergroup", kind: "message", T: Usergroup },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetUserResponse | PlainMessage<GetUserResponse> | undefined, b: GetUserResponse | PlainMessage<GetUserResponse> | undefined): boolean {
-    return proto3.util.equals(GetUserResponse, a, b);
+  static equals(a: CreateUsergroupResponse | PlainMessage<CreateUsergroupResponse> | undefined, b: CreateUsergroupResponse | PlainMessage<CreateUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(CreateUsergroupResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UserPreferences
+ * @generated from message rill.admin.v1.ListUsergroupsRequest
  */
-export class UserPreferences extends Message<UserPreferences> {
+export class ListUsergroupsRequest extends Message<ListUsergroupsRequest> {
   /**
-   * @generated from field: optional string time_zone = 1;
+   * @generated from field: string organization = 1;
    */
-  timeZone?: string;
+  organization = "";
 
-  constructor(data?: PartialMessage<UserPreferences>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
 
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UserPreferences";
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListUsergroupsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UserPreferences {
-    return new UserPreferences().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupsRequest {
+    return new ListUsergroupsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UserPreferences {
-    return new UserPreferences().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupsRequest {
+    return new ListUsergroupsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UserPreferences {
-    return new UserPreferences().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupsRequest {
+    return new ListUsergroupsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UserPreferences | PlainMessage<UserPreferences> | undefined, b: UserPreferences | PlainMessage<UserPreferences> | undefined): boolean {
-    return proto3.util.equals(UserPreferences, a, b);
+  static equals(a: ListUsergroupsRequest | PlainMessage<ListUsergroupsRequest> | undefined, b: ListUsergroupsRequest | PlainMessage<ListUsergroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UpdateUserPreferencesRequest
+ * @generated from message rill.admin.v1.ListUsergroupsResponse
  */
-export class UpdateUserPreferencesRequest extends Message<UpdateUserPreferencesRequest> {
+export class ListUsergroupsResponse extends Message<ListUsergroupsResponse> {
   /**
-   * @generated from field: rill.admin.v1.UserPreferences preferences = 1;
+   * @generated from field: repeated rill.admin.v1.Usergroup usergroups = 1;
    */
-  preferences?: UserPreferences;
+  usergroups: Usergroup[] = [];
 
-  constructor(data?: PartialMessage<UpdateUserPreferencesRequest>) {
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UpdateUserPreferencesRequest";
+  static readonly typeName = "rill.admin.v1.ListUsergroupsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "preferences", kind: "message", T: UserPreferences },
+    { no: 1, name: "usergroups", kind: "message", T: Usergroup, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUserPreferencesRequest {
-    return new UpdateUserPreferencesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupsResponse {
+    return new ListUsergroupsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUserPreferencesRequest {
-    return new UpdateUserPreferencesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupsResponse {
+    return new ListUsergroupsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUserPreferencesRequest {
-    return new UpdateUserPreferencesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupsResponse {
+    return new ListUsergroupsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateUserPreferencesRequest | PlainMessage<UpdateUserPreferencesRequest> | undefined, b: UpdateUserPreferencesRequest | PlainMessage<UpdateUserPreferencesRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateUserPreferencesRequest, a, b);
+  static equals(a: ListUsergroupsResponse | PlainMessage<ListUsergroupsResponse> | undefined, b: ListUsergroupsResponse | PlainMessage<ListUsergroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupsResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UpdateUserPreferencesResponse
+ * @generated from message rill.admin.v1.AddUsergroupMemberRequest
  */
-export class UpdateUserPreferencesResponse extends Message<UpdateUserPreferencesResponse> {
+export class AddUsergroupMemberRequest extends Message<AddUsergroupMemberRequest> {
   /**
-   * @generated from field: rill.admin.v1.UserPreferences preferences = 1;
+   * @generated from field: string organization = 1;
    */
-  preferences?: UserPreferences;
+  organization = "";
 
-  constructor(data?: PartialMessage<UpdateUserPreferencesResponse>) {
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<AddUsergroupMemberRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.UpdateUserPreferencesResponse";
+  static readonly typeName = "rill.admin.v1.AddUsergroupMemberRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "preferences", kind: "message", T: UserPreferences },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUserPreferencesResponse {
-    return new UpdateUserPreferencesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUserPreferencesResponse {
-    return new UpdateUserPreferencesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUserPreferencesResponse {
-    return new UpdateUserPreferencesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateUserPreferencesResponse | PlainMessage<UpdateUserPreferencesResponse> | undefined, b: UpdateUserPreferencesResponse | PlainMessage<UpdateUserPreferencesResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateUserPreferencesResponse, a, b);
+  static equals(a: AddUsergroupMemberRequest | PlainMessage<AddUsergroupMemberRequest> | undefined, b: AddUsergroupMemberRequest | PlainMessage<AddUsergroupMemberRequest> | undefined): boolean {
+    return proto3.util.equals(AddUsergroupMemberRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListBookmarksRequest
+ * @generated from message rill.admin.v1.AddUsergroupMemberResponse
  */
-export class ListBookmarksRequest extends Message<ListBookmarksRequest> {
-  /**
-   * @generated from field: string project_id = 1;
-   */
-  projectId = "";
-
-  constructor(data?: PartialMessage<ListBookmarksRequest>) {
+export class AddUsergroupMemberResponse extends Message<AddUsergroupMemberResponse> {
+  constructor(data?: PartialMessage<AddUsergroupMemberResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListBookmarksRequest";
+  static readonly typeName = "rill.admin.v1.AddUsergroupMemberResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBookmarksRequest {
-    return new ListBookmarksRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBookmarksRequest {
-    return new ListBookmarksRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBookmarksRequest {
-    return new ListBookmarksRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListBookmarksRequest | PlainMessage<ListBookmarksRequest> | undefined, b: ListBookmarksRequest | PlainMessage<ListBookmarksRequest> | undefined): boolean {
-    return proto3.util.equals(ListBookmarksRequest, a, b);
+  static equals(a: AddUsergroupMemberResponse | PlainMessage<AddUsergroupMemberResponse> | undefined, b: AddUsergroupMemberResponse | PlainMessage<AddUsergroupMemberResponse> | undefined): boolean {
+    return proto3.util.equals(AddUsergroupMemberResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListBookmarksResponse
+ * @generated from message rill.admin.v1.RemoveUsergroupMemberRequest
  */
-export class ListBookmarksResponse extends Message<ListBookmarksResponse> {
+export class RemoveUsergroupMemberRequest extends Message<RemoveUsergroupMemberRequest> {
   /**
-   * @generated from field: repeated rill.admin.v1.Bookmark bookmarks = 1;
+   * @generated from field: string organization = 1;
    */
-  bookmarks: Bookmark[] = [];
+  organization = "";
 
-  constructor(data?: PartialMessage<ListBookmarksResponse>) {
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<RemoveUsergroupMemberRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListBookmarksResponse";
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupMemberRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bookmarks", kind: "message", T: Bookmark, repeated: true },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBookmarksResponse {
-    return new ListBookmarksResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBookmarksResponse {
-    return new ListBookmarksResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBookmarksResponse {
-    return new ListBookmarksResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListBookmarksResponse | PlainMessage<ListBookmarksResponse> | undefined, b: ListBookmarksResponse | PlainMessage<ListBookmarksResponse> | undefined): boolean {
-    return proto3.util.equals(ListBookmarksResponse, a, b);
+  static equals(a: RemoveUsergroupMemberRequest | PlainMessage<RemoveUsergroupMemberRequest> | undefined, b: RemoveUsergroupMemberRequest | PlainMessage<RemoveUsergroupMemberRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupMemberRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetBookmarkRequest
+ * @generated from message rill.admin.v1.RemoveUsergroupMemberResponse
  */
-export class GetBookmarkRequest extends Message<GetBookmarkRequest> {
-  /**
-   * @generated from field: string bookmark_id = 1;
-   */
-  bookmarkId = "";
-
-  constructor(data?: PartialMessage<GetBookmarkRequest>) {
+export class RemoveUsergroupMemberResponse extends Message<RemoveUsergroupMemberResponse> {
+  constructor(data?: PartialMessage<RemoveUsergroupMemberResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetBookmarkRequest";
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupMemberResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bookmark_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBookmarkRequest {
-    return new GetBookmarkRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBookmarkRequest {
-    return new GetBookmarkRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBookmarkRequest {
-    return new GetBookmarkRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetBookmarkRequest | PlainMessage<GetBookmarkRequest> | undefined, b: GetBookmarkRequest | PlainMessage<GetBookmarkRequest> | undefined): boolean {
-    return proto3.util.equals(GetBookmarkRequest, a, b);
+  static equals(a: RemoveUsergroupMemberResponse | PlainMessage<RemoveUsergroupMemberResponse> | undefined, b: RemoveUsergroupMemberResponse | PlainMessage<RemoveUsergroupMemberResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupMemberResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetBookmarkResponse
+ * @generated from message rill.admin.v1.ListProjectRolesRequest
  */
-export class GetBookmarkResponse extends Message<GetBookmarkResponse> {
+export class ListProjectRolesRequest extends Message<ListProjectRolesRequest> {
   /**
-   * @generated from field: rill.admin.v1.Bookmark bookmark = 1;
+   * @generated from field: string organization = 1;
    */
-  bookmark?: Bookmark;
+  organization = "";
 
-  constructor(data?: PartialMessage<GetBookmarkResponse>) {
+  constructor(data?: PartialMessage<ListProjectRolesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetBookmarkResponse";
+  static readonly typeName = "rill.admin.v1.ListProjectRolesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bookmark", kind: "message", T: Bookmark },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBookmarkResponse {
-    return new GetBookmarkResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectRolesRequest {
+    return new ListProjectRolesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBookmarkResponse {
-    return new GetBookmarkResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectRolesRequest {
+    return new ListProjectRolesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBookmarkResponse {
-    return new GetBookmarkResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectRolesRequest {
+    return new ListProjectRolesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetBookmarkResponse | PlainMessage<GetBookmarkResponse> | undefined, b: GetBookmarkResponse | PlainMessage<GetBookmarkResponse> | undefined): boolean {
-    return proto3.util.equals(GetBookmarkResponse, a, b);
+  static equals(a: ListProjectRolesRequest | PlainMessage<ListProjectRolesRequest> | undefined, b: ListProjectRolesRequest | PlainMessage<ListProjectRolesRequest> | undefined): boolean {
+    return proto3.util.equals(ListProjectRolesRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateBookmarkRequest
+ * @generated from message rill.admin.v1.ListProjectRolesResponse
  */
-export class CreateBookmarkRequest extends Message<CreateBookmarkRequest> {
+export class ListProjectRolesResponse extends Message<ListProjectRolesResponse> {
   /**
-   * @generated from field: string display_name = 1;
+   * @generated from field: repeated rill.admin.v1.ProjectRole roles = 1;
    */
-  displayName = "";
+  roles: ProjectRole[] = [];
+
+  constructor(data?: PartialMessage<ListProjectRolesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListProjectRolesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "roles", kind: "message", T: ProjectRole, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectRolesResponse {
+    return new ListProjectRolesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectRolesResponse {
+    return new ListProjectRolesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectRolesResponse {
+    return new ListProjectRolesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProjectRolesResponse | PlainMessage<ListProjectRolesResponse> | undefined, b: ListProjectRolesResponse | PlainMessage<ListProjectRolesResponse> | undefined): boolean {
+    return proto3.util.equals(ListProjectRolesResponse, a, b);
+  }
+}
 
+/**
+ * @generated from message rill.admin.v1.CreateProjectRoleRequest
+ */
+export class CreateProjectRoleRequest extends Message<CreateProjectRoleRequest> {
   /**
-   * @generated from field: bytes data = 2;
+   * @generated from field: string organization = 1;
    */
-  data = new Uint8Array(0);
+  organization = "";
 
   /**
-   * @generated from field: string dashboard_name = 3;
+   * @generated from field: string name = 2;
    */
-  dashboardName = "";
+  name = "";
 
   /**
-   * @generated from field: string project_id = 4;
+   * @generated from field: rill.admin.v1.ProjectPermissions permissions = 3;
    */
-  projectId = "";
+  permissions?: ProjectPermissions;
 
-  constructor(data?: PartialMessage<CreateBookmarkRequest>) {
+  constructor(data?: PartialMessage<CreateProjectRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateBookmarkRequest";
+  static readonly typeName = "rill.admin.v1.CreateProjectRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
-    { no: 3, name: "dashboard_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "permissions", kind: "message", T: ProjectPermissions },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBookmarkRequest {
-    return new CreateBookmarkRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectRoleRequest {
+    return new CreateProjectRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBookmarkRequest {
-    return new CreateBookmarkRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectRoleRequest {
+    return new CreateProjectRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBookmarkRequest {
-    return new CreateBookmarkRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectRoleRequest {
+    return new CreateProjectRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateBookmarkRequest | PlainMessage<CreateBookmarkRequest> | undefined, b: CreateBookmarkRequest | PlainMessage<CreateBookmarkRequest> | undefined): boolean {
-    return proto3.util.equals(CreateBookmarkRequest, a, b);
+  static equals(a: CreateProjectRoleRequest | PlainMessage<CreateProjectRoleRequest> | undefined, b: CreateProjectRoleRequest | PlainMessage<CreateProjectRoleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateProjectRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateBookmarkResponse
+ * @generated from message rill.admin.v1.CreateProjectRoleResponse
  */
-export class CreateBookmarkResponse extends Message<CreateBookmarkResponse> {
+export class CreateProjectRoleResponse extends Message<CreateProjectRoleResponse> {
   /**
-   * @generated from field: rill.admin.v1.Bookmark bookmark = 1;
+   * @generated from field: rill.admin.v1.ProjectRole role = 1;
    */
-  bookmark?: Bookmark;
+  role?: ProjectRole;
 
-  constructor(data?: PartialMessage<CreateBookmarkResponse>) {
+  constructor(data?: PartialMessage<CreateProjectRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateBookmarkResponse";
+  static readonly typeName = "rill.admin.v1.CreateProjectRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bookmark", kind: "message", T: Bookmark },
+    { no: 1, name: "role", kind: "message", T: ProjectRole },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBookmarkResponse {
-    return new CreateBookmarkResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectRoleResponse {
+    return new CreateProjectRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBookmarkResponse {
-    return new CreateBookmarkResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectRoleResponse {
+    return new CreateProjectRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBookmarkResponse {
-    return new CreateBookmarkResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectRoleResponse {
+    return new CreateProjectRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateBookmarkResponse | PlainMessage<CreateBookmarkResponse> | undefined, b: CreateBookmarkResponse | PlainMessage<CreateBookmarkResponse> | undefined): boolean {
-    return proto3.util.equals(CreateBookmarkResponse, a, b);
+  static equals(a: CreateProjectRoleResponse | PlainMessage<CreateProjectRoleResponse> | undefined, b: CreateProjectRoleResponse | PlainMessage<CreateProjectRoleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateProjectRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveBookmarkRequest
+ * @generated from message rill.admin.v1.DeleteProjectRoleRequest
  */
-export class RemoveBookmarkRequest extends Message<RemoveBookmarkRequest> {
+export class DeleteProjectRoleRequest extends Message<DeleteProjectRoleRequest> {
   /**
-   * @generated from field: string bookmark_id = 1;
+   * @generated from field: string organization = 1;
    */
-  bookmarkId = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<RemoveBookmarkRequest>) {
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<DeleteProjectRoleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveBookmarkRequest";
+  static readonly typeName = "rill.admin.v1.DeleteProjectRoleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bookmark_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveBookmarkRequest {
-    return new RemoveBookmarkRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteProjectRoleRequest {
+    return new DeleteProjectRoleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveBookmarkRequest {
-    return new RemoveBookmarkRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteProjectRoleRequest {
+    return new DeleteProjectRoleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveBookmarkRequest {
-    return new RemoveBookmarkRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteProjectRoleRequest {
+    return new DeleteProjectRoleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveBookmarkRequest | PlainMessage<RemoveBookmarkRequest> | undefined, b: RemoveBookmarkRequest | PlainMessage<RemoveBookmarkRequest> | undefined): boolean {
-    return proto3.util.equals(RemoveBookmarkRequest, a, b);
+  static equals(a: DeleteProjectRoleRequest | PlainMessage<DeleteProjectRoleRequest> | undefined, b: DeleteProjectRoleRequest | PlainMessage<DeleteProjectRoleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteProjectRoleRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveBookmarkResponse
+ * @generated from message rill.admin.v1.DeleteProjectRoleResponse
  */
-export class RemoveBookmarkResponse extends Message<RemoveBookmarkResponse> {
-  constructor(data?: PartialMessage<RemoveBookmarkResponse>) {
+export class DeleteProjectRoleResponse extends Message<DeleteProjectRoleResponse> {
+  constructor(data?: PartialMessage<DeleteProjectRoleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveBookmarkResponse";
+  static readonly typeName = "rill.admin.v1.DeleteProjectRoleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveBookmarkResponse {
-    return new RemoveBookmarkResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteProjectRoleResponse {
+    return new DeleteProjectRoleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveBookmarkResponse {
-    return new RemoveBookmarkResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteProjectRoleResponse {
+    return new DeleteProjectRoleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveBookmarkResponse {
-    return new RemoveBookmarkResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteProjectRoleResponse {
+    return new DeleteProjectRoleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveBookmarkResponse | PlainMessage<RemoveBookmarkResponse> | undefined, b: RemoveBookmarkResponse | PlainMessage<RemoveBookmarkResponse> | undefined): boolean {
-    return proto3.util.equals(RemoveBookmarkResponse, a, b);
+  static equals(a: DeleteProjectRoleResponse | PlainMessage<DeleteProjectRoleResponse> | undefined, b: DeleteProjectRoleResponse | PlainMessage<DeleteProjectRoleResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteProjectRoleResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SearchUsersRequest
+ * @generated from message rill.admin.v1.ListAuditEventsRequest
  */
-export class SearchUsersRequest extends Message<SearchUsersRequest> {
+export class ListAuditEventsRequest extends Message<ListAuditEventsRequest> {
   /**
-   * @generated from field: string email_pattern = 1;
+   * @generated from field: string organization = 1;
    */
-  emailPattern = "";
+  organization = "";
 
   /**
-   * @generated from field: uint32 page_size = 2;
+   * Optional filter on the project name
+   *
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * Optional filter on the actor's email (for users) or name (for services)
+   *
+   * @generated from field: string actor = 3;
+   */
+  actor = "";
+
+  /**
+   * Optional filter on the action. Also matches actions it's a prefix of, e.g. "project" matches "project.update".
+   *
+   * @generated from field: string action = 4;
+   */
+  action = "";
+
+  /**
+   * Optional filter that only returns events created at or after this time
+   *
+   * @generated from field: google.protobuf.Timestamp since = 5;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: uint32 page_size = 6;
    */
   pageSize = 0;
 
   /**
-   * @generated from field: string page_token = 3;
+   * @generated from field: string page_token = 7;
    */
   pageToken = "";
 
-  constructor(data?: PartialMessage<SearchUsersRequest>) {
+  constructor(data?: PartialMessage<ListAuditEventsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SearchUsersRequest";
+  static readonly typeName = "rill.admin.v1.ListAuditEventsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email_pattern", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "actor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "since", kind: "message", T: Timestamp },
+    { no: 6, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchUsersRequest {
-    return new SearchUsersRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchUsersRequest {
-    return new SearchUsersRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchUsersRequest {
-    return new SearchUsersRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SearchUsersRequest | PlainMessage<SearchUsersRequest> | undefined, b: SearchUsersRequest | PlainMessage<SearchUsersRequest> | undefined): boolean {
-    return proto3.util.equals(SearchUsersRequest, a, b);
+  static equals(a: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined, b: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.SearchUsersResponse
+ * @generated from message rill.admin.v1.ListAuditEventsResponse
  */
-export class SearchUsersResponse extends Message<SearchUsersResponse> {
+export class ListAuditEventsResponse extends Message<ListAuditEventsResponse> {
   /**
-   * @generated from field: repeated rill.admin.v1.User users = 1;
+   * @generated from field: repeated rill.admin.v1.AuditEvent events = 1;
    */
-  users: User[] = [];
+  events: AuditEvent[] = [];
 
   /**
    * @generated from field: string next_page_token = 2;
    */
   nextPageToken = "";
 
-  constructor(data?: PartialMessage<SearchUsersResponse>) {
+  constructor(data?: PartialMessage<ListAuditEventsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.SearchUsersResponse";
+  static readonly typeName = "rill.admin.v1.ListAuditEventsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "users", kind: "message", T: User, repeated: true },
+    { no: 1, name: "events", kind: "message", T: AuditEvent, repeated: true },
     { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchUsersResponse {
-    return new SearchUsersResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchUsersResponse {
-    return new SearchUsersResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchUsersResponse {
-    return new SearchUsersResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SearchUsersResponse | PlainMessage<SearchUsersResponse> | undefined, b: SearchUsersResponse | PlainMessage<SearchUsersResponse> | undefined): boolean {
-    return proto3.util.equals(SearchUsersResponse, a, b);
+  static equals(a: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined, b: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RevokeCurrentAuthTokenRequest
+ * @generated from message rill.admin.v1.GetCurrentUserRequest
  */
-export class RevokeCurrentAuthTokenRequest extends Message<RevokeCurrentAuthTokenRequest> {
-  constructor(data?: PartialMessage<RevokeCurrentAuthTokenRequest>) {
+export class GetCurrentUserRequest extends Message<GetCurrentUserRequest> {
+  constructor(data?: PartialMessage<GetCurrentUserRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RevokeCurrentAuthTokenRequest";
+  static readonly typeName = "rill.admin.v1.GetCurrentUserRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeCurrentAuthTokenRequest {
-    return new RevokeCurrentAuthTokenRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenRequest {
-    return new RevokeCurrentAuthTokenRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenRequest {
-    return new RevokeCurrentAuthTokenRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RevokeCurrentAuthTokenRequest | PlainMessage<RevokeCurrentAuthTokenRequest> | undefined, b: RevokeCurrentAuthTokenRequest | PlainMessage<RevokeCurrentAuthTokenRequest> | undefined): boolean {
-    return proto3.util.equals(RevokeCurrentAuthTokenRequest, a, b);
+  static equals(a: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined, b: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined): boolean {
+    return proto3.util.equals(GetCurrentUserRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RevokeCurrentAuthTokenResponse
+ * @generated from message rill.admin.v1.GetCurrentUserResponse
  */
-export class RevokeCurrentAuthTokenResponse extends Message<RevokeCurrentAuthTokenResponse> {
+export class GetCurrentUserResponse extends Message<GetCurrentUserResponse> {
   /**
-   * @generated from field: string token_id = 1;
+   * @generated from field: rill.admin.v1.User user = 1;
    */
-  tokenId = "";
+  user?: User;
 
-  constructor(data?: PartialMessage<RevokeCurrentAuthTokenResponse>) {
+  /**
+   * @generated from field: rill.admin.v1.UserPreferences preferences = 2;
+   */
+  preferences?: UserPreferences;
+
+  constructor(data?: PartialMessage<GetCurrentUserResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RevokeCurrentAuthTokenResponse";
+  static readonly typeName = "rill.admin.v1.GetCurrentUserResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "user", kind: "message", T: User },
+    { no: 2, name: "preferences", kind: "message", T: UserPreferences },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeCurrentAuthTokenResponse {
-    return new RevokeCurrentAuthTokenResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenResponse {
-    return new RevokeCurrentAuthTokenResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenResponse {
-    return new RevokeCurrentAuthTokenResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RevokeCurrentAuthTokenResponse | PlainMessage<RevokeCurrentAuthTokenResponse> | undefined, b: RevokeCurrentAuthTokenResponse | PlainMessage<RevokeCurrentAuthTokenResponse> | undefined): boolean {
-    return proto3.util.equals(RevokeCurrentAuthTokenResponse, a, b);
+  static equals(a: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined, b: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined): boolean {
+    return proto3.util.equals(GetCurrentUserResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.IssueRepresentativeAuthTokenRequest
+ * @generated from message rill.admin.v1.GetUserRequest
  */
-export class IssueRepresentativeAuthTokenRequest extends Message<IssueRepresentativeAuthTokenRequest> {
+export class GetUserRequest extends Message<GetUserRequest> {
   /**
    * @generated from field: string email = 1;
    */
   email = "";
 
-  /**
-   * @generated from field: int64 ttl_minutes = 2;
-   */
-  ttlMinutes = protoInt64.zero;
-
-  constructor(data?: PartialMessage<IssueRepresentativeAuthTokenRequest>) {
+  constructor(data?: PartialMessage<GetUserRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.IssueRepresentativeAuthTokenRequest";
+  static readonly typeName = "rill.admin.v1.GetUserRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "ttl_minutes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueRepresentativeAuthTokenRequest {
-    return new IssueRepresentativeAuthTokenRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenRequest {
-    return new IssueRepresentativeAuthTokenRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenRequest {
-    return new IssueRepresentativeAuthTokenRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: IssueRepresentativeAuthTokenRequest | PlainMessage<IssueRepresentativeAuthTokenRequest> | undefined, b: IssueRepresentativeAuthTokenRequest | PlainMessage<IssueRepresentativeAuthTokenRequest> | undefined): boolean {
-    return proto3.util.equals(IssueRepresentativeAuthTokenRequest, a, b);
+  static equals(a: GetUserRequest | PlainMessage<GetUserRequest> | undefined, b: GetUserRequest | PlainMessage<GetUserRequest> | undefined): boolean {
+    return proto3.util.equals(GetUserRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.IssueRepresentativeAuthTokenResponse
+ * @generated from message rill.admin.v1.GetUserResponse
  */
-export class IssueRepresentativeAuthTokenResponse extends Message<IssueRepresentativeAuthTokenResponse> {
+export class GetUserResponse extends Message<GetUserResponse> {
   /**
-   * @generated from field: string token = 1;
+   * @generated from field: rill.admin.v1.User user = 1;
    */
-  token = "";
+  user?: User;
 
-  constructor(data?: PartialMessage<IssueRepresentativeAuthTokenResponse>) {
+  constructor(data?: PartialMessage<GetUserResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.IssueRepresentativeAuthTokenResponse";
+  static readonly typeName = "rill.admin.v1.GetUserResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "user", kind: "message", T: User },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueRepresentativeAuthTokenResponse {
-    return new IssueRepresentativeAuthTokenResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenResponse {
-    return new IssueRepresentativeAuthTokenResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenResponse {
-    return new IssueRepresentativeAuthTokenResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: IssueRepresentativeAuthTokenResponse | PlainMessage<IssueRepresentativeAuthTokenResponse> | undefined, b: IssueRepresentativeAuthTokenResponse | PlainMessage<IssueRepresentativeAuthTokenResponse> | undefined): boolean {
-    return proto3.util.equals(IssueRepresentativeAuthTokenResponse, a, b);
+  static equals(a: GetUserResponse | PlainMessage<GetUserResponse> | undefined, b: GetUserResponse | PlainMessage<GetUserResponse> | undefined): boolean {
+    return proto3.util.equals(GetUserResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RevokeServiceAuthTokenRequest
+ * @generated from message rill.admin.v1.UserPreferences
  */
-export class RevokeServiceAuthTokenRequest extends Message<RevokeServiceAuthTokenRequest> {
+export class UserPreferences extends Message<UserPreferences> {
   /**
-   * @generated from field: string token_id = 1;
+   * @generated from field: optional string time_zone = 1;
    */
-  tokenId = "";
+  timeZone?: string;
 
-  constructor(data?: PartialMessage<RevokeServiceAuthTokenRequest>) {
+  constructor(data?: PartialMessage<UserPreferences>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RevokeServiceAuthTokenRequest";
+  static readonly typeName = "rill.admin.v1.UserPreferences";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeServiceAuthTokenRequest {
-    return new RevokeServiceAuthTokenRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UserPreferences {
+    return new UserPreferences().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenRequest {
-    return new RevokeServiceAuthTokenRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UserPreferences {
+    return new UserPreferences().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenRequest {
-    return new RevokeServiceAuthTokenRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UserPreferences {
+    return new UserPreferences().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RevokeServiceAuthTokenRequest | PlainMessage<RevokeServiceAuthTokenRequest> | undefined, b: RevokeServiceAuthTokenRequest | PlainMessage<RevokeServiceAuthTokenRequest> | undefined): boolean {
-    return proto3.util.equals(RevokeServiceAuthTokenRequest, a, b);
+  static equals(a: UserPreferences | PlainMessage<UserPreferences> | undefined, b: UserPreferences | PlainMessage<UserPreferences> | undefined): boolean {
+    return proto3.util.equals(UserPreferences, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RevokeServiceAuthTokenResponse
+ * @generated from message rill.admin.v1.UpdateUserPreferencesRequest
  */
-export class RevokeServiceAuthTokenResponse extends Message<RevokeServiceAuthTokenResponse> {
-  constructor(data?: PartialMessage<RevokeServiceAuthTokenResponse>) {
+export class UpdateUserPreferencesRequest extends Message<UpdateUserPreferencesRequest> {
+  /**
+   * @generated from field: rill.admin.v1.UserPreferences preferences = 1;
+   */
+  preferences?: UserPreferences;
+
+  constructor(data?: PartialMessage<UpdateUserPreferencesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RevokeServiceAuthTokenResponse";
+  static readonly typeName = "rill.admin.v1.UpdateUserPreferencesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preferences", kind: "message", T: UserPreferences },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeServiceAuthTokenResponse {
-    return new RevokeServiceAuthTokenResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUserPreferencesRequest {
+    return new UpdateUserPreferencesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenResponse {
-    return new RevokeServiceAuthTokenResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUserPreferencesRequest {
+    return new UpdateUserPreferencesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenResponse {
-    return new RevokeServiceAuthTokenResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUserPreferencesRequest {
+    return new UpdateUserPreferencesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RevokeServiceAuthTokenResponse | PlainMessage<RevokeServiceAuthTokenResponse> | undefined, b: RevokeServiceAuthTokenResponse | PlainMessage<RevokeServiceAuthTokenResponse> | undefined): boolean {
-    return proto3.util.equals(RevokeServiceAuthTokenResponse, a, b);
+  static equals(a: UpdateUserPreferencesRequest | PlainMessage<UpdateUserPreferencesRequest> | undefined, b: UpdateUserPreferencesRequest | PlainMessage<UpdateUserPreferencesRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateUserPreferencesRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.IssueServiceAuthTokenRequest
+ * @generated from message rill.admin.v1.UpdateUserPreferencesResponse
  */
-export class IssueServiceAuthTokenRequest extends Message<IssueServiceAuthTokenRequest> {
-  /**
-   * @generated from field: string organization_name = 1;
-   */
-  organizationName = "";
-
+export class UpdateUserPreferencesResponse extends Message<UpdateUserPreferencesResponse> {
   /**
-   * @generated from field: string service_name = 2;
+   * @generated from field: rill.admin.v1.UserPreferences preferences = 1;
    */
-  serviceName = "";
+  preferences?: UserPreferences;
 
-  constructor(data?: PartialMessage<IssueServiceAuthTokenRequest>) {
+  constructor(data?: PartialMessage<UpdateUserPreferencesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.IssueServiceAuthTokenRequest";
+  static readonly typeName = "rill.admin.v1.UpdateUserPreferencesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "preferences", kind: "message", T: UserPreferences },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueServiceAuthTokenRequest {
-    return new IssueServiceAuthTokenRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUserPreferencesResponse {
+    return new UpdateUserPreferencesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenRequest {
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUserPreferencesResponse {
+    return new UpdateUserPreferencesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUserPreferencesResponse {
+    return new UpdateUserPreferencesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateUserPreferencesResponse | PlainMessage<UpdateUserPreferencesResponse> | undefined, b: UpdateUserPreferencesResponse | PlainMessage<UpdateUserPreferencesResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateUserPreferencesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListBookmarksRequest
+ */
+export class ListBookmarksRequest extends Message<ListBookmarksRequest> {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId = "";
+
+  constructor(data?: PartialMessage<ListBookmarksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListBookmarksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBookmarksRequest {
+    return new ListBookmarksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBookmarksRequest {
+    return new ListBookmarksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBookmarksRequest {
+    return new ListBookmarksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListBookmarksRequest | PlainMessage<ListBookmarksRequest> | undefined, b: ListBookmarksRequest | PlainMessage<ListBookmarksRequest> | undefined): boolean {
+    return proto3.util.equals(ListBookmarksRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListBookmarksResponse
+ */
+export class ListBookmarksResponse extends Message<ListBookmarksResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.Bookmark bookmarks = 1;
+   */
+  bookmarks: Bookmark[] = [];
+
+  constructor(data?: PartialMessage<ListBookmarksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListBookmarksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bookmarks", kind: "message", T: Bookmark, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBookmarksResponse {
+    return new ListBookmarksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBookmarksResponse {
+    return new ListBookmarksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBookmarksResponse {
+    return new ListBookmarksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListBookmarksResponse | PlainMessage<ListBookmarksResponse> | undefined, b: ListBookmarksResponse | PlainMessage<ListBookmarksResponse> | undefined): boolean {
+    return proto3.util.equals(ListBookmarksResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetBookmarkRequest
+ */
+export class GetBookmarkRequest extends Message<GetBookmarkRequest> {
+  /**
+   * @generated from field: string bookmark_id = 1;
+   */
+  bookmarkId = "";
+
+  constructor(data?: PartialMessage<GetBookmarkRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetBookmarkRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bookmark_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBookmarkRequest {
+    return new GetBookmarkRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBookmarkRequest {
+    return new GetBookmarkRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBookmarkRequest {
+    return new GetBookmarkRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBookmarkRequest | PlainMessage<GetBookmarkRequest> | undefined, b: GetBookmarkRequest | PlainMessage<GetBookmarkRequest> | undefined): boolean {
+    return proto3.util.equals(GetBookmarkRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetBookmarkResponse
+ */
+export class GetBookmarkResponse extends Message<GetBookmarkResponse> {
+  /**
+   * @generated from field: rill.admin.v1.Bookmark bookmark = 1;
+   */
+  bookmark?: Bookmark;
+
+  constructor(data?: PartialMessage<GetBookmarkResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetBookmarkResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bookmark", kind: "message", T: Bookmark },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBookmarkResponse {
+    return new GetBookmarkResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBookmarkResponse {
+    return new GetBookmarkResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBookmarkResponse {
+    return new GetBookmarkResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBookmarkResponse | PlainMessage<GetBookmarkResponse> | undefined, b: GetBookmarkResponse | PlainMessage<GetBookmarkResponse> | undefined): boolean {
+    return proto3.util.equals(GetBookmarkResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateBookmarkRequest
+ */
+export class CreateBookmarkRequest extends Message<CreateBookmarkRequest> {
+  /**
+   * @generated from field: string display_name = 1;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: bytes data = 2;
+   */
+  data = new Uint8Array(0);
+
+  /**
+   * @generated from field: string dashboard_name = 3;
+   */
+  dashboardName = "";
+
+  /**
+   * @generated from field: string project_id = 4;
+   */
+  projectId = "";
+
+  constructor(data?: PartialMessage<CreateBookmarkRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateBookmarkRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "dashboard_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBookmarkRequest {
+    return new CreateBookmarkRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBookmarkRequest {
+    return new CreateBookmarkRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBookmarkRequest {
+    return new CreateBookmarkRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateBookmarkRequest | PlainMessage<CreateBookmarkRequest> | undefined, b: CreateBookmarkRequest | PlainMessage<CreateBookmarkRequest> | undefined): boolean {
+    return proto3.util.equals(CreateBookmarkRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateBookmarkResponse
+ */
+export class CreateBookmarkResponse extends Message<CreateBookmarkResponse> {
+  /**
+   * @generated from field: rill.admin.v1.Bookmark bookmark = 1;
+   */
+  bookmark?: Bookmark;
+
+  constructor(data?: PartialMessage<CreateBookmarkResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateBookmarkResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bookmark", kind: "message", T: Bookmark },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBookmarkResponse {
+    return new CreateBookmarkResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBookmarkResponse {
+    return new CreateBookmarkResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBookmarkResponse {
+    return new CreateBookmarkResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateBookmarkResponse | PlainMessage<CreateBookmarkResponse> | undefined, b: CreateBookmarkResponse | PlainMessage<CreateBookmarkResponse> | undefined): boolean {
+    return proto3.util.equals(CreateBookmarkResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveBookmarkRequest
+ */
+export class RemoveBookmarkRequest extends Message<RemoveBookmarkRequest> {
+  /**
+   * @generated from field: string bookmark_id = 1;
+   */
+  bookmarkId = "";
+
+  constructor(data?: PartialMessage<RemoveBookmarkRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveBookmarkRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bookmark_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveBookmarkRequest {
+    return new RemoveBookmarkRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveBookmarkRequest {
+    return new RemoveBookmarkRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveBookmarkRequest {
+    return new RemoveBookmarkRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveBookmarkRequest | PlainMessage<RemoveBookmarkRequest> | undefined, b: RemoveBookmarkRequest | PlainMessage<RemoveBookmarkRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveBookmarkRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveBookmarkResponse
+ */
+export class RemoveBookmarkResponse extends Message<RemoveBookmarkResponse> {
+  constructor(data?: PartialMessage<RemoveBookmarkResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveBookmarkResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveBookmarkResponse {
+    return new RemoveBookmarkResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveBookmarkResponse {
+    return new RemoveBookmarkResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveBookmarkResponse {
+    return new RemoveBookmarkResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveBookmarkResponse | PlainMessage<RemoveBookmarkResponse> | undefined, b: RemoveBookmarkResponse | PlainMessage<RemoveBookmarkResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveBookmarkResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SearchUsersRequest
+ */
+export class SearchUsersRequest extends Message<SearchUsersRequest> {
+  /**
+   * @generated from field: string email_pattern = 1;
+   */
+  emailPattern = "";
+
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<SearchUsersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SearchUsersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email_pattern", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchUsersRequest {
+    return new SearchUsersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchUsersRequest {
+    return new SearchUsersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchUsersRequest {
+    return new SearchUsersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchUsersRequest | PlainMessage<SearchUsersRequest> | undefined, b: SearchUsersRequest | PlainMessage<SearchUsersRequest> | undefined): boolean {
+    return proto3.util.equals(SearchUsersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SearchUsersResponse
+ */
+export class SearchUsersResponse extends Message<SearchUsersResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.User users = 1;
+   */
+  users: User[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<SearchUsersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SearchUsersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "users", kind: "message", T: User, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchUsersResponse {
+    return new SearchUsersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchUsersResponse {
+    return new SearchUsersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchUsersResponse {
+    return new SearchUsersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchUsersResponse | PlainMessage<SearchUsersResponse> | undefined, b: SearchUsersResponse | PlainMessage<SearchUsersResponse> | undefined): boolean {
+    return proto3.util.equals(SearchUsersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RevokeCurrentAuthTokenRequest
+ */
+export class RevokeCurrentAuthTokenRequest extends Message<RevokeCurrentAuthTokenRequest> {
+  constructor(data?: PartialMessage<RevokeCurrentAuthTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RevokeCurrentAuthTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeCurrentAuthTokenRequest {
+    return new RevokeCurrentAuthTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenRequest {
+    return new RevokeCurrentAuthTokenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenRequest {
+    return new RevokeCurrentAuthTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeCurrentAuthTokenRequest | PlainMessage<RevokeCurrentAuthTokenRequest> | undefined, b: RevokeCurrentAuthTokenRequest | PlainMessage<RevokeCurrentAuthTokenRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeCurrentAuthTokenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RevokeCurrentAuthTokenResponse
+ */
+export class RevokeCurrentAuthTokenResponse extends Message<RevokeCurrentAuthTokenResponse> {
+  /**
+   * @generated from field: string token_id = 1;
+   */
+  tokenId = "";
+
+  constructor(data?: PartialMessage<RevokeCurrentAuthTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RevokeCurrentAuthTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeCurrentAuthTokenResponse {
+    return new RevokeCurrentAuthTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenResponse {
+    return new RevokeCurrentAuthTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeCurrentAuthTokenResponse {
+    return new RevokeCurrentAuthTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeCurrentAuthTokenResponse | PlainMessage<RevokeCurrentAuthTokenResponse> | undefined, b: RevokeCurrentAuthTokenResponse | PlainMessage<RevokeCurrentAuthTokenResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeCurrentAuthTokenResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.IssueRepresentativeAuthTokenRequest
+ */
+export class IssueRepresentativeAuthTokenRequest extends Message<IssueRepresentativeAuthTokenRequest> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  /**
+   * @generated from field: int64 ttl_minutes = 2;
+   */
+  ttlMinutes = protoInt64.zero;
+
+  constructor(data?: PartialMessage<IssueRepresentativeAuthTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.IssueRepresentativeAuthTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ttl_minutes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueRepresentativeAuthTokenRequest {
+    return new IssueRepresentativeAuthTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenRequest {
+    return new IssueRepresentativeAuthTokenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenRequest {
+    return new IssueRepresentativeAuthTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IssueRepresentativeAuthTokenRequest | PlainMessage<IssueRepresentativeAuthTokenRequest> | undefined, b: IssueRepresentativeAuthTokenRequest | PlainMessage<IssueRepresentativeAuthTokenRequest> | undefined): boolean {
+    return proto3.util.equals(IssueRepresentativeAuthTokenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.IssueRepresentativeAuthTokenResponse
+ */
+export class IssueRepresentativeAuthTokenResponse extends Message<IssueRepresentativeAuthTokenResponse> {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<IssueRepresentativeAuthTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.IssueRepresentativeAuthTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueRepresentativeAuthTokenResponse {
+    return new IssueRepresentativeAuthTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenResponse {
+    return new IssueRepresentativeAuthTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueRepresentativeAuthTokenResponse {
+    return new IssueRepresentativeAuthTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IssueRepresentativeAuthTokenResponse | PlainMessage<IssueRepresentativeAuthTokenResponse> | undefined, b: IssueRepresentativeAuthTokenResponse | PlainMessage<IssueRepresentativeAuthTokenResponse> | undefined): boolean {
+    return proto3.util.equals(IssueRepresentativeAuthTokenResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RevokeServiceAuthTokenRequest
+ */
+export class RevokeServiceAuthTokenRequest extends Message<RevokeServiceAuthTokenRequest> {
+  /**
+   * @generated from field: string token_id = 1;
+   */
+  tokenId = "";
+
+  constructor(data?: PartialMessage<RevokeServiceAuthTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RevokeServiceAuthTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeServiceAuthTokenRequest {
+    return new RevokeServiceAuthTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenRequest {
+    return new RevokeServiceAuthTokenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenRequest {
+    return new RevokeServiceAuthTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeServiceAuthTokenRequest | PlainMessage<RevokeServiceAuthTokenRequest> | undefined, b: RevokeServiceAuthTokenRequest | PlainMessage<RevokeServiceAuthTokenRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeServiceAuthTokenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RevokeServiceAuthTokenResponse
+ */
+export class RevokeServiceAuthTokenResponse extends Message<RevokeServiceAuthTokenResponse> {
+  constructor(data?: PartialMessage<RevokeServiceAuthTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RevokeServiceAuthTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeServiceAuthTokenResponse {
+    return new RevokeServiceAuthTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenResponse {
+    return new RevokeServiceAuthTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeServiceAuthTokenResponse {
+    return new RevokeServiceAuthTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeServiceAuthTokenResponse | PlainMessage<RevokeServiceAuthTokenResponse> | undefined, b: RevokeServiceAuthTokenResponse | PlainMessage<RevokeServiceAuthTokenResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeServiceAuthTokenResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.IssueServiceAuthTokenRequest
+ */
+export class IssueServiceAuthTokenRequest extends Message<IssueServiceAuthTokenRequest> {
+  /**
+   * @generated from field: string organization_name = 1;
+   */
+  organizationName = "";
+
+  /**
+   * @generated from field: string service_name = 2;
+   */
+  serviceName = "";
+
+  constructor(data?: PartialMessage<IssueServiceAuthTokenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.IssueServiceAuthTokenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueServiceAuthTokenRequest {
+    return new IssueServiceAuthTokenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenRequest {
     return new IssueServiceAuthTokenRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenRequest {
-    return new IssueServiceAuthTokenRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenRequest {
+    return new IssueServiceAuthTokenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IssueServiceAuthTokenRequest | PlainMessage<IssueServiceAuthTokenRequest> | undefined, b: IssueServiceAuthTokenRequest | PlainMessage<IssueServiceAuthTokenRequest> | undefined): boolean {
+    return proto3.util.equals(IssueServiceAuthTokenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.IssueServiceAuthTokenResponse
+ */
+export class IssueServiceAuthTokenResponse extends Message<IssueServiceAuthTokenResponse> {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<IssueServiceAuthTokenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.IssueServiceAuthTokenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueServiceAuthTokenResponse {
+    return new IssueServiceAuthTokenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenResponse {
+    return new IssueServiceAuthTokenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenResponse {
+    return new IssueServiceAuthTokenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IssueServiceAuthTokenResponse | PlainMessage<IssueServiceAuthTokenResponse> | undefined, b: IssueServiceAuthTokenResponse | PlainMessage<IssueServiceAuthTokenResponse> | undefined): boolean {
+    return proto3.util.equals(IssueServiceAuthTokenResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListServiceAuthTokensRequest
+ */
+export class ListServiceAuthTokensRequest extends Message<ListServiceAuthTokensRequest> {
+  /**
+   * @generated from field: string organization_name = 1;
+   */
+  organizationName = "";
+
+  /**
+   * @generated from field: string service_name = 2;
+   */
+  serviceName = "";
+
+  constructor(data?: PartialMessage<ListServiceAuthTokensRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListServiceAuthTokensRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListServiceAuthTokensRequest {
+    return new ListServiceAuthTokensRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListServiceAuthTokensRequest {
+    return new ListServiceAuthTokensRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListServiceAuthTokensRequest {
+    return new ListServiceAuthTokensRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListServiceAuthTokensRequest | PlainMessage<ListServiceAuthTokensRequest> | undefined, b: ListServiceAuthTokensRequest | PlainMessage<ListServiceAuthTokensRequest> | undefined): boolean {
+    return proto3.util.equals(ListServiceAuthTokensRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListServiceAuthTokensResponse
+ */
+export class ListServiceAuthTokensResponse extends Message<ListServiceAuthTokensResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.ServiceToken tokens = 1;
+   */
+  tokens: ServiceToken[] = [];
+
+  constructor(data?: PartialMessage<ListServiceAuthTokensResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListServiceAuthTokensResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tokens", kind: "message", T: ServiceToken, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListServiceAuthTokensResponse {
+    return new ListServiceAuthTokensResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListServiceAuthTokensResponse {
+    return new ListServiceAuthTokensResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListServiceAuthTokensResponse {
+    return new ListServiceAuthTokensResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListServiceAuthTokensResponse | PlainMessage<ListServiceAuthTokensResponse> | undefined, b: ListServiceAuthTokensResponse | PlainMessage<ListServiceAuthTokensResponse> | undefined): boolean {
+    return proto3.util.equals(ListServiceAuthTokensResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetGithubRepoStatusRequest
+ */
+export class GetGithubRepoStatusRequest extends Message<GetGithubRepoStatusRequest> {
+  /**
+   * @generated from field: string github_url = 1;
+   */
+  githubUrl = "";
+
+  constructor(data?: PartialMessage<GetGithubRepoStatusRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetGithubRepoStatusRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "github_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubRepoStatusRequest {
+    return new GetGithubRepoStatusRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubRepoStatusRequest {
+    return new GetGithubRepoStatusRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubRepoStatusRequest {
+    return new GetGithubRepoStatusRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetGithubRepoStatusRequest | PlainMessage<GetGithubRepoStatusRequest> | undefined, b: GetGithubRepoStatusRequest | PlainMessage<GetGithubRepoStatusRequest> | undefined): boolean {
+    return proto3.util.equals(GetGithubRepoStatusRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetGithubRepoStatusResponse
+ */
+export class GetGithubRepoStatusResponse extends Message<GetGithubRepoStatusResponse> {
+  /**
+   * @generated from field: bool has_access = 1;
+   */
+  hasAccess = false;
+
+  /**
+   * @generated from field: string grant_access_url = 2;
+   */
+  grantAccessUrl = "";
+
+  /**
+   * @generated from field: string default_branch = 3;
+   */
+  defaultBranch = "";
+
+  constructor(data?: PartialMessage<GetGithubRepoStatusResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetGithubRepoStatusResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "has_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "grant_access_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "default_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubRepoStatusResponse {
+    return new GetGithubRepoStatusResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubRepoStatusResponse {
+    return new GetGithubRepoStatusResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubRepoStatusResponse {
+    return new GetGithubRepoStatusResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetGithubRepoStatusResponse | PlainMessage<GetGithubRepoStatusResponse> | undefined, b: GetGithubRepoStatusResponse | PlainMessage<GetGithubRepoStatusResponse> | undefined): boolean {
+    return proto3.util.equals(GetGithubRepoStatusResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetGitCredentialsRequest
+ */
+export class GetGitCredentialsRequest extends Message<GetGitCredentialsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  constructor(data?: PartialMessage<GetGitCredentialsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetGitCredentialsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGitCredentialsRequest {
+    return new GetGitCredentialsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGitCredentialsRequest {
+    return new GetGitCredentialsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGitCredentialsRequest {
+    return new GetGitCredentialsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetGitCredentialsRequest | PlainMessage<GetGitCredentialsRequest> | undefined, b: GetGitCredentialsRequest | PlainMessage<GetGitCredentialsRequest> | undefined): boolean {
+    return proto3.util.equals(GetGitCredentialsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetGitCredentialsResponse
+ */
+export class GetGitCredentialsResponse extends Message<GetGitCredentialsResponse> {
+  /**
+   * @generated from field: string repo_url = 1;
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string password = 3;
+   */
+  password = "";
+
+  /**
+   * @generated from field: string subpath = 4;
+   */
+  subpath = "";
+
+  /**
+   * @generated from field: string prod_branch = 5;
+   */
+  prodBranch = "";
+
+  constructor(data?: PartialMessage<GetGitCredentialsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetGitCredentialsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "subpath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "prod_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGitCredentialsResponse {
+    return new GetGitCredentialsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGitCredentialsResponse {
+    return new GetGitCredentialsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGitCredentialsResponse {
+    return new GetGitCredentialsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetGitCredentialsResponse | PlainMessage<GetGitCredentialsResponse> | undefined, b: GetGitCredentialsResponse | PlainMessage<GetGitCredentialsResponse> | undefined): boolean {
+    return proto3.util.equals(GetGitCredentialsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateWhitelistedDomainRequest
+ */
+export class CreateWhitelistedDomainRequest extends Message<CreateWhitelistedDomainRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string domain = 2;
+   */
+  domain = "";
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<CreateWhitelistedDomainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateWhitelistedDomainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWhitelistedDomainRequest {
+    return new CreateWhitelistedDomainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainRequest {
+    return new CreateWhitelistedDomainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainRequest {
+    return new CreateWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWhitelistedDomainRequest | PlainMessage<CreateWhitelistedDomainRequest> | undefined, b: CreateWhitelistedDomainRequest | PlainMessage<CreateWhitelistedDomainRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWhitelistedDomainRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.CreateWhitelistedDomainResponse
+ */
+export class CreateWhitelistedDomainResponse extends Message<CreateWhitelistedDomainResponse> {
+  constructor(data?: PartialMessage<CreateWhitelistedDomainResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.CreateWhitelistedDomainResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWhitelistedDomainResponse {
+    return new CreateWhitelistedDomainResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainResponse {
+    return new CreateWhitelistedDomainResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainResponse {
+    return new CreateWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWhitelistedDomainResponse | PlainMessage<CreateWhitelistedDomainResponse> | undefined, b: CreateWhitelistedDomainResponse | PlainMessage<CreateWhitelistedDomainResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWhitelistedDomainResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveWhitelistedDomainRequest
+ */
+export class RemoveWhitelistedDomainRequest extends Message<RemoveWhitelistedDomainRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string domain = 2;
+   */
+  domain = "";
+
+  constructor(data?: PartialMessage<RemoveWhitelistedDomainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveWhitelistedDomainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveWhitelistedDomainRequest {
+    return new RemoveWhitelistedDomainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainRequest {
+    return new RemoveWhitelistedDomainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainRequest {
+    return new RemoveWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveWhitelistedDomainRequest | PlainMessage<RemoveWhitelistedDomainRequest> | undefined, b: RemoveWhitelistedDomainRequest | PlainMessage<RemoveWhitelistedDomainRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveWhitelistedDomainRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveWhitelistedDomainResponse
+ */
+export class RemoveWhitelistedDomainResponse extends Message<RemoveWhitelistedDomainResponse> {
+  constructor(data?: PartialMessage<RemoveWhitelistedDomainResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveWhitelistedDomainResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveWhitelistedDomainResponse {
+    return new RemoveWhitelistedDomainResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainResponse {
+    return new RemoveWhitelistedDomainResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainResponse {
+    return new RemoveWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveWhitelistedDomainResponse | PlainMessage<RemoveWhitelistedDomainResponse> | undefined, b: RemoveWhitelistedDomainResponse | PlainMessage<RemoveWhitelistedDomainResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveWhitelistedDomainResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListWhitelistedDomainsRequest
+ */
+export class ListWhitelistedDomainsRequest extends Message<ListWhitelistedDomainsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  constructor(data?: PartialMessage<ListWhitelistedDomainsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsRequest {
+    return new ListWhitelistedDomainsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsRequest {
+    return new ListWhitelistedDomainsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsRequest {
+    return new ListWhitelistedDomainsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: IssueServiceAuthTokenRequest | PlainMessage<IssueServiceAuthTokenRequest> | undefined, b: IssueServiceAuthTokenRequest | PlainMessage<IssueServiceAuthTokenRequest> | undefined): boolean {
-    return proto3.util.equals(IssueServiceAuthTokenRequest, a, b);
+  static equals(a: ListWhitelistedDomainsRequest | PlainMessage<ListWhitelistedDomainsRequest> | undefined, b: ListWhitelistedDomainsRequest | PlainMessage<ListWhitelistedDomainsRequest> | undefined): boolean {
+    return proto3.util.equals(ListWhitelistedDomainsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.IssueServiceAuthTokenResponse
+ * @generated from message rill.admin.v1.ListWhitelistedDomainsResponse
  */
-export class IssueServiceAuthTokenResponse extends Message<IssueServiceAuthTokenResponse> {
+export class ListWhitelistedDomainsResponse extends Message<ListWhitelistedDomainsResponse> {
   /**
-   * @generated from field: string token = 1;
+   * @generated from field: repeated rill.admin.v1.WhitelistedDomain domains = 1;
    */
-  token = "";
+  domains: WhitelistedDomain[] = [];
 
-  constructor(data?: PartialMessage<IssueServiceAuthTokenResponse>) {
+  constructor(data?: PartialMessage<ListWhitelistedDomainsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.IssueServiceAuthTokenResponse";
+  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "domains", kind: "message", T: WhitelistedDomain, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueServiceAuthTokenResponse {
-    return new IssueServiceAuthTokenResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenResponse {
-    return new IssueServiceAuthTokenResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueServiceAuthTokenResponse {
-    return new IssueServiceAuthTokenResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: IssueServiceAuthTokenResponse | PlainMessage<IssueServiceAuthTokenResponse> | undefined, b: IssueServiceAuthTokenResponse | PlainMessage<IssueServiceAuthTokenResponse> | undefined): boolean {
-    return proto3.util.equals(IssueServiceAuthTokenResponse, a, b);
+  static equals(a: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined, b: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined): boolean {
+    return proto3.util.equals(ListWhitelistedDomainsResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListServiceAuthTokensRequest
+ * @generated from message rill.admin.v1.GetOrganizationSSORequest
  */
-export class ListServiceAuthTokensRequest extends Message<ListServiceAuthTokensRequest> {
-  /**
-   * @generated from field: string organization_name = 1;
-   */
-  organizationName = "";
-
+export class GetOrganizationSSORequest extends Message<GetOrganizationSSORequest> {
   /**
-   * @generated from field: string service_name = 2;
+   * @generated from field: string organization = 1;
    */
-  serviceName = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<ListServiceAuthTokensRequest>) {
+  constructor(data?: PartialMessage<GetOrganizationSSORequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListServiceAuthTokensRequest";
+  static readonly typeName = "rill.admin.v1.GetOrganizationSSORequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListServiceAuthTokensRequest {
-    return new ListServiceAuthTokensRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationSSORequest {
+    return new GetOrganizationSSORequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListServiceAuthTokensRequest {
-    return new ListServiceAuthTokensRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationSSORequest {
+    return new GetOrganizationSSORequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListServiceAuthTokensRequest {
-    return new ListServiceAuthTokensRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationSSORequest {
+    return new GetOrganizationSSORequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListServiceAuthTokensRequest | PlainMessage<ListServiceAuthTokensRequest> | undefined, b: ListServiceAuthTokensRequest | PlainMessage<ListServiceAuthTokensRequest> | undefined): boolean {
-    return proto3.util.equals(ListServiceAuthTokensRequest, a, b);
+  static equals(a: GetOrganizationSSORequest | PlainMessage<GetOrganizationSSORequest> | undefined, b: GetOrganizationSSORequest | PlainMessage<GetOrganizationSSORequest> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationSSORequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListServiceAuthTokensResponse
+ * @generated from message rill.admin.v1.GetOrganizationSSOResponse
  */
-export class ListServiceAuthTokensResponse extends Message<ListServiceAuthTokensResponse> {
+export class GetOrganizationSSOResponse extends Message<GetOrganizationSSOResponse> {
   /**
-   * @generated from field: repeated rill.admin.v1.ServiceToken tokens = 1;
+   * @generated from field: rill.admin.v1.OrganizationSSO sso = 1;
    */
-  tokens: ServiceToken[] = [];
+  sso?: OrganizationSSO;
 
-  constructor(data?: PartialMessage<ListServiceAuthTokensResponse>) {
+  constructor(data?: PartialMessage<GetOrganizationSSOResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListServiceAuthTokensResponse";
+  static readonly typeName = "rill.admin.v1.GetOrganizationSSOResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "tokens", kind: "message", T: ServiceToken, repeated: true },
+    { no: 1, name: "sso", kind: "message", T: OrganizationSSO },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListServiceAuthTokensResponse {
-    return new ListServiceAuthTokensResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationSSOResponse {
+    return new GetOrganizationSSOResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListServiceAuthTokensResponse {
-    return new ListServiceAuthTokensResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationSSOResponse {
+    return new GetOrganizationSSOResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListServiceAuthTokensResponse {
-    return new ListServiceAuthTokensResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationSSOResponse {
+    return new GetOrganizationSSOResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListServiceAuthTokensResponse | PlainMessage<ListServiceAuthTokensResponse> | undefined, b: ListServiceAuthTokensResponse | PlainMessage<ListServiceAuthTokensResponse> | undefined): boolean {
-    return proto3.util.equals(ListServiceAuthTokensResponse, a, b);
+  static equals(a: GetOrganizationSSOResponse | PlainMessage<GetOrganizationSSOResponse> | undefined, b: GetOrganizationSSOResponse | PlainMessage<GetOrganizationSSOResponse> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationSSOResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetGithubRepoStatusRequest
+ * @generated from message rill.admin.v1.SetOrganizationSSORequest
  */
-export class GetGithubRepoStatusRequest extends Message<GetGithubRepoStatusRequest> {
+export class SetOrganizationSSORequest extends Message<SetOrganizationSSORequest> {
   /**
-   * @generated from field: string github_url = 1;
+   * @generated from field: string organization = 1;
    */
-  githubUrl = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<GetGithubRepoStatusRequest>) {
+  /**
+   * @generated from field: string issuer = 2;
+   */
+  issuer = "";
+
+  /**
+   * @generated from field: string client_id = 3;
+   */
+  clientId = "";
+
+  /**
+   * @generated from field: string client_secret = 4;
+   */
+  clientSecret = "";
+
+  /**
+   * @generated from field: string default_role = 5;
+   */
+  defaultRole = "";
+
+  constructor(data?: PartialMessage<SetOrganizationSSORequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetGithubRepoStatusRequest";
+  static readonly typeName = "rill.admin.v1.SetOrganizationSSORequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "github_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "issuer", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "client_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "client_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "default_role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubRepoStatusRequest {
-    return new GetGithubRepoStatusRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetOrganizationSSORequest {
+    return new SetOrganizationSSORequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubRepoStatusRequest {
-    return new GetGithubRepoStatusRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetOrganizationSSORequest {
+    return new SetOrganizationSSORequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubRepoStatusRequest {
-    return new GetGithubRepoStatusRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetOrganizationSSORequest {
+    return new SetOrganizationSSORequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetGithubRepoStatusRequest | PlainMessage<GetGithubRepoStatusRequest> | undefined, b: GetGithubRepoStatusRequest | PlainMessage<GetGithubRepoStatusRequest> | undefined): boolean {
-    return proto3.util.equals(GetGithubRepoStatusRequest, a, b);
+  static equals(a: SetOrganizationSSORequest | PlainMessage<SetOrganizationSSORequest> | undefined, b: SetOrganizationSSORequest | PlainMessage<SetOrganizationSSORequest> | undefined): boolean {
+    return proto3.util.equals(SetOrganizationSSORequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetGithubRepoStatusResponse
+ * @generated from message rill.admin.v1.SetOrganizationSSOResponse
  */
-export class GetGithubRepoStatusResponse extends Message<GetGithubRepoStatusResponse> {
-  /**
-   * @generated from field: bool has_access = 1;
-   */
-  hasAccess = false;
-
-  /**
-   * @generated from field: string grant_access_url = 2;
-   */
-  grantAccessUrl = "";
-
+export class SetOrganizationSSOResponse extends Message<SetOrganizationSSOResponse> {
   /**
-   * @generated from field: string default_branch = 3;
+   * @generated from field: rill.admin.v1.OrganizationSSO sso = 1;
    */
-  defaultBranch = "";
+  sso?: OrganizationSSO;
 
-  constructor(data?: PartialMessage<GetGithubRepoStatusResponse>) {
+  constructor(data?: PartialMessage<SetOrganizationSSOResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetGithubRepoStatusResponse";
+  static readonly typeName = "rill.admin.v1.SetOrganizationSSOResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "has_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 2, name: "grant_access_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "default_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "sso", kind: "message", T: OrganizationSSO },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGithubRepoStatusResponse {
-    return new GetGithubRepoStatusResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetOrganizationSSOResponse {
+    return new SetOrganizationSSOResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGithubRepoStatusResponse {
-    return new GetGithubRepoStatusResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetOrganizationSSOResponse {
+    return new SetOrganizationSSOResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGithubRepoStatusResponse {
-    return new GetGithubRepoStatusResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetOrganizationSSOResponse {
+    return new SetOrganizationSSOResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetGithubRepoStatusResponse | PlainMessage<GetGithubRepoStatusResponse> | undefined, b: GetGithubRepoStatusResponse | PlainMessage<GetGithubRepoStatusResponse> | undefined): boolean {
-    return proto3.util.equals(GetGithubRepoStatusResponse, a, b);
+  static equals(a: SetOrganizationSSOResponse | PlainMessage<SetOrganizationSSOResponse> | undefined, b: SetOrganizationSSOResponse | PlainMessage<SetOrganizationSSOResponse> | undefined): boolean {
+    return proto3.util.equals(SetOrganizationSSOResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetGitCredentialsRequest
+ * @generated from message rill.admin.v1.RemoveOrganizationSSORequest
  */
-export class GetGitCredentialsRequest extends Message<GetGitCredentialsRequest> {
+export class RemoveOrganizationSSORequest extends Message<RemoveOrganizationSSORequest> {
   /**
    * @generated from field: string organization = 1;
    */
   organization = "";
 
-  /**
-   * @generated from field: string project = 2;
-   */
-  project = "";
-
-  constructor(data?: PartialMessage<GetGitCredentialsRequest>) {
+  constructor(data?: PartialMessage<RemoveOrganizationSSORequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetGitCredentialsRequest";
+  static readonly typeName = "rill.admin.v1.RemoveOrganizationSSORequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGitCredentialsRequest {
-    return new GetGitCredentialsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationSSORequest {
+    return new RemoveOrganizationSSORequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGitCredentialsRequest {
-    return new GetGitCredentialsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationSSORequest {
+    return new RemoveOrganizationSSORequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGitCredentialsRequest {
-    return new GetGitCredentialsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationSSORequest {
+    return new RemoveOrganizationSSORequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetGitCredentialsRequest | PlainMessage<GetGitCredentialsRequest> | undefined, b: GetGitCredentialsRequest | PlainMessage<GetGitCredentialsRequest> | undefined): boolean {
-    return proto3.util.equals(GetGitCredentialsRequest, a, b);
+  static equals(a: RemoveOrganizationSSORequest | PlainMessage<RemoveOrganizationSSORequest> | undefined, b: RemoveOrganizationSSORequest | PlainMessage<RemoveOrganizationSSORequest> | undefined): boolean {
+    return proto3.util.equals(RemoveOrganizationSSORequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetGitCredentialsResponse
+ * @generated from message rill.admin.v1.RemoveOrganizationSSOResponse
  */
-export class GetGitCredentialsResponse extends Message<GetGitCredentialsResponse> {
-  /**
-   * @generated from field: string repo_url = 1;
-   */
-  repoUrl = "";
-
-  /**
-   * @generated from field: string username = 2;
-   */
-  username = "";
-
-  /**
-   * @generated from field: string password = 3;
-   */
-  password = "";
-
-  /**
-   * @generated from field: string subpath = 4;
-   */
-  subpath = "";
-
-  /**
-   * @generated from field: string prod_branch = 5;
-   */
-  prodBranch = "";
-
-  constructor(data?: PartialMessage<GetGitCredentialsResponse>) {
+export class RemoveOrganizationSSOResponse extends Message<RemoveOrganizationSSOResponse> {
+  constructor(data?: PartialMessage<RemoveOrganizationSSOResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetGitCredentialsResponse";
+  static readonly typeName = "rill.admin.v1.RemoveOrganizationSSOResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "subpath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "prod_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGitCredentialsResponse {
-    return new GetGitCredentialsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationSSOResponse {
+    return new RemoveOrganizationSSOResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGitCredentialsResponse {
-    return new GetGitCredentialsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationSSOResponse {
+    return new RemoveOrganizationSSOResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGitCredentialsResponse {
-    return new GetGitCredentialsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationSSOResponse {
+    return new RemoveOrganizationSSOResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetGitCredentialsResponse | PlainMessage<GetGitCredentialsResponse> | undefined, b: GetGitCredentialsResponse | PlainMessage<GetGitCredentialsResponse> | undefined): boolean {
-    return proto3.util.equals(GetGitCredentialsResponse, a, b);
+  static equals(a: RemoveOrganizationSSOResponse | PlainMessage<RemoveOrganizationSSOResponse> | undefined, b: RemoveOrganizationSSOResponse | PlainMessage<RemoveOrganizationSSOResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveOrganizationSSOResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateWhitelistedDomainRequest
+ * @generated from message rill.admin.v1.AddOrganizationSSODomainRequest
  */
-export class CreateWhitelistedDomainRequest extends Message<CreateWhitelistedDomainRequest> {
+export class AddOrganizationSSODomainRequest extends Message<AddOrganizationSSODomainRequest> {
   /**
    * @generated from field: string organization = 1;
    */
@@ -4913,76 +6563,76 @@ export class CreateWhitelistedDomainRequest extends Message<CreateWhitelistedDom
    */
   domain = "";
 
-  /**
-   * @generated from field: string role = 3;
-   */
-  role = "";
-
-  constructor(data?: PartialMessage<CreateWhitelistedDomainRequest>) {
+  constructor(data?: PartialMessage<AddOrganizationSSODomainRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateWhitelistedDomainRequest";
+  static readonly typeName = "rill.admin.v1.AddOrganizationSSODomainRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWhitelistedDomainRequest {
-    return new CreateWhitelistedDomainRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddOrganizationSSODomainRequest {
+    return new AddOrganizationSSODomainRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainRequest {
-    return new CreateWhitelistedDomainRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddOrganizationSSODomainRequest {
+    return new AddOrganizationSSODomainRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainRequest {
-    return new CreateWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddOrganizationSSODomainRequest {
+    return new AddOrganizationSSODomainRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWhitelistedDomainRequest | PlainMessage<CreateWhitelistedDomainRequest> | undefined, b: CreateWhitelistedDomainRequest | PlainMessage<CreateWhitelistedDomainRequest> | undefined): boolean {
-    return proto3.util.equals(CreateWhitelistedDomainRequest, a, b);
+  static equals(a: AddOrganizationSSODomainRequest | PlainMessage<AddOrganizationSSODomainRequest> | undefined, b: AddOrganizationSSODomainRequest | PlainMessage<AddOrganizationSSODomainRequest> | undefined): boolean {
+    return proto3.util.equals(AddOrganizationSSODomainRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.CreateWhitelistedDomainResponse
+ * @generated from message rill.admin.v1.AddOrganizationSSODomainResponse
  */
-export class CreateWhitelistedDomainResponse extends Message<CreateWhitelistedDomainResponse> {
-  constructor(data?: PartialMessage<CreateWhitelistedDomainResponse>) {
+export class AddOrganizationSSODomainResponse extends Message<AddOrganizationSSODomainResponse> {
+  /**
+   * @generated from field: rill.admin.v1.OrganizationSSODomain domain = 1;
+   */
+  domain?: OrganizationSSODomain;
+
+  constructor(data?: PartialMessage<AddOrganizationSSODomainResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.CreateWhitelistedDomainResponse";
+  static readonly typeName = "rill.admin.v1.AddOrganizationSSODomainResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domain", kind: "message", T: OrganizationSSODomain },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWhitelistedDomainResponse {
-    return new CreateWhitelistedDomainResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddOrganizationSSODomainResponse {
+    return new AddOrganizationSSODomainResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainResponse {
-    return new CreateWhitelistedDomainResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddOrganizationSSODomainResponse {
+    return new AddOrganizationSSODomainResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWhitelistedDomainResponse {
-    return new CreateWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddOrganizationSSODomainResponse {
+    return new AddOrganizationSSODomainResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWhitelistedDomainResponse | PlainMessage<CreateWhitelistedDomainResponse> | undefined, b: CreateWhitelistedDomainResponse | PlainMessage<CreateWhitelistedDomainResponse> | undefined): boolean {
-    return proto3.util.equals(CreateWhitelistedDomainResponse, a, b);
+  static equals(a: AddOrganizationSSODomainResponse | PlainMessage<AddOrganizationSSODomainResponse> | undefined, b: AddOrganizationSSODomainResponse | PlainMessage<AddOrganizationSSODomainResponse> | undefined): boolean {
+    return proto3.util.equals(AddOrganizationSSODomainResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveWhitelistedDomainRequest
+ * @generated from message rill.admin.v1.VerifyOrganizationSSODomainRequest
  */
-export class RemoveWhitelistedDomainRequest extends Message<RemoveWhitelistedDomainRequest> {
+export class VerifyOrganizationSSODomainRequest extends Message<VerifyOrganizationSSODomainRequest> {
   /**
    * @generated from field: string organization = 1;
    */
@@ -4993,137 +6643,143 @@ export class RemoveWhitelistedDomainRequest extends Message<RemoveWhitelistedDom
    */
   domain = "";
 
-  constructor(data?: PartialMessage<RemoveWhitelistedDomainRequest>) {
+  constructor(data?: PartialMessage<VerifyOrganizationSSODomainRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveWhitelistedDomainRequest";
+  static readonly typeName = "rill.admin.v1.VerifyOrganizationSSODomainRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveWhitelistedDomainRequest {
-    return new RemoveWhitelistedDomainRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyOrganizationSSODomainRequest {
+    return new VerifyOrganizationSSODomainRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainRequest {
-    return new RemoveWhitelistedDomainRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyOrganizationSSODomainRequest {
+    return new VerifyOrganizationSSODomainRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainRequest {
-    return new RemoveWhitelistedDomainRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyOrganizationSSODomainRequest {
+    return new VerifyOrganizationSSODomainRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveWhitelistedDomainRequest | PlainMessage<RemoveWhitelistedDomainRequest> | undefined, b: RemoveWhitelistedDomainRequest | PlainMessage<RemoveWhitelistedDomainRequest> | undefined): boolean {
-    return proto3.util.equals(RemoveWhitelistedDomainRequest, a, b);
+  static equals(a: VerifyOrganizationSSODomainRequest | PlainMessage<VerifyOrganizationSSODomainRequest> | undefined, b: VerifyOrganizationSSODomainRequest | PlainMessage<VerifyOrganizationSSODomainRequest> | undefined): boolean {
+    return proto3.util.equals(VerifyOrganizationSSODomainRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.RemoveWhitelistedDomainResponse
+ * @generated from message rill.admin.v1.VerifyOrganizationSSODomainResponse
  */
-export class RemoveWhitelistedDomainResponse extends Message<RemoveWhitelistedDomainResponse> {
-  constructor(data?: PartialMessage<RemoveWhitelistedDomainResponse>) {
+export class VerifyOrganizationSSODomainResponse extends Message<VerifyOrganizationSSODomainResponse> {
+  /**
+   * @generated from field: rill.admin.v1.OrganizationSSODomain domain = 1;
+   */
+  domain?: OrganizationSSODomain;
+
+  constructor(data?: PartialMessage<VerifyOrganizationSSODomainResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.RemoveWhitelistedDomainResponse";
+  static readonly typeName = "rill.admin.v1.VerifyOrganizationSSODomainResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domain", kind: "message", T: OrganizationSSODomain },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveWhitelistedDomainResponse {
-    return new RemoveWhitelistedDomainResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyOrganizationSSODomainResponse {
+    return new VerifyOrganizationSSODomainResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainResponse {
-    return new RemoveWhitelistedDomainResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyOrganizationSSODomainResponse {
+    return new VerifyOrganizationSSODomainResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveWhitelistedDomainResponse {
-    return new RemoveWhitelistedDomainResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyOrganizationSSODomainResponse {
+    return new VerifyOrganizationSSODomainResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RemoveWhitelistedDomainResponse | PlainMessage<RemoveWhitelistedDomainResponse> | undefined, b: RemoveWhitelistedDomainResponse | PlainMessage<RemoveWhitelistedDomainResponse> | undefined): boolean {
-    return proto3.util.equals(RemoveWhitelistedDomainResponse, a, b);
+  static equals(a: VerifyOrganizationSSODomainResponse | PlainMessage<VerifyOrganizationSSODomainResponse> | undefined, b: VerifyOrganizationSSODomainResponse | PlainMessage<VerifyOrganizationSSODomainResponse> | undefined): boolean {
+    return proto3.util.equals(VerifyOrganizationSSODomainResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListWhitelistedDomainsRequest
+ * @generated from message rill.admin.v1.RemoveOrganizationSSODomainRequest
  */
-export class ListWhitelistedDomainsRequest extends Message<ListWhitelistedDomainsRequest> {
+export class RemoveOrganizationSSODomainRequest extends Message<RemoveOrganizationSSODomainRequest> {
   /**
    * @generated from field: string organization = 1;
    */
   organization = "";
 
-  constructor(data?: PartialMessage<ListWhitelistedDomainsRequest>) {
+  /**
+   * @generated from field: string domain = 2;
+   */
+  domain = "";
+
+  constructor(data?: PartialMessage<RemoveOrganizationSSODomainRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsRequest";
+  static readonly typeName = "rill.admin.v1.RemoveOrganizationSSODomainRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsRequest {
-    return new ListWhitelistedDomainsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationSSODomainRequest {
+    return new RemoveOrganizationSSODomainRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsRequest {
-    return new ListWhitelistedDomainsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationSSODomainRequest {
+    return new RemoveOrganizationSSODomainRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsRequest {
-    return new ListWhitelistedDomainsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationSSODomainRequest {
+    return new RemoveOrganizationSSODomainRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWhitelistedDomainsRequest | PlainMessage<ListWhitelistedDomainsRequest> | undefined, b: ListWhitelistedDomainsRequest | PlainMessage<ListWhitelistedDomainsRequest> | undefined): boolean {
-    return proto3.util.equals(ListWhitelistedDomainsRequest, a, b);
+  static equals(a: RemoveOrganizationSSODomainRequest | PlainMessage<RemoveOrganizationSSODomainRequest> | undefined, b: RemoveOrganizationSSODomainRequest | PlainMessage<RemoveOrganizationSSODomainRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveOrganizationSSODomainRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.ListWhitelistedDomainsResponse
+ * @generated from message rill.admin.v1.RemoveOrganizationSSODomainResponse
  */
-export class ListWhitelistedDomainsResponse extends Message<ListWhitelistedDomainsResponse> {
-  /**
-   * @generated from field: repeated rill.admin.v1.WhitelistedDomain domains = 1;
-   */
-  domains: WhitelistedDomain[] = [];
-
-  constructor(data?: PartialMessage<ListWhitelistedDomainsResponse>) {
+export class RemoveOrganizationSSODomainResponse extends Message<RemoveOrganizationSSODomainResponse> {
+  constructor(data?: PartialMessage<RemoveOrganizationSSODomainResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsResponse";
+  static readonly typeName = "rill.admin.v1.RemoveOrganizationSSODomainResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "domains", kind: "message", T: WhitelistedDomain, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveOrganizationSSODomainResponse {
+    return new RemoveOrganizationSSODomainResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveOrganizationSSODomainResponse {
+    return new RemoveOrganizationSSODomainResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveOrganizationSSODomainResponse {
+    return new RemoveOrganizationSSODomainResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined, b: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined): boolean {
-    return proto3.util.equals(ListWhitelistedDomainsResponse, a, b);
+  static equals(a: RemoveOrganizationSSODomainResponse | PlainMessage<RemoveOrganizationSSODomainResponse> | undefined, b: RemoveOrganizationSSODomainResponse | PlainMessage<RemoveOrganizationSSODomainResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveOrganizationSSODomainResponse, a, b);
   }
 }
 
@@ -6787,6 +8443,205 @@ export class Member extends Message<Member> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.Usergroup
+ */
+export class Usergroup extends Message<Usergroup> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<Usergroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.Usergroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Usergroup {
+    return new Usergroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Usergroup {
+    return new Usergroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Usergroup {
+    return new Usergroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Usergroup | PlainMessage<Usergroup> | undefined, b: Usergroup | PlainMessage<Usergroup> | undefined): boolean {
+    return proto3.util.equals(Usergroup, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ProjectRole
+ */
+export class ProjectRole extends Message<ProjectRole> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Built-in roles are shared by all organizations and can't be changed
+   *
+   * @generated from field: bool builtin = 3;
+   */
+  builtin = false;
+
+  /**
+   * @generated from field: rill.admin.v1.ProjectPermissions permissions = 4;
+   */
+  permissions?: ProjectPermissions;
+
+  constructor(data?: PartialMessage<ProjectRole>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ProjectRole";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "builtin", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "permissions", kind: "message", T: ProjectPermissions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectRole {
+    return new ProjectRole().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectRole {
+    return new ProjectRole().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectRole {
+    return new ProjectRole().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectRole | PlainMessage<ProjectRole> | undefined, b: ProjectRole | PlainMessage<ProjectRole> | undefined): boolean {
+    return proto3.util.equals(ProjectRole, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AuditEvent
+ */
+export class AuditEvent extends Message<AuditEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string actor_type = 2;
+   */
+  actorType = "";
+
+  /**
+   * @generated from field: string actor_id = 3;
+   */
+  actorId = "";
+
+  /**
+   * @generated from field: string actor_name = 4;
+   */
+  actorName = "";
+
+  /**
+   * @generated from field: string project_id = 5;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string project_name = 6;
+   */
+  projectName = "";
+
+  /**
+   * @generated from field: string action = 7;
+   */
+  action = "";
+
+  /**
+   * @generated from field: string target = 8;
+   */
+  target = "";
+
+  /**
+   * Fields that changed, with their values before and after the change. Secrets are redacted.
+   *
+   * @generated from field: google.protobuf.Struct before = 9;
+   */
+  before?: Struct;
+
+  /**
+   * @generated from field: google.protobuf.Struct after = 10;
+   */
+  after?: Struct;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 11;
+   */
+  createdOn?: Timestamp;
+
+  constructor(data?: PartialMessage<AuditEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AuditEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "actor_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "actor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "actor_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "project_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "target", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "before", kind: "message", T: Struct },
+    { no: 10, name: "after", kind: "message", T: Struct },
+    { no: 11, name: "created_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent {
+    return new AuditEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean {
+    return proto3.util.equals(AuditEvent, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.UserInvite
  */
@@ -6879,6 +8734,152 @@ export class WhitelistedDomain extends Message<WhitelistedDomain> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.OrganizationSSO
+ */
+export class OrganizationSSO extends Message<OrganizationSSO> {
+  /**
+   * @generated from field: string issuer = 1;
+   */
+  issuer = "";
+
+  /**
+   * @generated from field: string client_id = 2;
+   */
+  clientId = "";
+
+  /**
+   * @generated from field: string default_role = 3;
+   */
+  defaultRole = "";
+
+  /**
+   * Redirect URL to configure in the identity provider
+   *
+   * @generated from field: string redirect_url = 4;
+   */
+  redirectUrl = "";
+
+  /**
+   * Email domains whose users log in through the provider (once verified)
+   *
+   * @generated from field: repeated rill.admin.v1.OrganizationSSODomain domains = 5;
+   */
+  domains: OrganizationSSODomain[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 6;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 7;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<OrganizationSSO>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.OrganizationSSO";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "issuer", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "client_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "default_role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "domains", kind: "message", T: OrganizationSSODomain, repeated: true },
+    { no: 6, name: "created_on", kind: "message", T: Timestamp },
+    { no: 7, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationSSO {
+    return new OrganizationSSO().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationSSO {
+    return new OrganizationSSO().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationSSO {
+    return new OrganizationSSO().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OrganizationSSO | PlainMessage<OrganizationSSO> | undefined, b: OrganizationSSO | PlainMessage<OrganizationSSO> | undefined): boolean {
+    return proto3.util.equals(OrganizationSSO, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.OrganizationSSODomain
+ */
+export class OrganizationSSODomain extends Message<OrganizationSSODomain> {
+  /**
+   * @generated from field: string domain = 1;
+   */
+  domain = "";
+
+  /**
+   * @generated from field: bool verified = 2;
+   */
+  verified = false;
+
+  /**
+   * DNS TXT record to create to prove ownership of the domain
+   *
+   * @generated from field: string verification_record_name = 3;
+   */
+  verificationRecordName = "";
+
+  /**
+   * @generated from field: string verification_record_value = 4;
+   */
+  verificationRecordValue = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 5;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp verified_on = 6;
+   */
+  verifiedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<OrganizationSSODomain>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.OrganizationSSODomain";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "verified", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "verification_record_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "verification_record_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_on", kind: "message", T: Timestamp },
+    { no: 6, name: "verified_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationSSODomain {
+    return new OrganizationSSODomain().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationSSODomain {
+    return new OrganizationSSODomain().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationSSODomain {
+    return new OrganizationSSODomain().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OrganizationSSODomain | PlainMessage<OrganizationSSODomain> | undefined, b: OrganizationSSODomain | PlainMessage<OrganizationSSODomain> | undefined): boolean {
+    return proto3.util.equals(OrganizationSSODomain, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.Bookmark
  */
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 import { PullTriggerSpec, RefreshTriggerSpec, Resource, ResourceName } from "./resources_pb.js";
 import { MetricsView, Model, ObjectType, Source, Table } from "./catalog_pb.js";
 
@@ -213,6 +213,14 @@ export class Instance extends Message<Instance> {
    */
   adminConnector = "";
 
+  /**
+   * Environment is the name of the environment the instance runs in, such as "dev" or "prod".
+   * It selects the environment-specific overrides in rill.yaml and resource files.
+   *
+   * @generated from field: string environment = 20;
+   */
+  environment = "";
+
   /**
    * @generated from field: google.protobuf.Timestamp created_on = 11;
    */
@@ -285,6 +293,7 @@ export class Instance extends Message<Instance> {
     { no: 2, name: "olap_connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "repo_connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 19, name: "admin_connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 20, name: "environment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 11, name: "created_on", kind: "message", T: Timestamp },
     { no: 12, name: "updated_on", kind: "message", T: Timestamp },
     { no: 10, name: "connectors", kind: "message", T: Connector, repeated: true },
@@ -566,6 +575,11 @@ export class CreateInstanceRequest extends Message<CreateInstanceRequest> {
    */
   adminConnector = "";
 
+  /**
+   * @generated from field: string environment = 16;
+   */
+  environment = "";
+
   /**
    * @generated from field: repeated rill.runtime.v1.Connector connectors = 10;
    */
@@ -618,6 +632,7 @@ export class CreateInstanceRequest extends Message<CreateInstanceRequest> {
     { no: 2, name: "olap_connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "repo_connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 15, name: "admin_connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 16, name: "environment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 10, name: "connectors", kind: "message", T: Connector, repeated: true },
     { no: 7, name: "variables", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 9, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
@@ -789,6 +804,11 @@ export class EditInstanceRequest extends Message<EditInstanceRequest> {
    */
   adminConnector?: string;
 
+  /**
+   * @generated from field: optional string environment = 17;
+   */
+  environment?: string;
+
   /**
    * @generated from field: repeated rill.runtime.v1.Connector connectors = 9;
    */
@@ -841,6 +861,7 @@ export class EditInstanceRequest extends Message<EditInstanceRequest> {
     { no: 2, name: "olap_connector", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "repo_connector", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 16, name: "admin_connector", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 17, name: "environment", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 9, name: "connectors", kind: "message", T: Connector, repeated: true },
     { no: 15, name: "variables", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 10, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
@@ -1493,6 +1514,108 @@ export class Example extends Message<Example> {
   }
 }
 
+/**
+ * Request message for RuntimeService.FormatFile
+ *
+ * @generated from message rill.runtime.v1.FormatFileRequest
+ */
+export class FormatFileRequest extends Message<FormatFileRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: string path = 2;
+   */
+  path = "";
+
+  /**
+   * If true, the formatted contents are returned without writing them to the file
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun = false;
+
+  constructor(data?: PartialMessage<FormatFileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.FormatFileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FormatFileRequest {
+    return new FormatFileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FormatFileRequest {
+    return new FormatFileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FormatFileRequest {
+    return new FormatFileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FormatFileRequest | PlainMessage<FormatFileRequest> | undefined, b: FormatFileRequest | PlainMessage<FormatFileRequest> | undefined): boolean {
+    return proto3.util.equals(FormatFileRequest, a, b);
+  }
+}
+
+/**
+ * Response message for RuntimeService.FormatFile
+ *
+ * @generated from message rill.runtime.v1.FormatFileResponse
+ */
+export class FormatFileResponse extends Message<FormatFileResponse> {
+  /**
+   * The formatted contents of the file
+   *
+   * @generated from field: string blob = 1;
+   */
+  blob = "";
+
+  /**
+   * Changed is true if the formatted contents differ from the file's current contents
+   *
+   * @generated from field: bool changed = 2;
+   */
+  changed = false;
+
+  constructor(data?: PartialMessage<FormatFileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.FormatFileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "blob", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "changed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FormatFileResponse {
+    return new FormatFileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FormatFileResponse {
+    return new FormatFileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FormatFileResponse {
+    return new FormatFileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FormatFileResponse | PlainMessage<FormatFileResponse> | undefined, b: FormatFileResponse | PlainMessage<FormatFileResponse> | undefined): boolean {
+    return proto3.util.equals(FormatFileResponse, a, b);
+  }
+}
+
 /**
  * Request message for RuntimeService.ListExamples
  *
@@ -1874,6 +1997,256 @@ export class GetLogsResponse extends Message<GetLogsResponse> {
   }
 }
 
+/**
+ * Request message for RuntimeService.ListQueryHistory
+ *
+ * @generated from message rill.runtime.v1.ListQueryHistoryRequest
+ */
+export class ListQueryHistoryRequest extends Message<ListQueryHistoryRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * If set, only queries that took at least this long are returned
+   *
+   * @generated from field: uint32 min_duration_ms = 2;
+   */
+  minDurationMs = 0;
+
+  /**
+   * Maximum number of entries to return. If 0 or -1, all entries are returned.
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListQueryHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ListQueryHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "min_duration_ms", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListQueryHistoryRequest {
+    return new ListQueryHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListQueryHistoryRequest {
+    return new ListQueryHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListQueryHistoryRequest {
+    return new ListQueryHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListQueryHistoryRequest | PlainMessage<ListQueryHistoryRequest> | undefined, b: ListQueryHistoryRequest | PlainMessage<ListQueryHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(ListQueryHistoryRequest, a, b);
+  }
+}
+
+/**
+ * Response message for RuntimeService.ListQueryHistory
+ *
+ * @generated from message rill.runtime.v1.ListQueryHistoryResponse
+ */
+export class ListQueryHistoryResponse extends Message<ListQueryHistoryResponse> {
+  /**
+   * @generated from field: repeated rill.runtime.v1.QueryHistoryEntry entries = 1;
+   */
+  entries: QueryHistoryEntry[] = [];
+
+  constructor(data?: PartialMessage<ListQueryHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ListQueryHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: QueryHistoryEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListQueryHistoryResponse {
+    return new ListQueryHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListQueryHistoryResponse {
+    return new ListQueryHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListQueryHistoryResponse {
+    return new ListQueryHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListQueryHistoryResponse | PlainMessage<ListQueryHistoryResponse> | undefined, b: ListQueryHistoryResponse | PlainMessage<ListQueryHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(ListQueryHistoryResponse, a, b);
+  }
+}
+
+/**
+ * QueryHistoryEntry describes a query executed by the runtime
+ *
+ * @generated from message rill.runtime.v1.QueryHistoryEntry
+ */
+export class QueryHistoryEntry extends Message<QueryHistoryEntry> {
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * Type of the query, e.g. "MetricsViewAggregation"
+   *
+   * @generated from field: string query_type = 2;
+   */
+  queryType = "";
+
+  /**
+   * Name of the metrics view the query targets (if any)
+   *
+   * @generated from field: string metrics_view = 3;
+   */
+  metricsView = "";
+
+  /**
+   * SQL statements executed against the OLAP connector. Empty for cache hits.
+   *
+   * @generated from field: repeated rill.runtime.v1.QueryHistoryStatement statements = 4;
+   */
+  statements: QueryHistoryStatement[] = [];
+
+  /**
+   * @generated from field: uint32 duration_ms = 5;
+   */
+  durationMs = 0;
+
+  /**
+   * Number of rows in the query's result (if known)
+   *
+   * @generated from field: int64 rows = 6;
+   */
+  rows = protoInt64.zero;
+
+  /**
+   * @generated from field: bool cache_hit = 7;
+   */
+  cacheHit = false;
+
+  /**
+   * @generated from field: int32 priority = 8;
+   */
+  priority = 0;
+
+  /**
+   * Subject (usually a user ID) that requested the query
+   *
+   * @generated from field: string subject = 9;
+   */
+  subject = "";
+
+  /**
+   * Error message if the query failed
+   *
+   * @generated from field: string error = 10;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<QueryHistoryEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.QueryHistoryEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start_time", kind: "message", T: Timestamp },
+    { no: 2, name: "query_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metrics_view", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "statements", kind: "message", T: QueryHistoryStatement, repeated: true },
+    { no: 5, name: "duration_ms", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "rows", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "cache_hit", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "subject", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryHistoryEntry {
+    return new QueryHistoryEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryHistoryEntry {
+    return new QueryHistoryEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryHistoryEntry {
+    return new QueryHistoryEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueryHistoryEntry | PlainMessage<QueryHistoryEntry> | undefined, b: QueryHistoryEntry | PlainMessage<QueryHistoryEntry> | undefined): boolean {
+    return proto3.util.equals(QueryHistoryEntry, a, b);
+  }
+}
+
+/**
+ * QueryHistoryStatement is a SQL statement executed as part of a query
+ *
+ * @generated from message rill.runtime.v1.QueryHistoryStatement
+ */
+export class QueryHistoryStatement extends Message<QueryHistoryStatement> {
+  /**
+   * @generated from field: string sql = 1;
+   */
+  sql = "";
+
+  /**
+   * @generated from field: repeated string args = 2;
+   */
+  args: string[] = [];
+
+  /**
+   * @generated from field: uint32 duration_ms = 3;
+   */
+  durationMs = 0;
+
+  constructor(data?: PartialMessage<QueryHistoryStatement>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.QueryHistoryStatement";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sql", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "args", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "duration_ms", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryHistoryStatement {
+    return new QueryHistoryStatement().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryHistoryStatement {
+    return new QueryHistoryStatement().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryHistoryStatement {
+    return new QueryHistoryStatement().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueryHistoryStatement | PlainMessage<QueryHistoryStatement> | undefined, b: QueryHistoryStatement | PlainMessage<QueryHistoryStatement> | undefined): boolean {
+    return proto3.util.equals(QueryHistoryStatement, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.WatchLogsRequest
  */
@@ -342,6 +342,149 @@ export class QueryBatchResponse extends Message<QueryBatchResponse> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.ExplainQueryRequest
+ */
+export class ExplainQueryRequest extends Message<ExplainQueryRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.Query query = 2;
+   */
+  query?: Query;
+
+  /**
+   * Explain with EXPLAIN ANALYZE instead of EXPLAIN, which executes the statements again to report timings.
+   * Ignored for Druid, which doesn't support it.
+   *
+   * @generated from field: bool analyze = 3;
+   */
+  analyze = false;
+
+  constructor(data?: PartialMessage<ExplainQueryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ExplainQueryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "query", kind: "message", T: Query },
+    { no: 3, name: "analyze", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainQueryRequest {
+    return new ExplainQueryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainQueryRequest {
+    return new ExplainQueryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainQueryRequest {
+    return new ExplainQueryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExplainQueryRequest | PlainMessage<ExplainQueryRequest> | undefined, b: ExplainQueryRequest | PlainMessage<ExplainQueryRequest> | undefined): boolean {
+    return proto3.util.equals(ExplainQueryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.ExplainQueryResponse
+ */
+export class ExplainQueryResponse extends Message<ExplainQueryResponse> {
+  /**
+   * @generated from field: repeated rill.runtime.v1.ExplainedStatement statements = 1;
+   */
+  statements: ExplainedStatement[] = [];
+
+  constructor(data?: PartialMessage<ExplainQueryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ExplainQueryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "statements", kind: "message", T: ExplainedStatement, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainQueryResponse {
+    return new ExplainQueryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainQueryResponse {
+    return new ExplainQueryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainQueryResponse {
+    return new ExplainQueryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExplainQueryResponse | PlainMessage<ExplainQueryResponse> | undefined, b: ExplainQueryResponse | PlainMessage<ExplainQueryResponse> | undefined): boolean {
+    return proto3.util.equals(ExplainQueryResponse, a, b);
+  }
+}
+
+/**
+ * ExplainedStatement is a SQL statement executed by a query along with its query plan
+ *
+ * @generated from message rill.runtime.v1.ExplainedStatement
+ */
+export class ExplainedStatement extends Message<ExplainedStatement> {
+  /**
+   * @generated from field: string sql = 1;
+   */
+  sql = "";
+
+  /**
+   * @generated from field: repeated string args = 2;
+   */
+  args: string[] = [];
+
+  /**
+   * Output of EXPLAIN or EXPLAIN ANALYZE (DuckDB), or EXPLAIN PLAN FOR (Druid).
+   * Empty for statements that are not read-only, such as creating a temporary table.
+   *
+   * @generated from field: string plan = 3;
+   */
+  plan = "";
+
+  constructor(data?: PartialMessage<ExplainedStatement>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ExplainedStatement";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sql", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "args", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "plan", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainedStatement {
+    return new ExplainedStatement().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainedStatement {
+    return new ExplainedStatement().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainedStatement {
+    return new ExplainedStatement().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExplainedStatement | PlainMessage<ExplainedStatement> | undefined, b: ExplainedStatement | PlainMessage<ExplainedStatement> | undefined): boolean {
+    return proto3.util.equals(ExplainedStatement, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.ExportRequest
  */
@@ -812,6 +955,13 @@ export class MetricsViewAggregationRequest extends Message<MetricsViewAggregatio
    */
   timeRange?: TimeRange;
 
+  /**
+   * Optional. Defaults to the metrics view's primary time dimension.
+   *
+   * @generated from field: string time_dimension = 15;
+   */
+  timeDimension = "";
+
   /**
    * Deprecated in favor of time_range
    *
@@ -872,6 +1022,7 @@ export class MetricsViewAggregationRequest extends Message<MetricsViewAggregatio
     { no: 4, name: "measures", kind: "message", T: MetricsViewAggregationMeasure, repeated: true },
     { no: 5, name: "sort", kind: "message", T: MetricsViewAggregationSort, repeated: true },
     { no: 12, name: "time_range", kind: "message", T: TimeRange },
+    { no: 15, name: "time_dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "time_start", kind: "message", T: Timestamp },
     { no: 7, name: "time_end", kind: "message", T: Timestamp },
     { no: 8, name: "where", kind: "message", T: Expression },
@@ -1284,6 +1435,13 @@ export class MetricsViewComparisonRequest extends Message<MetricsViewComparisonR
    */
   comparisonTimeRange?: TimeRange;
 
+  /**
+   * Optional. Defaults to the metrics view's primary time dimension.
+   *
+   * @generated from field: string time_dimension = 16;
+   */
+  timeDimension = "";
+
   /**
    * @generated from field: rill.runtime.v1.Expression where = 8;
    */
@@ -1341,6 +1499,7 @@ export class MetricsViewComparisonRequest extends Message<MetricsViewComparisonR
     { no: 5, name: "sort", kind: "message", T: MetricsViewComparisonSort, repeated: true },
     { no: 6, name: "time_range", kind: "message", T: TimeRange },
     { no: 7, name: "comparison_time_range", kind: "message", T: TimeRange },
+    { no: 16, name: "time_dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "where", kind: "message", T: Expression },
     { no: 12, name: "having", kind: "message", T: Expression },
     { no: 15, name: "aliases", kind: "message", T: MetricsViewComparisonMeasureAlias, repeated: true },
@@ -1723,6 +1882,13 @@ export class MetricsViewTimeSeriesRequest extends Message<MetricsViewTimeSeriesR
    */
   timeGranularity = TimeGrain.UNSPECIFIED;
 
+  /**
+   * Optional. Defaults to the metrics view's primary time dimension.
+   *
+   * @generated from field: string time_dimension = 13;
+   */
+  timeDimension = "";
+
   /**
    * @generated from field: rill.runtime.v1.Expression where = 7;
    */
@@ -1765,6 +1931,7 @@ export class MetricsViewTimeSeriesRequest extends Message<MetricsViewTimeSeriesR
     { no: 4, name: "time_start", kind: "message", T: Timestamp },
     { no: 5, name: "time_end", kind: "message", T: Timestamp },
     { no: 6, name: "time_granularity", kind: "enum", T: proto3.getEnumType(TimeGrain) },
+    { no: 13, name: "time_dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "where", kind: "message", T: Expression },
     { no: 11, name: "having", kind: "message", T: Expression },
     { no: 10, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
@@ -2351,6 +2518,13 @@ export class MetricsViewTimeRangeRequest extends Message<MetricsViewTimeRangeReq
    */
   metricsViewName = "";
 
+  /**
+   * Optional. Defaults to the metrics view's primary time dimension.
+   *
+   * @generated from field: string time_dimension = 4;
+   */
+  timeDimension = "";
+
   /**
    * @generated from field: int32 priority = 3;
    */
@@ -2366,6 +2540,7 @@ export class MetricsViewTimeRangeRequest extends Message<MetricsViewTimeRangeReq
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "metrics_view_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "time_dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
@@ -115,6 +115,12 @@ export class Resource extends Message<Resource> {
      */
     value: Theme;
     case: "theme";
+  } | {
+    /**
+     * @generated from field: rill.runtime.v1.Seed seed = 12;
+     */
+    value: Seed;
+    case: "seed";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Resource>) {
@@ -136,6 +142,7 @@ export class Resource extends Message<Resource> {
     { no: 7, name: "refresh_trigger", kind: "message", T: RefreshTrigger, oneof: "resource" },
     { no: 8, name: "bucket_planner", kind: "message", T: BucketPlanner, oneof: "resource" },
     { no: 11, name: "theme", kind: "message", T: Theme, oneof: "resource" },
+    { no: 12, name: "seed", kind: "message", T: Seed, oneof: "resource" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Resource {
@@ -520,6 +527,11 @@ export class SourceSpec extends Message<SourceSpec> {
    */
   timeoutSeconds = 0;
 
+  /**
+   * @generated from field: rill.runtime.v1.TableSecurity security = 10;
+   */
+  security?: TableSecurity;
+
   /**
    * Fields not derived from code files
    *
@@ -550,6 +562,7 @@ export class SourceSpec extends Message<SourceSpec> {
     { no: 3, name: "properties", kind: "message", T: Struct },
     { no: 5, name: "refresh_schedule", kind: "message", T: Schedule },
     { no: 6, name: "timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 10, name: "security", kind: "message", T: TableSecurity },
     { no: 7, name: "stage_changes", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 8, name: "stream_ingestion", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 9, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
@@ -627,6 +640,114 @@ export class SourceState extends Message<SourceState> {
   }
 }
 
+/**
+ * Security policy for queries that read a source or model's table directly
+ *
+ * @generated from message rill.runtime.v1.TableSecurity
+ */
+export class TableSecurity extends Message<TableSecurity> {
+  /**
+   * Table level access condition
+   *
+   * @generated from field: string access = 1;
+   */
+  access = "";
+
+  /**
+   * Row level access condition
+   *
+   * @generated from field: string row_filter = 2;
+   */
+  rowFilter = "";
+
+  /**
+   * either one of include or exclude will be specified
+   *
+   * @generated from field: repeated rill.runtime.v1.TableSecurity.ColumnCondition include = 3;
+   */
+  include: TableSecurity_ColumnCondition[] = [];
+
+  /**
+   * @generated from field: repeated rill.runtime.v1.TableSecurity.ColumnCondition exclude = 4;
+   */
+  exclude: TableSecurity_ColumnCondition[] = [];
+
+  constructor(data?: PartialMessage<TableSecurity>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TableSecurity";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "row_filter", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "include", kind: "message", T: TableSecurity_ColumnCondition, repeated: true },
+    { no: 4, name: "exclude", kind: "message", T: TableSecurity_ColumnCondition, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableSecurity {
+    return new TableSecurity().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableSecurity {
+    return new TableSecurity().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableSecurity {
+    return new TableSecurity().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TableSecurity | PlainMessage<TableSecurity> | undefined, b: TableSecurity | PlainMessage<TableSecurity> | undefined): boolean {
+    return proto3.util.equals(TableSecurity, a, b);
+  }
+}
+
+/**
+ * Column level access condition
+ *
+ * @generated from message rill.runtime.v1.TableSecurity.ColumnCondition
+ */
+export class TableSecurity_ColumnCondition extends Message<TableSecurity_ColumnCondition> {
+  /**
+   * @generated from field: string condition = 1;
+   */
+  condition = "";
+
+  /**
+   * @generated from field: repeated string names = 2;
+   */
+  names: string[] = [];
+
+  constructor(data?: PartialMessage<TableSecurity_ColumnCondition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.TableSecurity.ColumnCondition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "condition", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableSecurity_ColumnCondition {
+    return new TableSecurity_ColumnCondition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableSecurity_ColumnCondition {
+    return new TableSecurity_ColumnCondition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableSecurity_ColumnCondition {
+    return new TableSecurity_ColumnCondition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TableSecurity_ColumnCondition | PlainMessage<TableSecurity_ColumnCondition> | undefined, b: TableSecurity_ColumnCondition | PlainMessage<TableSecurity_ColumnCondition> | undefined): boolean {
+    return proto3.util.equals(TableSecurity_ColumnCondition, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.ModelV2
  */
@@ -704,6 +825,11 @@ export class ModelSpec extends Message<ModelSpec> {
    */
   usesTemplating = false;
 
+  /**
+   * @generated from field: rill.runtime.v1.TableSecurity security = 10;
+   */
+  security?: TableSecurity;
+
   /**
    * Fields not derived from code files
    *
@@ -735,6 +861,7 @@ export class ModelSpec extends Message<ModelSpec> {
     { no: 4, name: "refresh_schedule", kind: "message", T: Schedule },
     { no: 5, name: "timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 6, name: "uses_templating", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "security", kind: "message", T: TableSecurity },
     { no: 7, name: "stage_changes", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 8, name: "materialize_delay_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 9, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
@@ -978,6 +1105,27 @@ export class MetricsViewSpec extends Message<MetricsViewSpec> {
    */
   defaultTheme = "";
 
+  /**
+   * Lookup joins that dimensions can reference. Joins are only added to queries that need them.
+   *
+   * @generated from field: repeated rill.runtime.v1.MetricsViewSpec.JoinV2 joins = 18;
+   */
+  joins: MetricsViewSpec_JoinV2[] = [];
+
+  /**
+   * Additional time dimensions that queries can use instead of the primary time dimension
+   *
+   * @generated from field: repeated string time_dimensions = 19;
+   */
+  timeDimensions: string[] = [];
+
+  /**
+   * Rollups that are materialized and used to answer queries
+   *
+   * @generated from field: repeated rill.runtime.v1.MetricsViewSpec.RollupV2 rollups = 20;
+   */
+  rollups: MetricsViewSpec_RollupV2[] = [];
+
   constructor(data?: PartialMessage<MetricsViewSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1003,6 +1151,9 @@ export class MetricsViewSpec extends Message<MetricsViewSpec> {
     { no: 15, name: "default_comparison_dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 16, name: "available_time_ranges", kind: "message", T: MetricsViewSpec_AvailableTimeRange, repeated: true },
     { no: 17, name: "default_theme", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 18, name: "joins", kind: "message", T: MetricsViewSpec_JoinV2, repeated: true },
+    { no: 19, name: "time_dimensions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 20, name: "rollups", kind: "message", T: MetricsViewSpec_RollupV2, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec {
@@ -1022,6 +1173,70 @@ export class MetricsViewSpec extends Message<MetricsViewSpec> {
   }
 }
 
+/**
+ * @generated from enum rill.runtime.v1.MetricsViewSpec.MeasureType
+ */
+export enum MetricsViewSpec_MeasureType {
+  /**
+   * @generated from enum value: MEASURE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MEASURE_TYPE_SIMPLE = 1;
+   */
+  SIMPLE = 1,
+
+  /**
+   * @generated from enum value: MEASURE_TYPE_DERIVED = 2;
+   */
+  DERIVED = 2,
+
+  /**
+   * @generated from enum value: MEASURE_TYPE_WINDOW = 3;
+   */
+  WINDOW = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MetricsViewSpec_MeasureType)
+proto3.util.setEnumType(MetricsViewSpec_MeasureType, "rill.runtime.v1.MetricsViewSpec.MeasureType", [
+  { no: 0, name: "MEASURE_TYPE_UNSPECIFIED" },
+  { no: 1, name: "MEASURE_TYPE_SIMPLE" },
+  { no: 2, name: "MEASURE_TYPE_DERIVED" },
+  { no: 3, name: "MEASURE_TYPE_WINDOW" },
+]);
+
+/**
+ * @generated from enum rill.runtime.v1.MetricsViewSpec.WindowKind
+ */
+export enum MetricsViewSpec_WindowKind {
+  /**
+   * @generated from enum value: WINDOW_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WINDOW_KIND_RUNNING_SUM = 1;
+   */
+  RUNNING_SUM = 1,
+
+  /**
+   * @generated from enum value: WINDOW_KIND_ROLLING = 2;
+   */
+  ROLLING = 2,
+
+  /**
+   * @generated from enum value: WINDOW_KIND_PERCENT_OF_TOTAL = 3;
+   */
+  PERCENT_OF_TOTAL = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MetricsViewSpec_WindowKind)
+proto3.util.setEnumType(MetricsViewSpec_WindowKind, "rill.runtime.v1.MetricsViewSpec.WindowKind", [
+  { no: 0, name: "WINDOW_KIND_UNSPECIFIED" },
+  { no: 1, name: "WINDOW_KIND_RUNNING_SUM" },
+  { no: 2, name: "WINDOW_KIND_ROLLING" },
+  { no: 3, name: "WINDOW_KIND_PERCENT_OF_TOTAL" },
+]);
+
 /**
  * @generated from enum rill.runtime.v1.MetricsViewSpec.ComparisonMode
  */
@@ -1075,6 +1290,13 @@ export class MetricsViewSpec_DimensionV2 extends Message<MetricsViewSpec_Dimensi
    */
   expression = "";
 
+  /**
+   * Name of the joined table that the dimension's column comes from. If empty, the column comes from the base table.
+   *
+   * @generated from field: string table = 7;
+   */
+  table = "";
+
   /**
    * @generated from field: string label = 3;
    */
@@ -1101,6 +1323,7 @@ export class MetricsViewSpec_DimensionV2 extends Message<MetricsViewSpec_Dimensi
     { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "expression", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "unnest", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
@@ -1139,6 +1362,20 @@ export class MetricsViewSpec_MeasureV2 extends Message<MetricsViewSpec_MeasureV2
    */
   expression = "";
 
+  /**
+   * Derived measures reference other measures by name in their expression
+   *
+   * @generated from field: rill.runtime.v1.MetricsViewSpec.MeasureType type = 8;
+   */
+  type = MetricsViewSpec_MeasureType.UNSPECIFIED;
+
+  /**
+   * Window to compute the measure over (only for window measures)
+   *
+   * @generated from field: rill.runtime.v1.MetricsViewSpec.MeasureWindow window = 9;
+   */
+  window?: MetricsViewSpec_MeasureWindow;
+
   /**
    * @generated from field: string label = 3;
    */
@@ -1174,6 +1411,8 @@ export class MetricsViewSpec_MeasureV2 extends Message<MetricsViewSpec_MeasureV2
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "expression", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "type", kind: "enum", T: proto3.getEnumType(MetricsViewSpec_MeasureType) },
+    { no: 9, name: "window", kind: "message", T: MetricsViewSpec_MeasureWindow },
     { no: 3, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "format_preset", kind: "scalar", T: 9 /* ScalarType.STRING */ },
@@ -1198,6 +1437,181 @@ export class MetricsViewSpec_MeasureV2 extends Message<MetricsViewSpec_MeasureV2
   }
 }
 
+/**
+ * Window measures are computed over the rows of an aggregated result
+ *
+ * @generated from message rill.runtime.v1.MetricsViewSpec.MeasureWindow
+ */
+export class MetricsViewSpec_MeasureWindow extends Message<MetricsViewSpec_MeasureWindow> {
+  /**
+   * @generated from field: rill.runtime.v1.MetricsViewSpec.WindowKind kind = 1;
+   */
+  kind = MetricsViewSpec_WindowKind.UNSPECIFIED;
+
+  /**
+   * Time grain at which a running sum resets (e.g. month for month-to-date)
+   *
+   * @generated from field: rill.runtime.v1.TimeGrain period = 2;
+   */
+  period = TimeGrain.UNSPECIFIED;
+
+  /**
+   * Number of time grains in a rolling window
+   *
+   * @generated from field: uint32 size = 3;
+   */
+  size = 0;
+
+  /**
+   * Dimension to compute the percent of total over. If empty, the total is computed over all rows.
+   *
+   * @generated from field: string dimension = 4;
+   */
+  dimension = "";
+
+  constructor(data?: PartialMessage<MetricsViewSpec_MeasureWindow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewSpec.MeasureWindow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(MetricsViewSpec_WindowKind) },
+    { no: 2, name: "period", kind: "enum", T: proto3.getEnumType(TimeGrain) },
+    { no: 3, name: "size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "dimension", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_MeasureWindow {
+    return new MetricsViewSpec_MeasureWindow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewSpec_MeasureWindow {
+    return new MetricsViewSpec_MeasureWindow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewSpec_MeasureWindow {
+    return new MetricsViewSpec_MeasureWindow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewSpec_MeasureWindow | PlainMessage<MetricsViewSpec_MeasureWindow> | undefined, b: MetricsViewSpec_MeasureWindow | PlainMessage<MetricsViewSpec_MeasureWindow> | undefined): boolean {
+    return proto3.util.equals(MetricsViewSpec_MeasureWindow, a, b);
+  }
+}
+
+/**
+ * Lookup join from the base table to another table
+ *
+ * @generated from message rill.runtime.v1.MetricsViewSpec.JoinV2
+ */
+export class MetricsViewSpec_JoinV2 extends Message<MetricsViewSpec_JoinV2> {
+  /**
+   * Name of the table to join
+   *
+   * @generated from field: string table = 1;
+   */
+  table = "";
+
+  /**
+   * Name of the column to join on. It must exist in both the base table and the joined table.
+   *
+   * @generated from field: string on = 2;
+   */
+  on = "";
+
+  constructor(data?: PartialMessage<MetricsViewSpec_JoinV2>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewSpec.JoinV2";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "on", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_JoinV2 {
+    return new MetricsViewSpec_JoinV2().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewSpec_JoinV2 {
+    return new MetricsViewSpec_JoinV2().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewSpec_JoinV2 {
+    return new MetricsViewSpec_JoinV2().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewSpec_JoinV2 | PlainMessage<MetricsViewSpec_JoinV2> | undefined, b: MetricsViewSpec_JoinV2 | PlainMessage<MetricsViewSpec_JoinV2> | undefined): boolean {
+    return proto3.util.equals(MetricsViewSpec_JoinV2, a, b);
+  }
+}
+
+/**
+ * Rollup is a pre-aggregated table that queries are routed to when it can answer them
+ *
+ * @generated from message rill.runtime.v1.MetricsViewSpec.RollupV2
+ */
+export class MetricsViewSpec_RollupV2 extends Message<MetricsViewSpec_RollupV2> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Dimensions to group by
+   *
+   * @generated from field: repeated string dimensions = 2;
+   */
+  dimensions: string[] = [];
+
+  /**
+   * Time grain to truncate the time dimension to. If unspecified, the rollup is not grouped by time.
+   *
+   * @generated from field: rill.runtime.v1.TimeGrain time_grain = 3;
+   */
+  timeGrain = TimeGrain.UNSPECIFIED;
+
+  /**
+   * Measures to pre-aggregate. Only measures that can be re-aggregated (sum, count, min and max) are supported.
+   *
+   * @generated from field: repeated string measures = 4;
+   */
+  measures: string[] = [];
+
+  constructor(data?: PartialMessage<MetricsViewSpec_RollupV2>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewSpec.RollupV2";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "dimensions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "time_grain", kind: "enum", T: proto3.getEnumType(TimeGrain) },
+    { no: 4, name: "measures", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_RollupV2 {
+    return new MetricsViewSpec_RollupV2().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewSpec_RollupV2 {
+    return new MetricsViewSpec_RollupV2().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewSpec_RollupV2 {
+    return new MetricsViewSpec_RollupV2().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewSpec_RollupV2 | PlainMessage<MetricsViewSpec_RollupV2> | undefined, b: MetricsViewSpec_RollupV2 | PlainMessage<MetricsViewSpec_RollupV2> | undefined): boolean {
+    return proto3.util.equals(MetricsViewSpec_RollupV2, a, b);
+  }
+}
+
 /**
  * Security for the dashboard
  *
@@ -1230,6 +1644,11 @@ export class MetricsViewSpec_SecurityV2 extends Message<MetricsViewSpec_Security
    */
   exclude: MetricsViewSpec_SecurityV2_FieldConditionV2[] = [];
 
+  /**
+   * @generated from field: repeated rill.runtime.v1.MetricsViewSpec.SecurityV2.MaskV2 mask = 5;
+   */
+  mask: MetricsViewSpec_SecurityV2_MaskV2[] = [];
+
   constructor(data?: PartialMessage<MetricsViewSpec_SecurityV2>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1242,6 +1661,7 @@ export class MetricsViewSpec_SecurityV2 extends Message<MetricsViewSpec_Security
     { no: 2, name: "row_filter", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "include", kind: "message", T: MetricsViewSpec_SecurityV2_FieldConditionV2, repeated: true },
     { no: 4, name: "exclude", kind: "message", T: MetricsViewSpec_SecurityV2_FieldConditionV2, repeated: true },
+    { no: 5, name: "mask", kind: "message", T: MetricsViewSpec_SecurityV2_MaskV2, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_SecurityV2 {
@@ -1306,6 +1726,59 @@ export class MetricsViewSpec_SecurityV2_FieldConditionV2 extends Message<Metrics
   }
 }
 
+/**
+ * Dimension level masking condition
+ *
+ * @generated from message rill.runtime.v1.MetricsViewSpec.SecurityV2.MaskV2
+ */
+export class MetricsViewSpec_SecurityV2_MaskV2 extends Message<MetricsViewSpec_SecurityV2_MaskV2> {
+  /**
+   * @generated from field: string condition = 1;
+   */
+  condition = "";
+
+  /**
+   * @generated from field: repeated string names = 2;
+   */
+  names: string[] = [];
+
+  /**
+   * Masking function to apply: "hash", "redact" or "partial"
+   *
+   * @generated from field: string with = 3;
+   */
+  with = "";
+
+  constructor(data?: PartialMessage<MetricsViewSpec_SecurityV2_MaskV2>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewSpec.SecurityV2.MaskV2";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "condition", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "with", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_SecurityV2_MaskV2 {
+    return new MetricsViewSpec_SecurityV2_MaskV2().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewSpec_SecurityV2_MaskV2 {
+    return new MetricsViewSpec_SecurityV2_MaskV2().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewSpec_SecurityV2_MaskV2 {
+    return new MetricsViewSpec_SecurityV2_MaskV2().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewSpec_SecurityV2_MaskV2 | PlainMessage<MetricsViewSpec_SecurityV2_MaskV2> | undefined, b: MetricsViewSpec_SecurityV2_MaskV2 | PlainMessage<MetricsViewSpec_SecurityV2_MaskV2> | undefined): boolean {
+    return proto3.util.equals(MetricsViewSpec_SecurityV2_MaskV2, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.MetricsViewSpec.AvailableComparisonOffset
  */
@@ -1405,6 +1878,20 @@ export class MetricsViewState extends Message<MetricsViewState> {
    */
   validSpec?: MetricsViewSpec;
 
+  /**
+   * Rollup tables materialized for the valid spec
+   *
+   * @generated from field: repeated rill.runtime.v1.MetricsViewRollupState rollups = 2;
+   */
+  rollups: MetricsViewRollupState[] = [];
+
+  /**
+   * Hash of the rollups and the state of the metrics view's refs at the time the rollups were materialized
+   *
+   * @generated from field: string rollups_hash = 3;
+   */
+  rollupsHash = "";
+
   constructor(data?: PartialMessage<MetricsViewState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1414,6 +1901,8 @@ export class MetricsViewState extends Message<MetricsViewState> {
   static readonly typeName = "rill.runtime.v1.MetricsViewState";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "valid_spec", kind: "message", T: MetricsViewSpec },
+    { no: 2, name: "rollups", kind: "message", T: MetricsViewRollupState, repeated: true },
+    { no: 3, name: "rollups_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewState {
@@ -1433,6 +1922,67 @@ export class MetricsViewState extends Message<MetricsViewState> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.MetricsViewRollupState
+ */
+export class MetricsViewRollupState extends Message<MetricsViewRollupState> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Name of the materialized table
+   *
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * Number of rows in the materialized table
+   *
+   * @generated from field: int64 row_count = 3;
+   */
+  rowCount = protoInt64.zero;
+
+  /**
+   * Latest refresh time of the metrics view's sources and models when the rollup was materialized
+   *
+   * @generated from field: google.protobuf.Timestamp refs_refreshed_on = 4;
+   */
+  refsRefreshedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<MetricsViewRollupState>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.MetricsViewRollupState";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "row_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "refs_refreshed_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewRollupState {
+    return new MetricsViewRollupState().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsViewRollupState {
+    return new MetricsViewRollupState().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsViewRollupState {
+    return new MetricsViewRollupState().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsViewRollupState | PlainMessage<MetricsViewRollupState> | undefined, b: MetricsViewRollupState | PlainMessage<MetricsViewRollupState> | undefined): boolean {
+    return proto3.util.equals(MetricsViewRollupState, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.Migration
  */
@@ -2343,6 +2893,183 @@ export class ThemeState extends Message<ThemeState> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.Seed
+ */
+export class Seed extends Message<Seed> {
+  /**
+   * @generated from field: rill.runtime.v1.SeedSpec spec = 1;
+   */
+  spec?: SeedSpec;
+
+  /**
+   * @generated from field: rill.runtime.v1.SeedState state = 2;
+   */
+  state?: SeedState;
+
+  constructor(data?: PartialMessage<Seed>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.Seed";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "spec", kind: "message", T: SeedSpec },
+    { no: 2, name: "state", kind: "message", T: SeedState },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Seed {
+    return new Seed().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Seed {
+    return new Seed().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Seed {
+    return new Seed().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Seed | PlainMessage<Seed> | undefined, b: Seed | PlainMessage<Seed> | undefined): boolean {
+    return proto3.util.equals(Seed, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.SeedSpec
+ */
+export class SeedSpec extends Message<SeedSpec> {
+  /**
+   * OLAP connector to load the seed into
+   *
+   * @generated from field: string connector = 1;
+   */
+  connector = "";
+
+  /**
+   * Path of the data file in the repo
+   *
+   * @generated from field: string path = 2;
+   */
+  path = "";
+
+  /**
+   * Format of the data file ("csv", "json" or "parquet")
+   *
+   * @generated from field: string format = 3;
+   */
+  format = "";
+
+  /**
+   * Hash of the data file's contents, which changes the spec when the file changes
+   *
+   * @generated from field: string content_hash = 4;
+   */
+  contentHash = "";
+
+  /**
+   * Types to use for the given columns instead of the inferred types
+   *
+   * @generated from field: map<string, string> column_types = 5;
+   */
+  columnTypes: { [key: string]: string } = {};
+
+  /**
+   * Fields not derived from code files
+   *
+   * @generated from field: bool trigger = 6;
+   */
+  trigger = false;
+
+  constructor(data?: PartialMessage<SeedSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.SeedSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "format", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "content_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "column_types", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 6, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SeedSpec {
+    return new SeedSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SeedSpec {
+    return new SeedSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SeedSpec {
+    return new SeedSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SeedSpec | PlainMessage<SeedSpec> | undefined, b: SeedSpec | PlainMessage<SeedSpec> | undefined): boolean {
+    return proto3.util.equals(SeedSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.SeedState
+ */
+export class SeedState extends Message<SeedState> {
+  /**
+   * @generated from field: string connector = 1;
+   */
+  connector = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * @generated from field: string spec_hash = 3;
+   */
+  specHash = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp refreshed_on = 4;
+   */
+  refreshedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<SeedState>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.SeedState";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "spec_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "refreshed_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SeedState {
+    return new SeedState().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SeedState {
+    return new SeedState().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SeedState {
+    return new SeedState().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SeedState | PlainMessage<SeedState> | undefined, b: SeedState | PlainMessage<SeedState> | undefined): boolean {
+    return proto3.util.equals(SeedState, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.Schedule
  */